@@ -17,16 +17,23 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -38,6 +45,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	kubevirtiov1 "kubevirt.io/api/core/v1"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 
 	webhookv1 "kubevirt.io/kubevirt-rbac-webhook/internal/webhook/v1"
 )
@@ -50,6 +58,7 @@ var (
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(kubevirtiov1.AddToScheme(scheme))
+	utilruntime.Must(instancetypev1beta1.AddToScheme(scheme))
 }
 
 func main() {
@@ -59,6 +68,43 @@ func main() {
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var allowGroups, denyGroups string
+	var useTypedSARClient bool
+	var reportAllMissingPermissions bool
+	var strictMode bool
+	var disabledCheckers string
+	var maxObjectBytes int
+	var webhookPath string
+	var requireComputeLiveAdmin bool
+	var requireInputAdmin bool
+	var requireFilesystemRemovalAdmin bool
+	var requireFullAdminForDeviceRemovals bool
+	var prefetchPermissions bool
+	var labelGrantsConfigMap string
+	var policyConfigMap string
+	var liveOldObject bool
+	var ownerDelegation bool
+	var namespaceFreeze bool
+	var instancetypeAware bool
+	var fullAdminFromCategories bool
+	var unauthenticatedPolicy string
+	var enforcedNamespaces, exemptNamespaces string
+	var pendingEnforcementFields string
+	var controlAnnotationPrefixes string
+	var labelSubresources string
+	var defaultArchitecture, defaultMachineTypes string
+	var immutableFields string
+	var immutableFieldsAllowFullAdmin bool
+	var sarRetries int
+	var sarRetryBackoff time.Duration
+	var sarVerbs string
+	var sarGroups string
+	var sarQPS float64
+	var sarBurst int
+	var maxSARsPerRequest int
+	var maxChangedCategories int
+	var enableTracing bool
+	var restoreControllerUsers string
 	var tlsOpts []func(*tls.Config)
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
@@ -75,6 +121,124 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.StringVar(&allowGroups, "allow-groups", "",
+		"Comma-separated list of groups whose members are always allowed to update VirtualMachines (break-glass).")
+	flag.StringVar(&denyGroups, "deny-groups", "",
+		"Comma-separated list of groups whose members are always denied VirtualMachine updates. "+
+			"Takes precedence over --allow-groups.")
+	flag.BoolVar(&useTypedSARClient, "use-typed-sar-client", false,
+		"If set, SubjectAccessReviews are created with the typed authorization client "+
+			"instead of the controller-runtime client.")
+	flag.BoolVar(&reportAllMissingPermissions, "report-all-missing-permissions", false,
+		"If set, denials list every changed category the user lacks permission for.")
+	flag.BoolVar(&strictMode, "strict-mode", false,
+		"If set, users without full-admin or any VM subresource permission are denied "+
+			"instead of allowed (disables backwards compatibility).")
+	flag.StringVar(&disabledCheckers, "disabled-checkers", "",
+		"Comma-separated list of field permission checker names (e.g. devices,autoattach) to disable. "+
+			"Changes in disabled categories fall through to the generic spec/metadata handling.")
+	flag.IntVar(&maxObjectBytes, "max-object-bytes", 3*1024*1024,
+		"Deny VirtualMachine updates whose serialized object exceeds this many bytes. Set to 0 to disable.")
+	flag.StringVar(&webhookPath, "webhook-path", "",
+		"Admission path of the VirtualMachine validating webhook. "+
+			"Defaults to /validate-kubevirt-io-v1-virtualmachine.")
+	flag.BoolVar(&requireComputeLiveAdmin, "require-compute-live-admin", false,
+		"If set, compute changes to running VMs require virtualmachines/compute-live-admin "+
+			"instead of virtualmachines/compute-admin.")
+	flag.BoolVar(&requireInputAdmin, "require-input-admin", false,
+		"If set, input device type/bus changes require virtualmachines/input-admin "+
+			"instead of virtualmachines/devices-admin.")
+	flag.BoolVar(&requireFilesystemRemovalAdmin, "require-filesystem-removal-admin", false,
+		"If set, removing virtio-fs filesystems requires virtualmachines/filesystem-removal-admin; "+
+			"virtualmachines/filesystem-admin still covers adding them.")
+	flag.BoolVar(&requireFullAdminForDeviceRemovals, "require-full-admin-for-device-removals", false,
+		"If set, removing GPUs or host devices requires virtualmachines/full-admin; "+
+			"virtualmachines/devices-admin still covers adding them.")
+	flag.BoolVar(&prefetchPermissions, "prefetch-permissions", false,
+		"If set, full-admin and every category permission are resolved with concurrent SubjectAccessReviews "+
+			"up front, lowering latency at the cost of reviews a full-admin would not need.")
+	flag.StringVar(&unauthenticatedPolicy, "unauthenticated-policy", string(webhookv1.UnauthenticatedDeny),
+		"Decision for updates whose admission request has no username: "+string(webhookv1.UnauthenticatedDeny)+
+			" denies them as unauthenticated, "+string(webhookv1.UnauthenticatedNoPermissions)+
+			" treats the user as holding no permission.")
+	flag.BoolVar(&fullAdminFromCategories, "full-admin-from-categories", false,
+		"If set, users granted every category subresource are treated like virtualmachines/full-admin, "+
+			"including changes to fields no category covers.")
+	flag.StringVar(&policyConfigMap, "policy-configmap", "",
+		"Name of a ConfigMap in the webhook's namespace whose "+webhookv1.PolicyConfigKey+" key overrides the strict mode, "+
+			"disabled checkers, allow/deny groups and immutable fields flags. Changes are applied without a restart. "+
+			"If empty, the policy is not reloadable.")
+	flag.StringVar(&labelGrantsConfigMap, "label-grants-configmap", "",
+		"Name of a ConfigMap in the webhook's namespace whose "+webhookv1.LabelGrantsKey+" key grants "+
+			"subresources to groups on VMs matching label selectors. Read once at startup.")
+	flag.BoolVar(&liveOldObject, "live-old-object", false,
+		"If set, the VM is read from the API server on every update and used as the old state, "+
+			"instead of the AdmissionReview's oldObject.")
+	flag.BoolVar(&namespaceFreeze, "namespace-freeze", false,
+		"If set, the "+webhookv1.FrozenAnnotation+" annotation on a namespace freezes all of its VMs, "+
+			"reading the namespace from the API server on every update.")
+	flag.BoolVar(&instancetypeAware, "instancetype-aware", false,
+		"If set, the CPU and memory settings of a VM's instancetype are filled into the specs before "+
+			"they are compared, reading the instancetype from the API server on every update, and "+
+			"instancetype-admin may switch the instancetype.")
+	flag.BoolVar(&ownerDelegation, "owner-delegation", false,
+		"If set, users that may update the controller owner of a VM (e.g. a VirtualMachinePool) "+
+			"are granted every category subresource on the VM, but not full-admin.")
+	flag.StringVar(&enforcedNamespaces, "enforced-namespaces", "",
+		"Comma-separated list of namespaces whose VirtualMachine updates are enforced (staged rollout). "+
+			"Updates in other namespaces are allowed unchecked. Defaults to every namespace.")
+	flag.StringVar(&exemptNamespaces, "exempt-namespaces", "",
+		"Comma-separated list of namespaces whose VirtualMachine updates are never enforced. "+
+			"Takes precedence over --enforced-namespaces.")
+	flag.StringVar(&pendingEnforcementFields, "pending-enforcement-fields", "",
+		"Comma-separated list of dot-separated VirtualMachine field paths (e.g. spec.template.spec.domain.devices.tpm) "+
+			"whose changes produce an admission warning, ahead of being enforced.")
+	flag.StringVar(&controlAnnotationPrefixes, "control-annotation-prefixes", "",
+		"Comma-separated annotation key prefixes (e.g. kubevirt.io/) of control annotations on the VM or its template "+
+			"whose changes require virtualmachines/full-admin.")
+	flag.StringVar(&labelSubresources, "label-subresources", "",
+		"Comma-separated <label key prefix>=<subresource> entries (e.g. topology.kubernetes.io/=virtualmachines/scheduling-admin). "+
+			"Changing a label with the prefix on the VM or its template requires the subresource.")
+	flag.StringVar(&defaultArchitecture, "default-architecture", "",
+		"The default architecture configured in the KubeVirt CR (empty uses KubeVirt's default, amd64). "+
+			"Only this architecture is ignored when KubeVirt defaults it on a VM stored without one.")
+	flag.StringVar(&defaultMachineTypes, "default-machine-types", "",
+		"Comma-separated <architecture>=<machine type> entries (e.g. amd64=q35) configured in the KubeVirt CR "+
+			"(empty uses KubeVirt's defaults). Only these machine types are ignored when KubeVirt defaults them "+
+			"on a VM stored without one.")
+	flag.StringVar(&immutableFields, "immutable-fields", "",
+		"Comma-separated, dot-separated VM field paths (e.g. spec.template.spec.domain.firmware.uuid) immutable by policy. "+
+			"Changes to them are denied for every user without virtualmachines/full-admin.")
+	flag.BoolVar(&immutableFieldsAllowFullAdmin, "immutable-fields-allow-full-admin", true,
+		"If set, users with virtualmachines/full-admin may change the --immutable-fields; otherwise no one may.")
+	flag.IntVar(&sarRetries, "sar-retries", 2,
+		"Number of times a SubjectAccessReview failing with a transient error (timeout, 429, 5xx) is retried. "+
+			"0 disables retries.")
+	flag.DurationVar(&sarRetryBackoff, "sar-retry-backoff", 100*time.Millisecond,
+		"Wait before the first SubjectAccessReview retry, doubled before every further retry.")
+	flag.StringVar(&sarVerbs, "sar-verbs", "update",
+		"Comma-separated verbs checked on VM subresources; a user granted any of them on a subresource holds it "+
+			"(e.g. update,patch for RBAC setups that grant patch only).")
+	flag.StringVar(&sarGroups, "sar-groups", "kubevirt.io",
+		"Comma-separated API groups VM subresources are checked in; a user granted a subresource in any of them holds it "+
+			"(e.g. kubevirt.io,subresources.kubevirt.io).")
+	flag.Float64Var(&sarQPS, "sar-qps", 0,
+		"Maximum SubjectAccessReviews created per second; reviews beyond it wait for a token until the admission "+
+			"request's deadline, then fail it. 0 disables rate limiting.")
+	flag.IntVar(&sarBurst, "sar-burst", 20,
+		"Maximum burst of SubjectAccessReviews above --sar-qps.")
+	flag.IntVar(&maxSARsPerRequest, "max-sars-per-request", 64,
+		"Maximum subresource SubjectAccessReviews a single update may need; an update needing more fails, "+
+			"indicating a misconfigured checker set. 0 disables the limit.")
+	flag.IntVar(&maxChangedCategories, "max-changed-categories", 0,
+		"Maximum categories a single update may change without virtualmachines/full-admin, even if the user holds "+
+			"the role of each. 0 disables the limit.")
+	flag.StringVar(&restoreControllerUsers, "restore-controller-users", "",
+		"Comma-separated usernames of the VirtualMachineRestore controller (e.g. "+
+			"system:serviceaccount:kubevirt:kubevirt-controller) whose restore updates skip granular checks.")
+	flag.BoolVar(&enableTracing, "enable-tracing", false,
+		"If set, export OpenTelemetry spans of admission decisions and SubjectAccessReviews over OTLP/gRPC, "+
+			"configured with the standard OTEL_EXPORTER_OTLP_* environment variables.")
 
 	opts := zap.Options{
 		Development: true,
@@ -84,6 +248,17 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	// Without an exporter the global tracer provider is a no-op
+	shutdownTracing := func(context.Context) error { return nil }
+	if enableTracing {
+		var err error
+		shutdownTracing, err = setupTracing(context.Background())
+		if err != nil {
+			setupLog.Error(err, "unable to set up tracing")
+			os.Exit(1)
+		}
+	}
+
 	// Disable HTTP/2 by default due to CVE vulnerabilities
 	// https://github.com/advisories/GHSA-qppj-fm5r-hxr3
 	// https://github.com/advisories/GHSA-4374-p667-p6c8
@@ -171,7 +346,59 @@ func main() {
 
 	// Register webhook
 	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
-		if err := webhookv1.SetupVirtualMachineWebhookWithManager(mgr); err != nil {
+		webhookOpts := webhookv1.WebhookOptions{
+			AllowGroups:       splitList(allowGroups),
+			DenyGroups:        splitList(denyGroups),
+			UseTypedSARClient: useTypedSARClient,
+
+			ReportAllMissingPermissions: reportAllMissingPermissions,
+			StrictMode:                  strictMode,
+			DisabledCheckers:            splitList(disabledCheckers),
+			MaxObjectBytes:              maxObjectBytes,
+			Path:                        webhookPath,
+			RequireComputeLiveAdmin:     requireComputeLiveAdmin,
+			RequireInputAdmin:           requireInputAdmin,
+			PrefetchPermissions:         prefetchPermissions,
+			FullAdminFromCategories:     fullAdminFromCategories,
+			UnauthenticatedPolicy:       webhookv1.UnauthenticatedPolicy(unauthenticatedPolicy),
+			LabelGrantsConfigMap: types.NamespacedName{
+				Namespace: os.Getenv("OPERATOR_NAMESPACE"),
+				Name:      labelGrantsConfigMap,
+			},
+			PolicyConfigMap: types.NamespacedName{
+				Namespace: os.Getenv("OPERATOR_NAMESPACE"),
+				Name:      policyConfigMap,
+			},
+			LiveOldObject:      liveOldObject,
+			OwnerDelegation:    ownerDelegation,
+			NamespaceFreeze:    namespaceFreeze,
+			InstancetypeAware:  instancetypeAware,
+			EnforcedNamespaces: splitList(enforcedNamespaces),
+			ExemptNamespaces:   splitList(exemptNamespaces),
+
+			PendingEnforcementFields:  splitList(pendingEnforcementFields),
+			ControlAnnotationPrefixes: splitList(controlAnnotationPrefixes),
+			LabelSubresources:         splitList(labelSubresources),
+			ImmutableFields:           splitList(immutableFields),
+
+			ImmutableFieldsAllowFullAdmin: immutableFieldsAllowFullAdmin,
+			DefaultArchitecture:           defaultArchitecture,
+			DefaultMachineTypes:           splitList(defaultMachineTypes),
+			SARRetry: webhookv1.RetryPolicy{
+				Retries: sarRetries,
+				Backoff: sarRetryBackoff,
+			},
+			SARVerbs:                          splitList(sarVerbs),
+			SARGroups:                         splitList(sarGroups),
+			SARQPS:                            sarQPS,
+			SARBurst:                          sarBurst,
+			MaxSARsPerRequest:                 maxSARsPerRequest,
+			MaxChangedCategories:              maxChangedCategories,
+			RequireFilesystemRemovalAdmin:     requireFilesystemRemovalAdmin,
+			RequireFullAdminForDeviceRemovals: requireFullAdminForDeviceRemovals,
+			RestoreControllerUsers:            splitList(restoreControllerUsers),
+		}
+		if err := webhookv1.SetupVirtualMachineWebhookWithManager(mgr, webhookOpts); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "VirtualMachine")
 			os.Exit(1)
 		}
@@ -205,8 +432,40 @@ func main() {
 	}
 
 	setupLog.Info("starting webhook server")
-	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+	err = mgr.Start(ctrl.SetupSignalHandler())
+
+	// Flush the spans still buffered before exiting
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		setupLog.Error(err, "failed to shut down tracing")
+	}
+	cancel()
+
+	if err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
 }
+
+// setupTracing installs a global tracer provider exporting spans over OTLP/gRPC and returns
+// the function flushing and stopping it
+func setupTracing(ctx context.Context) (func(context.Context) error, error) {
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter))
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// splitList parses a comma-separated flag value, dropping empty entries
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+)
+
+// admissionServer serves validators registered with RegisterValidators over HTTP, so tests
+// exercise the same path as the API server: AdmissionReview decoding, injection of the
+// request into the context, the audit annotation wrapper, and response encoding.
+type admissionServer struct {
+	mgr ctrl.Manager
+}
+
+// newAdmissionServer builds a manager whose webhook server serves the registrations.
+// The manager is never started, so the API server is never contacted.
+func newAdmissionServer(registrations ...ValidatorRegistration) *admissionServer {
+	GinkgoHelper()
+
+	testScheme := runtime.NewScheme()
+	Expect(kubevirtiov1.AddToScheme(testScheme)).To(Succeed())
+
+	mgr, err := ctrl.NewManager(&rest.Config{Host: "https://127.0.0.1:1"}, ctrl.Options{
+		Scheme:                 testScheme,
+		Metrics:                metricsserver.Options{BindAddress: "0"},
+		HealthProbeBindAddress: "0",
+	})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(RegisterValidators(mgr, registrations...)).To(Succeed())
+
+	return &admissionServer{mgr: mgr}
+}
+
+// post sends the raw body to the path and returns the HTTP recorder
+func (s *admissionServer) post(path string, body []byte) *httptest.ResponseRecorder {
+	GinkgoHelper()
+
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	s.mgr.GetWebhookServer().WebhookMux().ServeHTTP(recorder, req)
+	return recorder
+}
+
+// reviewUpdate posts an AdmissionReview for a VirtualMachine update by the user and returns
+// the decoded AdmissionResponse
+func (s *admissionServer) reviewUpdate(path string, userInfo authenticationv1.UserInfo, oldVM, newVM *kubevirtiov1.VirtualMachine) *admissionv1.AdmissionResponse {
+	GinkgoHelper()
+
+	oldRaw, err := json.Marshal(oldVM)
+	Expect(err).ToNot(HaveOccurred())
+	newRaw, err := json.Marshal(newVM)
+	Expect(err).ToNot(HaveOccurred())
+
+	body, err := json.Marshal(&admissionv1.AdmissionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+		Request: &admissionv1.AdmissionRequest{
+			UID:       types.UID("http-" + newVM.Name),
+			Kind:      metav1.GroupVersionKind{Group: "kubevirt.io", Version: "v1", Kind: "VirtualMachine"},
+			Resource:  metav1.GroupVersionResource{Group: "kubevirt.io", Version: "v1", Resource: "virtualmachines"},
+			Name:      newVM.Name,
+			Namespace: newVM.Namespace,
+			Operation: admissionv1.Update,
+			UserInfo:  userInfo,
+			Object:    runtime.RawExtension{Raw: newRaw},
+			OldObject: runtime.RawExtension{Raw: oldRaw},
+		},
+	})
+	Expect(err).ToNot(HaveOccurred())
+
+	recorder := s.post(path, body)
+	Expect(recorder.Code).To(Equal(http.StatusOK))
+
+	review := &admissionv1.AdmissionReview{}
+	Expect(json.Unmarshal(recorder.Body.Bytes(), review)).To(Succeed())
+	Expect(review.Response).ToNot(BeNil())
+	Expect(review.Response.UID).To(Equal(types.UID("http-" + newVM.Name)))
+	return review.Response
+}
+
+var _ = Describe("Admission HTTP path", func() {
+	const path = "/validate-kubevirt-io-v1-virtualmachine"
+
+	var (
+		server   *admissionServer
+		mockPerm *MockPermissionChecker
+		userInfo authenticationv1.UserInfo
+		oldVM    *kubevirtiov1.VirtualMachine
+		newVM    *kubevirtiov1.VirtualMachine
+	)
+
+	BeforeEach(func() {
+		mockPerm = &MockPermissionChecker{permissions: map[string]bool{
+			"virtualmachines/storage-admin": true,
+		}}
+		server = newAdmissionServer(ValidatorRegistration{
+			Object: &kubevirtiov1.VirtualMachine{},
+			Validator: &VirtualMachineCustomValidator{
+				FieldCheckers:     DefaultFieldCheckers(),
+				PermissionChecker: mockPerm,
+			},
+		})
+		userInfo = authenticationv1.UserInfo{Username: "test-user", Groups: []string{"test-group"}}
+
+		oldVM = &kubevirtiov1.VirtualMachine{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "kubevirt.io/v1", Kind: "VirtualMachine"},
+			ObjectMeta: metav1.ObjectMeta{Name: "test-vm", Namespace: "default"},
+			Spec: kubevirtiov1.VirtualMachineSpec{
+				Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+					Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+						Domain: kubevirtiov1.DomainSpec{
+							CPU: &kubevirtiov1.CPU{Cores: 2},
+						},
+						Volumes: []kubevirtiov1.Volume{{Name: "volume1"}},
+					},
+				},
+			},
+		}
+		newVM = oldVM.DeepCopy()
+	})
+
+	It("should allow updates the user has permission for", func() {
+		newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+
+		resp := server.reviewUpdate(path, userInfo, oldVM, newVM)
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.AuditAnnotations).To(HaveKeyWithValue(AuditAnnotationDecision, "allowed"))
+	})
+
+	It("should deny updates with a 403 and the denial message", func() {
+		newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+		resp := server.reviewUpdate(path, userInfo, oldVM, newVM)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result).ToNot(BeNil())
+		Expect(resp.Result.Code).To(Equal(int32(http.StatusForbidden)))
+		Expect(resp.Result.Message).To(Equal(
+			"user does not have permission to modify one or more spec fields of VirtualMachine default/test-vm"))
+		Expect(resp.AuditAnnotations).To(HaveKeyWithValue(AuditAnnotationDecision, "denied"))
+	})
+
+	It("should pass the requesting user to the permission checks", func() {
+		server = newAdmissionServer(ValidatorRegistration{
+			Object: &kubevirtiov1.VirtualMachine{},
+			Validator: &VirtualMachineCustomValidator{
+				FieldCheckers:     DefaultFieldCheckers(),
+				PermissionChecker: mockPerm,
+				DenyGroups:        []string{"test-group"},
+			},
+		})
+		newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+
+		resp := server.reviewUpdate(path, userInfo, oldVM, newVM)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring(`denied group "test-group"`))
+	})
+
+	It("should reject malformed AdmissionReviews", func() {
+		recorder := server.post(path, []byte("{not json"))
+
+		review := &admissionv1.AdmissionReview{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), review)).To(Succeed())
+		Expect(review.Response).ToNot(BeNil())
+		Expect(review.Response.Allowed).To(BeFalse())
+		Expect(review.Response.Result.Code).To(Equal(int32(http.StatusBadRequest)))
+	})
+})
@@ -0,0 +1,88 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Audit annotation keys set on the admission response.
+// The API server prefixes them with the webhook name in the audit log,
+// e.g. virtualmachine.validate.rbac.kubevirt.io/categories-changed.
+const (
+	AuditAnnotationCategoriesChanged = "categories-changed"
+	AuditAnnotationDecision          = "decision"
+	AuditAnnotationReasonCode        = "reason-code"
+)
+
+// auditRecord collects details from a validator for the audit annotations and metrics of one request
+type auditRecord struct {
+	categoriesChanged []string
+
+	// noGranularRoles is set when the update was allowed because the user holds no granular role
+	noGranularRoles bool
+
+	// reasonCode classifies the denial, empty when the update was allowed
+	reasonCode ReasonCode
+}
+
+type auditRecordKey struct{}
+
+// auditRecordFrom returns the audit record of the request, or nil when audit annotations are not collected
+func auditRecordFrom(ctx context.Context) *auditRecord {
+	record, _ := ctx.Value(auditRecordKey{}).(*auditRecord)
+	return record
+}
+
+// auditAnnotationHandler wraps an admission.Handler and adds audit annotations with the
+// decision and the categories the validator recorded, so they land in the audit log.
+// It also counts the decision in the decisions metric, and a denial in the denials metric.
+type auditAnnotationHandler struct {
+	admission.Handler
+}
+
+var _ admission.Handler = &auditAnnotationHandler{}
+
+// Handle runs the wrapped handler and annotates its response
+func (h *auditAnnotationHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	record := &auditRecord{}
+	resp := h.Handler.Handle(context.WithValue(ctx, auditRecordKey{}, record), req)
+
+	if resp.AuditAnnotations == nil {
+		resp.AuditAnnotations = make(map[string]string)
+	}
+	resp.AuditAnnotations[AuditAnnotationDecision] = "denied"
+	if resp.Allowed {
+		resp.AuditAnnotations[AuditAnnotationDecision] = "allowed"
+	} else {
+		// Requests denied before the validator ran (e.g. undecodable objects) have no code
+		reasonCode := record.reasonCode
+		if reasonCode == "" {
+			reasonCode = ReasonCodeInternalError
+		}
+		resp.AuditAnnotations[AuditAnnotationReasonCode] = string(reasonCode)
+		recordDenialMetric(reasonCode)
+	}
+	if len(record.categoriesChanged) > 0 {
+		resp.AuditAnnotations[AuditAnnotationCategoriesChanged] = strings.Join(record.categoriesChanged, ",")
+	}
+	recordDecisionMetric(resp.Allowed, record.noGranularRoles)
+	return resp
+}
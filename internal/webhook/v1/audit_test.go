@@ -0,0 +1,204 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("Audit annotations", func() {
+	var (
+		handler  admission.Handler
+		mockPerm *MockPermissionChecker
+		oldVM    *kubevirtiov1.VirtualMachine
+		newVM    *kubevirtiov1.VirtualMachine
+	)
+
+	updateRequest := func(oldVM, newVM *kubevirtiov1.VirtualMachine) admission.Request {
+		oldRaw, err := json.Marshal(oldVM)
+		Expect(err).ToNot(HaveOccurred())
+		newRaw, err := json.Marshal(newVM)
+		Expect(err).ToNot(HaveOccurred())
+
+		return admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				UID:       "audit-request",
+				Operation: admissionv1.Update,
+				Kind:      metav1.GroupVersionKind{Group: "kubevirt.io", Version: "v1", Kind: "VirtualMachine"},
+				UserInfo:  authenticationv1.UserInfo{Username: "test-user"},
+				Object:    runtime.RawExtension{Raw: newRaw},
+				OldObject: runtime.RawExtension{Raw: oldRaw},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		testScheme := runtime.NewScheme()
+		Expect(kubevirtiov1.AddToScheme(testScheme)).To(Succeed())
+
+		mockPerm = &MockPermissionChecker{permissions: make(map[string]bool)}
+		hook := admission.WithCustomValidator(testScheme, &kubevirtiov1.VirtualMachine{}, &VirtualMachineCustomValidator{
+			FieldCheckers:     DefaultFieldCheckers(),
+			PermissionChecker: mockPerm,
+		})
+		handler = &auditAnnotationHandler{Handler: hook.Handler}
+
+		oldVM = &kubevirtiov1.VirtualMachine{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "kubevirt.io/v1", Kind: "VirtualMachine"},
+			ObjectMeta: metav1.ObjectMeta{Name: "test-vm", Namespace: "default"},
+			Spec: kubevirtiov1.VirtualMachineSpec{
+				Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+					Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+						Domain: kubevirtiov1.DomainSpec{
+							CPU: &kubevirtiov1.CPU{Cores: 2},
+						},
+						Volumes: []kubevirtiov1.Volume{{Name: "volume1"}},
+					},
+				},
+			},
+		}
+		newVM = oldVM.DeepCopy()
+	})
+
+	It("should annotate allowed updates with the changed categories", func() {
+		mockPerm.permissions["virtualmachines/storage-admin"] = true
+		newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+
+		resp := handler.Handle(context.Background(), updateRequest(oldVM, newVM))
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.AuditAnnotations).To(Equal(map[string]string{
+			AuditAnnotationDecision:          "allowed",
+			AuditAnnotationCategoriesChanged: "storage",
+		}))
+	})
+
+	It("should annotate denied updates with every changed category", func() {
+		mockPerm.permissions["virtualmachines/storage-admin"] = true
+		newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+		newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+
+		resp := handler.Handle(context.Background(), updateRequest(oldVM, newVM))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.AuditAnnotations).To(Equal(map[string]string{
+			AuditAnnotationDecision:          "denied",
+			AuditAnnotationReasonCode:        "COMPUTE_FORBIDDEN",
+			AuditAnnotationCategoriesChanged: "compute,storage",
+		}))
+	})
+
+	It("should return the reason code as the reason of the admission response", func() {
+		mockPerm.permissions["virtualmachines/storage-admin"] = true
+		newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+		resp := handler.Handle(context.Background(), updateRequest(oldVM, newVM))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Code).To(Equal(int32(http.StatusForbidden)))
+		Expect(resp.Result.Reason).To(Equal(metav1.StatusReason(CategoryReasonCode("compute"))))
+		Expect(resp.Result.Message).To(Equal("user does not have permission to modify one or more spec fields of VirtualMachine default/test-vm"))
+	})
+
+	It("should annotate requests denied before validation with an internal error", func() {
+		req := updateRequest(oldVM, newVM)
+		req.Object.Raw = []byte("{")
+
+		resp := handler.Handle(context.Background(), req)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.AuditAnnotations).To(HaveKeyWithValue(AuditAnnotationReasonCode, string(ReasonCodeInternalError)))
+	})
+
+	It("should record changed categories for full-admin users", func() {
+		mockPerm.permissions["virtualmachines/full-admin"] = true
+		newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+		resp := handler.Handle(context.Background(), updateRequest(oldVM, newVM))
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.AuditAnnotations).To(HaveKeyWithValue(AuditAnnotationCategoriesChanged, "compute"))
+	})
+
+	It("should only annotate the decision for no-op updates", func() {
+		resp := handler.Handle(context.Background(), updateRequest(oldVM, newVM))
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.AuditAnnotations).To(Equal(map[string]string{
+			AuditAnnotationDecision: "allowed",
+		}))
+	})
+
+	Context("decisions metric", func() {
+		decisions := func(decision string) float64 {
+			return testutil.ToFloat64(decisionsTotal.WithLabelValues(decision))
+		}
+
+		It("should count updates allowed without granular roles separately", func() {
+			allowed, noGranularRoles := decisions(MetricDecisionAllowed), decisions(MetricDecisionAllowedNoGranularRoles)
+			newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+			resp := handler.Handle(context.Background(), updateRequest(oldVM, newVM))
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(decisions(MetricDecisionAllowedNoGranularRoles)).To(Equal(noGranularRoles + 1))
+			Expect(decisions(MetricDecisionAllowed)).To(Equal(allowed))
+		})
+
+		It("should count updates allowed by a granular role as allowed", func() {
+			allowed, noGranularRoles := decisions(MetricDecisionAllowed), decisions(MetricDecisionAllowedNoGranularRoles)
+			mockPerm.permissions["virtualmachines/storage-admin"] = true
+			newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+
+			resp := handler.Handle(context.Background(), updateRequest(oldVM, newVM))
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(decisions(MetricDecisionAllowed)).To(Equal(allowed + 1))
+			Expect(decisions(MetricDecisionAllowedNoGranularRoles)).To(Equal(noGranularRoles))
+		})
+
+		It("should count denied updates", func() {
+			denied := decisions(MetricDecisionDenied)
+			mockPerm.permissions["virtualmachines/storage-admin"] = true
+			newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+			resp := handler.Handle(context.Background(), updateRequest(oldVM, newVM))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(decisions(MetricDecisionDenied)).To(Equal(denied + 1))
+		})
+
+		It("should count denials by reason code", func() {
+			code := string(CategoryReasonCode("compute"))
+			denials := testutil.ToFloat64(denialsTotal.WithLabelValues(code))
+			mockPerm.permissions["virtualmachines/storage-admin"] = true
+			newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+			resp := handler.Handle(context.Background(), updateRequest(oldVM, newVM))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(testutil.ToFloat64(denialsTotal.WithLabelValues(code))).To(Equal(denials + 1))
+		})
+	})
+
+	It("should not record categories when audit annotations are not collected", func() {
+		Expect(auditRecordFrom(context.Background())).To(BeNil())
+	})
+})
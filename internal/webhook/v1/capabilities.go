@@ -0,0 +1,88 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AllSubresources returns full-admin followed by every subresource the default checkers may
+// require, including the ones only required in some contexts (e.g. compute-live-admin)
+func AllSubresources() []string {
+	subresources := []string{"virtualmachines/full-admin"}
+	for _, checker := range DefaultFieldCheckers() {
+		if compute, ok := checker.(*ComputePermissionChecker); ok {
+			compute.RequireLiveAdminWhenRunning = true
+		}
+		for _, subresource := range []string{checker.Subresource(), requiredSubresource(checker, DecisionContext{Running: true})} {
+			if !slices.Contains(subresources, subresource) {
+				subresources = append(subresources, subresource)
+			}
+		}
+	}
+	return subresources
+}
+
+// CapabilitiesFor reports, for every subresource in AllSubresources, whether the user may
+// update it on VMs in the namespace, or on the named VM when vmName is set. Grants of namespaced
+// Roles only apply within their namespace, so an empty namespace reports cluster-wide grants
+// only. It is meant for debugging RBAC ("what can I do"), e.g. from a kubectl plugin, not for
+// admission decisions.
+//
+// A user with an empty Username is checked with SelfSubjectAccessReviews, i.e. as the identity
+// the client authenticates with; any other user with SubjectAccessReviews, which requires
+// permission to create them.
+func CapabilitiesFor(ctx context.Context, c client.Client, userInfo authenticationv1.UserInfo, namespace, vmName string) (map[string]bool, error) {
+	var checker PermissionChecker = &SubjectAccessReviewPermissionChecker{Client: c}
+	if userInfo.Username == "" {
+		checker = &selfSubjectAccessReviewPermissionChecker{client: c}
+	}
+	return checker.PrefetchPermissions(ctx, userInfo, namespace, vmName, AllSubresources())
+}
+
+// selfSubjectAccessReviewPermissionChecker implements PermissionChecker using
+// SelfSubjectAccessReview. The userInfo passed in is ignored: the review is always
+// answered for the identity the client authenticates with.
+type selfSubjectAccessReviewPermissionChecker struct {
+	client client.Client
+}
+
+var _ PermissionChecker = &selfSubjectAccessReviewPermissionChecker{}
+
+func (p *selfSubjectAccessReviewPermissionChecker) CheckPermission(ctx context.Context, _ authenticationv1.UserInfo, namespace, vmName, subresource string) (bool, error) {
+	sar := &authv1.SelfSubjectAccessReview{
+		Spec: authv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: newSubjectAccessReview(authenticationv1.UserInfo{}, namespace, vmName, defaultSARGroup, subresource, defaultSARVerb).Spec.ResourceAttributes,
+		},
+	}
+	if err := p.client.Create(ctx, sar); err != nil {
+		return false, fmt.Errorf("failed to create SelfSubjectAccessReview: %w", err)
+	}
+
+	return sar.Status.Allowed, nil
+}
+
+// PrefetchPermissions sends one SelfSubjectAccessReview per subresource, concurrently
+func (p *selfSubjectAccessReviewPermissionChecker) PrefetchPermissions(ctx context.Context, userInfo authenticationv1.UserInfo, namespace, vmName string, subresources []string) (map[string]bool, error) {
+	return checkConcurrently(ctx, p, userInfo, namespace, vmName, subresources)
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var _ = Describe("Capabilities", func() {
+	Describe("AllSubresources", func() {
+		It("should list full-admin and every checker subresource once", func() {
+			subresources := AllSubresources()
+
+			Expect(subresources[0]).To(Equal("virtualmachines/full-admin"))
+			for _, checker := range DefaultFieldCheckers() {
+				Expect(subresources).To(ContainElement(checker.Subresource()))
+			}
+			Expect(subresources).To(ContainElement("virtualmachines/compute-live-admin"))
+			Expect(slices.Compact(slices.Sorted(slices.Values(subresources)))).To(HaveLen(len(subresources)))
+		})
+	})
+
+	Describe("CapabilitiesFor", func() {
+		var (
+			mu      sync.Mutex
+			reviews []client.Object
+			granted map[string]bool
+		)
+
+		BeforeEach(func() {
+			reviews = nil
+			granted = map[string]bool{
+				"virtualmachines/storage-admin": true,
+				"virtualmachines/cdrom-user":    true,
+			}
+		})
+
+		// grantingClient answers SubjectAccessReviews and SelfSubjectAccessReviews from granted
+		grantingClient := func() client.Client {
+			return fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					mu.Lock()
+					defer mu.Unlock()
+					reviews = append(reviews, obj)
+
+					switch review := obj.(type) {
+					case *authv1.SubjectAccessReview:
+						review.Status.Allowed = granted[review.Spec.ResourceAttributes.Resource]
+					case *authv1.SelfSubjectAccessReview:
+						review.Status.Allowed = granted[review.Spec.ResourceAttributes.Resource]
+					}
+					return nil
+				},
+			}).Build()
+		}
+
+		It("should report the granted subset for the user", func() {
+			userInfo := authenticationv1.UserInfo{Username: "test-user", Groups: []string{"test-group"}}
+
+			capabilities, err := CapabilitiesFor(context.Background(), grantingClient(), userInfo, "default", "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(capabilities).To(HaveLen(len(AllSubresources())))
+			for subresource, allowed := range capabilities {
+				Expect(allowed).To(Equal(granted[subresource]), subresource)
+			}
+
+			Expect(reviews).To(HaveLen(len(AllSubresources())))
+			for _, review := range reviews {
+				sar, ok := review.(*authv1.SubjectAccessReview)
+				Expect(ok).To(BeTrue())
+				Expect(sar.Spec.User).To(Equal("test-user"))
+				Expect(sar.Spec.Groups).To(Equal([]string{"test-group"}))
+				Expect(sar.Spec.ResourceAttributes.Namespace).To(Equal("default"))
+				Expect(sar.Spec.ResourceAttributes.Name).To(BeEmpty())
+			}
+		})
+
+		It("should review the named VM", func() {
+			userInfo := authenticationv1.UserInfo{Username: "test-user"}
+
+			_, err := CapabilitiesFor(context.Background(), grantingClient(), userInfo, "default", "test-vm")
+			Expect(err).ToNot(HaveOccurred())
+			for _, review := range reviews {
+				attributes := review.(*authv1.SubjectAccessReview).Spec.ResourceAttributes
+				Expect(attributes.Namespace).To(Equal("default"))
+				Expect(attributes.Name).To(Equal("test-vm"))
+			}
+		})
+
+		It("should review cluster-wide grants without a namespace", func() {
+			userInfo := authenticationv1.UserInfo{Username: "test-user"}
+
+			_, err := CapabilitiesFor(context.Background(), grantingClient(), userInfo, "", "")
+			Expect(err).ToNot(HaveOccurred())
+			for _, review := range reviews {
+				attributes := review.(*authv1.SubjectAccessReview).Spec.ResourceAttributes
+				Expect(attributes.Namespace).To(BeEmpty())
+				Expect(attributes.Name).To(BeEmpty())
+			}
+		})
+
+		It("should use SelfSubjectAccessReviews without a username", func() {
+			capabilities, err := CapabilitiesFor(context.Background(), grantingClient(), authenticationv1.UserInfo{}, "default", "test-vm")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(capabilities).To(HaveKeyWithValue("virtualmachines/storage-admin", true))
+			Expect(capabilities).To(HaveKeyWithValue("virtualmachines/full-admin", false))
+
+			for _, review := range reviews {
+				Expect(review).To(BeAssignableToTypeOf(&authv1.SelfSubjectAccessReview{}))
+				attributes := review.(*authv1.SelfSubjectAccessReview).Spec.ResourceAttributes
+				Expect(attributes.Namespace).To(Equal("default"))
+				Expect(attributes.Name).To(Equal("test-vm"))
+			}
+		})
+
+		It("should fail when a review fails", func() {
+			c := fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					return apierrors.NewForbidden(authv1.Resource("subjectaccessreviews"), "", nil)
+				},
+			}).Build()
+
+			_, err := CapabilitiesFor(context.Background(), c, authenticationv1.UserInfo{Username: "test-user"}, "default", "")
+			Expect(err).To(MatchError(ContainSubstring("failed to create SubjectAccessReview")))
+		})
+	})
+})
@@ -0,0 +1,67 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"slices"
+	"strings"
+
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+)
+
+// changedControlAnnotations returns the sorted keys of control annotations added, removed or
+// modified by the update, on the VM and on its template. Control annotations are read by
+// KubeVirt or other tooling (e.g. the descheduler) and can change the VM's behavior like a spec
+// field, so this guards against spec changes hidden inside annotations.
+func (v *VirtualMachineCustomValidator) changedControlAnnotations(oldVM, newVM *kubevirtiov1.VirtualMachine) []string {
+	if len(v.ControlAnnotationPrefixes) == 0 {
+		return nil
+	}
+
+	changed := v.changedAnnotationsWithPrefix(oldVM.Annotations, newVM.Annotations)
+	if oldVM.Spec.Template != nil && newVM.Spec.Template != nil {
+		changed = append(changed, v.changedAnnotationsWithPrefix(oldVM.Spec.Template.ObjectMeta.Annotations, newVM.Spec.Template.ObjectMeta.Annotations)...)
+	}
+	slices.Sort(changed)
+	return slices.Compact(changed)
+}
+
+// changedAnnotationsWithPrefix returns the keys matching a control annotation prefix whose
+// value differs between the two annotation maps, including keys present in only one of them
+func (v *VirtualMachineCustomValidator) changedAnnotationsWithPrefix(oldAnnotations, newAnnotations map[string]string) []string {
+	var changed []string
+	for _, annotations := range []map[string]string{oldAnnotations, newAnnotations} {
+		for key := range annotations {
+			if !v.isControlAnnotation(key) {
+				continue
+			}
+			oldValue, inOld := oldAnnotations[key]
+			newValue, inNew := newAnnotations[key]
+			if inOld != inNew || oldValue != newValue {
+				changed = append(changed, key)
+			}
+		}
+	}
+	return changed
+}
+
+// isControlAnnotation reports whether the annotation key starts with a control annotation prefix
+func (v *VirtualMachineCustomValidator) isControlAnnotation(key string) bool {
+	return slices.ContainsFunc(v.ControlAnnotationPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(key, prefix)
+	})
+}
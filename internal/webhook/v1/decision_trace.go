@@ -0,0 +1,183 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+)
+
+// DecisionTrace is the JSON-serializable record of how ValidateUpdate decided an update, for
+// tools explaining a denial (e.g. which role the user would need)
+type DecisionTrace struct {
+	// Allowed is the decision, Reason the denial message and ReasonCode its classification
+	Allowed    bool       `json:"allowed"`
+	Reason     string     `json:"reason,omitempty"`
+	ReasonCode ReasonCode `json:"reasonCode,omitempty"`
+
+	// FullAdmin reports whether virtualmachines/full-admin allowed every change
+	FullAdmin bool `json:"fullAdmin"`
+
+	// Categories has one entry per field checker, in checker order. It is empty when the
+	// decision was made before the categories were evaluated (e.g. group short-circuits,
+	// no-op updates).
+	Categories []CategoryTrace `json:"categories,omitempty"`
+
+	// Granted lists the reviewed subresources the user holds and Needed those a denial is
+	// about, e.g. that the user holds storage-admin but the update changes compute. Reviews
+	// evaluate the user together with their groups, so a grant is not attributed to either.
+	// When a subset and its superset are both needed, either of them suffices. Needed is
+	// empty when no role permits the update (e.g. denied groups, policy-immutable fields).
+	Granted []string `json:"granted,omitempty"`
+	Needed  []string `json:"needed,omitempty"`
+
+	// Breakdown summarizes Granted and Needed for a denial, see PermissionBreakdown
+	Breakdown string `json:"breakdown,omitempty"`
+}
+
+// CategoryTrace records the decision for one field category
+type CategoryTrace struct {
+	Name string `json:"name"`
+
+	// Changed compares the unmodified objects, so a subset and its superset can both be changed
+	Changed bool `json:"changed"`
+
+	// Subresource is the subresource required for the category in the VM's current state,
+	// empty when it was not checked (full-admin, a decision before step 2, or an unchanged
+	// category whose review was not needed)
+	Subresource string `json:"subresource,omitempty"`
+	Granted     bool   `json:"granted"`
+
+	// Neutralized reports whether the category's changes were permitted and neutralized
+	Neutralized bool `json:"neutralized"`
+}
+
+type decisionTraceKey struct{}
+
+// WithDecisionTrace returns a context in which ValidateUpdate records its decision in trace
+func WithDecisionTrace(ctx context.Context, trace *DecisionTrace) context.Context {
+	return context.WithValue(ctx, decisionTraceKey{}, trace)
+}
+
+// decisionTraceFrom returns the decision trace of the request, or nil when none is recorded
+func decisionTraceFrom(ctx context.Context) *DecisionTrace {
+	trace, _ := ctx.Value(decisionTraceKey{}).(*DecisionTrace)
+	return trace
+}
+
+// Explain evaluates the update like ValidateUpdate and returns the trace of its decision. The
+// context must carry the admission request of the user to explain the decision for.
+func (v *VirtualMachineCustomValidator) Explain(ctx context.Context, oldVM, newVM *kubevirtiov1.VirtualMachine) *DecisionTrace {
+	trace := &DecisionTrace{}
+	_, _ = v.ValidateUpdate(WithDecisionTrace(ctx, trace), oldVM, newVM)
+	return trace
+}
+
+// recordChanges adds a category for every checker, with whether the update changes it
+func (t *DecisionTrace) recordChanges(oldVM, newVM *kubevirtiov1.VirtualMachine, checkers []FieldPermissionChecker) {
+	if t == nil {
+		return
+	}
+	changes := CategorizeChanges(oldVM, newVM, checkers)
+	t.Categories = make([]CategoryTrace, 0, len(checkers))
+	for _, checker := range checkers {
+		t.Categories = append(t.Categories, CategoryTrace{Name: checker.Name(), Changed: changes[checker.Name()]})
+	}
+}
+
+// recordPermissions sets the required subresource of every category whose subresource was
+// checked, and whether it is granted
+func (t *DecisionTrace) recordPermissions(checkers []FieldPermissionChecker, dc DecisionContext, permissions map[string]bool) {
+	if t == nil {
+		return
+	}
+	for _, checker := range checkers {
+		subresource := requiredSubresource(checker, dc)
+		granted, checked := permissions[subresource]
+		if category := t.category(checker.Name()); category != nil && checked {
+			category.Subresource = subresource
+			category.Granted = granted
+		}
+	}
+	t.recordGranted(permissions)
+}
+
+// recordGranted sets the subresources the user was granted, sorted
+func (t *DecisionTrace) recordGranted(permissions map[string]bool) {
+	if t == nil {
+		return
+	}
+	t.Granted = nil
+	for subresource, granted := range permissions {
+		if granted {
+			t.Granted = append(t.Granted, subresource)
+		}
+	}
+	sort.Strings(t.Granted)
+}
+
+// PermissionBreakdown describes the granted and needed subresources of a denial for
+// explanations, e.g. "granted virtualmachines/storage-admin, needed virtualmachines/compute-admin"
+func (t *DecisionTrace) PermissionBreakdown() string {
+	granted := "none"
+	if len(t.Granted) > 0 {
+		granted = strings.Join(t.Granted, ", ")
+	}
+	if len(t.Needed) == 0 {
+		return fmt.Sprintf("granted %s, no subresource permits the update", granted)
+	}
+	return fmt.Sprintf("granted %s, needed %s", granted, strings.Join(t.Needed, ", "))
+}
+
+// recordNeutralized marks the category's changes as permitted and neutralized
+func (t *DecisionTrace) recordNeutralized(name string) {
+	if category := t.category(name); category != nil {
+		category.Neutralized = true
+	}
+}
+
+// finish records the decision
+func (t *DecisionTrace) finish(decisionErr error) {
+	if t == nil {
+		return
+	}
+	t.Allowed = decisionErr == nil
+	t.Reason = ""
+	t.ReasonCode = ReasonCodeOf(decisionErr)
+	t.Needed = neededSubresourcesOf(decisionErr)
+	t.Breakdown = ""
+	if decisionErr != nil {
+		t.Reason = decisionErr.Error()
+		t.Breakdown = t.PermissionBreakdown()
+	}
+}
+
+func (t *DecisionTrace) category(name string) *CategoryTrace {
+	if t == nil {
+		return nil
+	}
+	for i := range t.Categories {
+		if t.Categories[i].Name == name {
+			return &t.Categories[i]
+		}
+	}
+	return nil
+}
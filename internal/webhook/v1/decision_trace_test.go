@@ -0,0 +1,270 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("Decision trace", func() {
+	var (
+		validator *VirtualMachineCustomValidator
+		mockPerm  *MockPermissionChecker
+		ctx       context.Context
+		oldVM     *kubevirtiov1.VirtualMachine
+		newVM     *kubevirtiov1.VirtualMachine
+	)
+
+	BeforeEach(func() {
+		mockPerm = &MockPermissionChecker{permissions: make(map[string]bool)}
+		validator = &VirtualMachineCustomValidator{
+			FieldCheckers:     DefaultFieldCheckers(),
+			PermissionChecker: mockPerm,
+		}
+		ctx = admission.NewContextWithRequest(context.Background(), admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				UserInfo: authenticationv1.UserInfo{Username: "test-user"},
+			},
+		})
+		oldVM = &kubevirtiov1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-vm", Namespace: "default"},
+			Spec: kubevirtiov1.VirtualMachineSpec{
+				Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+					Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+						Domain: kubevirtiov1.DomainSpec{CPU: &kubevirtiov1.CPU{Cores: 2}},
+					},
+				},
+			},
+		}
+		newVM = oldVM.DeepCopy()
+		newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+		newVM.Spec.Template.Spec.Volumes = []kubevirtiov1.Volume{{
+			Name:         "data",
+			VolumeSource: kubevirtiov1.VolumeSource{DataVolume: &kubevirtiov1.DataVolumeSource{Name: "data"}},
+		}}
+	})
+
+	categoryNamed := func(trace *DecisionTrace, name string) CategoryTrace {
+		for _, category := range trace.Categories {
+			if category.Name == name {
+				return category
+			}
+		}
+		Fail("no category " + name + " in the trace")
+		return CategoryTrace{}
+	}
+
+	It("should trace a storage and compute change with only storage granted", func() {
+		mockPerm.permissions["virtualmachines/storage-admin"] = true
+
+		trace := validator.Explain(ctx, oldVM, newVM)
+
+		Expect(trace.Allowed).To(BeFalse())
+		Expect(trace.Reason).To(ContainSubstring("test-vm"))
+		Expect(trace.FullAdmin).To(BeFalse())
+		Expect(trace.Categories).To(HaveLen(len(validator.FieldCheckers)))
+		Expect(categoryNamed(trace, "storage")).To(Equal(CategoryTrace{
+			Name: "storage", Changed: true, Subresource: "virtualmachines/storage-admin", Granted: true, Neutralized: true,
+		}))
+		Expect(categoryNamed(trace, "compute")).To(Equal(CategoryTrace{
+			Name: "compute", Changed: true, Subresource: "virtualmachines/compute-admin", Granted: false, Neutralized: false,
+		}))
+		// An unchanged category is not reviewed once a changed one opted the user in
+		Expect(categoryNamed(trace, "network")).To(Equal(CategoryTrace{Name: "network", Changed: false}))
+	})
+
+	It("should match the decision of ValidateUpdate", func() {
+		mockPerm.permissions["virtualmachines/storage-admin"] = true
+		mockPerm.permissions["virtualmachines/compute-admin"] = true
+
+		trace := &DecisionTrace{}
+		_, err := validator.ValidateUpdate(WithDecisionTrace(ctx, trace), oldVM, newVM)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(trace.Allowed).To(BeTrue())
+		Expect(trace.Reason).To(BeEmpty())
+		Expect(categoryNamed(trace, "compute").Neutralized).To(BeTrue())
+	})
+
+	It("should record full-admin without checking subresources", func() {
+		mockPerm.permissions["virtualmachines/full-admin"] = true
+
+		trace := validator.Explain(ctx, oldVM, newVM)
+
+		Expect(trace.Allowed).To(BeTrue())
+		Expect(trace.FullAdmin).To(BeTrue())
+		Expect(categoryNamed(trace, "compute")).To(Equal(CategoryTrace{Name: "compute", Changed: true}))
+	})
+
+	It("should serialize to JSON", func() {
+		mockPerm.permissions["virtualmachines/storage-admin"] = true
+
+		data, err := json.Marshal(validator.Explain(ctx, oldVM, newVM))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring(
+			`{"name":"compute","changed":true,"subresource":"virtualmachines/compute-admin","granted":false,"neutralized":false}`))
+		Expect(string(data)).To(HavePrefix(`{"allowed":false,"reason":`))
+	})
+
+	Context("permission breakdown", func() {
+		It("should report the granted and the needed subresources of a denial", func() {
+			mockPerm.permissions["virtualmachines/storage-admin"] = true
+
+			trace := validator.Explain(ctx, oldVM, newVM)
+
+			Expect(trace.Allowed).To(BeFalse())
+			Expect(trace.Granted).To(Equal([]string{"virtualmachines/storage-admin"}))
+			Expect(trace.Needed).To(Equal([]string{"virtualmachines/compute-admin"}))
+			Expect(trace.Breakdown).To(Equal("granted virtualmachines/storage-admin, needed virtualmachines/compute-admin"))
+		})
+
+		It("should report every missing category with ReportAllMissingPermissions", func() {
+			validator.ReportAllMissingPermissions = true
+			mockPerm.permissions["virtualmachines/network-admin"] = true
+
+			trace := validator.Explain(ctx, oldVM, newVM)
+
+			Expect(trace.Allowed).To(BeFalse())
+			Expect(trace.Granted).To(Equal([]string{"virtualmachines/network-admin"}))
+			Expect(trace.Needed).To(Equal([]string{"virtualmachines/compute-admin", "virtualmachines/storage-admin"}))
+		})
+
+		It("should report full-admin as needed for fields no granular role covers", func() {
+			mockPerm.permissions["virtualmachines/compute-admin"] = true
+			mockPerm.permissions["virtualmachines/storage-admin"] = true
+			newVM.Spec.Template.Spec.Domain.Chassis = &kubevirtiov1.Chassis{Asset: "asset-1"}
+
+			trace := validator.Explain(ctx, oldVM, newVM)
+
+			Expect(trace.ReasonCode).To(Equal(ReasonCodeFullAdminRequired))
+			Expect(trace.Granted).To(Equal([]string{"virtualmachines/compute-admin", "virtualmachines/storage-admin"}))
+			Expect(trace.Needed).To(Equal([]string{"virtualmachines/full-admin"}))
+		})
+
+		It("should report nothing needed for an allowed update", func() {
+			mockPerm.permissions["virtualmachines/compute-admin"] = true
+			mockPerm.permissions["virtualmachines/storage-admin"] = true
+
+			trace := validator.Explain(ctx, oldVM, newVM)
+
+			Expect(trace.Allowed).To(BeTrue())
+			Expect(trace.Needed).To(BeEmpty())
+			Expect(trace.Breakdown).To(BeEmpty())
+		})
+
+		It("should report full-admin as needed for a frozen VM", func() {
+			mockPerm.permissions["virtualmachines/compute-admin"] = true
+			mockPerm.permissions["virtualmachines/storage-admin"] = true
+			oldVM.Annotations = map[string]string{FrozenAnnotation: "true"}
+			newVM.Annotations = map[string]string{FrozenAnnotation: "true"}
+
+			trace := validator.Explain(ctx, oldVM, newVM)
+
+			Expect(trace.ReasonCode).To(Equal(ReasonCodeFrozen))
+			Expect(trace.Needed).To(Equal([]string{"virtualmachines/full-admin"}))
+			Expect(trace.Breakdown).To(Equal("granted none, needed virtualmachines/full-admin"))
+		})
+
+		It("should report full-admin as needed for a change to a control annotation", func() {
+			validator.ControlAnnotationPrefixes = []string{"example.com/"}
+			mockPerm.permissions["virtualmachines/compute-admin"] = true
+			newVM.Annotations = map[string]string{"example.com/control": "on"}
+
+			trace := validator.Explain(ctx, oldVM, newVM)
+
+			Expect(trace.ReasonCode).To(Equal(ReasonCodeControlAnnotationForbidden))
+			Expect(trace.Needed).To(Equal([]string{"virtualmachines/full-admin"}))
+		})
+
+		It("should report full-admin as needed for too many changed categories", func() {
+			validator.MaxChangedCategories = 1
+			mockPerm.permissions["virtualmachines/compute-admin"] = true
+			mockPerm.permissions["virtualmachines/storage-admin"] = true
+
+			trace := validator.Explain(ctx, oldVM, newVM)
+
+			Expect(trace.ReasonCode).To(Equal(ReasonCodeTooManyCategories))
+			Expect(trace.Needed).To(Equal([]string{"virtualmachines/full-admin"}))
+			Expect(trace.Breakdown).To(Equal(
+				"granted virtualmachines/compute-admin, virtualmachines/storage-admin, needed virtualmachines/full-admin"))
+		})
+
+		It("should report full-admin as needed for immutable fields full-admin may change", func() {
+			validator.ImmutableFields = &ImmutableFieldsChecker{Paths: []string{"spec.template.spec.domain.cpu.cores"}, AllowFullAdmin: true}
+
+			trace := validator.Explain(ctx, oldVM, newVM)
+
+			Expect(trace.ReasonCode).To(Equal(ReasonCodeImmutableField))
+			Expect(trace.Needed).To(Equal([]string{"virtualmachines/full-admin"}))
+		})
+
+		It("should report that no subresource permits a change to fields immutable for everyone", func() {
+			validator.ImmutableFields = &ImmutableFieldsChecker{Paths: []string{"spec.template.spec.domain.cpu.cores"}}
+
+			trace := validator.Explain(ctx, oldVM, newVM)
+
+			Expect(trace.ReasonCode).To(Equal(ReasonCodeImmutableField))
+			Expect(trace.Needed).To(BeEmpty())
+			Expect(trace.Breakdown).To(Equal("granted none, no subresource permits the update"))
+		})
+
+		It("should report the mapped subresource as needed for a label change", func() {
+			validator.LabelSubresources = map[string]string{"example.com/tier": "virtualmachines/tier-admin"}
+			mockPerm.permissions["virtualmachines/compute-admin"] = true
+			mockPerm.permissions["virtualmachines/storage-admin"] = true
+			newVM.Labels = map[string]string{"example.com/tier": "gold"}
+
+			trace := validator.Explain(ctx, oldVM, newVM)
+
+			Expect(trace.ReasonCode).To(Equal(ReasonCodeLabelForbidden))
+			Expect(trace.Needed).To(Equal([]string{"virtualmachines/tier-admin"}))
+		})
+
+		It("should report the changed categories as needed in strict mode", func() {
+			validator.StrictMode = true
+
+			trace := validator.Explain(ctx, oldVM, newVM)
+
+			Expect(trace.ReasonCode).To(Equal(ReasonCodeStrictNoRole))
+			Expect(trace.Needed).To(Equal([]string{"virtualmachines/compute-admin", "virtualmachines/storage-admin"}))
+		})
+
+		It("should serialize to JSON", func() {
+			mockPerm.permissions["virtualmachines/storage-admin"] = true
+
+			data, err := json.Marshal(validator.Explain(ctx, oldVM, newVM))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(HaveSuffix(
+				`"granted":["virtualmachines/storage-admin"],"needed":["virtualmachines/compute-admin"],` +
+					`"breakdown":"granted virtualmachines/storage-admin, needed virtualmachines/compute-admin"}`))
+		})
+	})
+
+	It("should not be recorded without a trace in the context", func() {
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
@@ -0,0 +1,132 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+	instancetypeapi "kubevirt.io/api/instancetype"
+)
+
+// defaultArchitecture is KubeVirt's default architecture when the KubeVirt CR sets none
+const defaultArchitecture = "amd64"
+
+// defaultMachineTypes are KubeVirt's default machine types of each architecture when the
+// KubeVirt CR sets none
+var defaultMachineTypes = map[string]string{
+	"amd64":   "q35",
+	"arm64":   "virt",
+	"s390x":   "s390-ccw-virtio",
+	"ppc64le": "pseries",
+}
+
+// ClusterDefaults are the architecture and machine types KubeVirt's mutating webhook defaults,
+// as configured in the KubeVirt CR (spec.configuration.architectureConfiguration)
+type ClusterDefaults struct {
+	// Architecture is the default architecture (empty uses KubeVirt's default, amd64)
+	Architecture string
+
+	// MachineTypes maps architectures to their default machine type (nil uses KubeVirt's defaults)
+	MachineTypes map[string]string
+}
+
+// architecture returns the default architecture
+func (d ClusterDefaults) architecture() string {
+	if d.Architecture == "" {
+		return defaultArchitecture
+	}
+	return d.Architecture
+}
+
+// machineType returns the default machine type of the architecture, empty if it has none
+func (d ClusterDefaults) machineType(architecture string) string {
+	if d.MachineTypes == nil {
+		return defaultMachineTypes[architecture]
+	}
+	return d.MachineTypes[architecture]
+}
+
+// ParseMachineTypes parses <architecture>=<machine type> entries (e.g. amd64=q35)
+func ParseMachineTypes(entries []string) (map[string]string, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	machineTypes := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		architecture, machineType, found := strings.Cut(entry, "=")
+		architecture, machineType = strings.TrimSpace(architecture), strings.TrimSpace(machineType)
+		if !found || architecture == "" || machineType == "" {
+			return nil, fmt.Errorf("invalid default machine type %q, must be <architecture>=<machine type>", entry)
+		}
+		if _, duplicate := machineTypes[architecture]; duplicate {
+			return nil, fmt.Errorf("duplicate default machine type architecture %q", architecture)
+		}
+		machineTypes[architecture] = machineType
+	}
+	return machineTypes, nil
+}
+
+// backfillDefaults returns oldVM with the fields KubeVirt's mutating webhook defaults copied from
+// newVM wherever oldVM leaves them unset and newVM holds the cluster default. The mutating
+// webhook runs before this one and only defaults the new object, so a VM stored before a default
+// existed would otherwise show the default as a user change. Only unset fields are backfilled,
+// and only with the default value: changing a value that is already set, or setting another
+// one, still counts. oldVM is copied before being modified and returned as is if nothing is unset.
+//
+// Map key order and other serialization differences (e.g. from JSON patches) need no handling,
+// since both objects are decoded into typed structs before they are compared.
+func backfillDefaults(oldVM, newVM *kubevirtiov1.VirtualMachine, defaults ClusterDefaults) *kubevirtiov1.VirtualMachine {
+	backfilled := oldVM
+	backfill := func() *kubevirtiov1.VirtualMachine {
+		if backfilled == oldVM {
+			backfilled = oldVM.DeepCopy()
+		}
+		return backfilled
+	}
+
+	if oldVM.Spec.Template != nil && newVM.Spec.Template != nil {
+		oldSpec := &oldVM.Spec.Template.Spec
+		newSpec := &newVM.Spec.Template.Spec
+
+		// The architecture and machine type default to cluster settings, any other value is a change
+		if oldSpec.Architecture == "" && newSpec.Architecture == defaults.architecture() {
+			backfill().Spec.Template.Spec.Architecture = newSpec.Architecture
+		}
+		architecture := newSpec.Architecture
+		if architecture == "" {
+			architecture = defaults.architecture()
+		}
+		if machineType := defaults.machineType(architecture); oldSpec.Domain.Machine == nil && newSpec.Domain.Machine != nil &&
+			machineType != "" && newSpec.Domain.Machine.Type == machineType {
+			backfill().Spec.Template.Spec.Domain.Machine = newSpec.Domain.Machine.DeepCopy()
+		}
+	}
+
+	// Instancetype and preference kinds default to their cluster-wide variants
+	if oldVM.Spec.Instancetype != nil && newVM.Spec.Instancetype != nil &&
+		oldVM.Spec.Instancetype.Kind == "" && newVM.Spec.Instancetype.Kind == instancetypeapi.ClusterSingularResourceName {
+		backfill().Spec.Instancetype.Kind = newVM.Spec.Instancetype.Kind
+	}
+	if oldVM.Spec.Preference != nil && newVM.Spec.Preference != nil &&
+		oldVM.Spec.Preference.Kind == "" && newVM.Spec.Preference.Kind == instancetypeapi.ClusterSingularPreferenceResourceName {
+		backfill().Spec.Preference.Kind = newVM.Spec.Preference.Kind
+	}
+
+	return backfilled
+}
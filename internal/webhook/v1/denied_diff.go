@@ -0,0 +1,120 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+)
+
+// deniedDiffVerbosity is the log verbosity of the spec diff of denied updates, e.g. enabled
+// with --zap-log-level=3
+const deniedDiffVerbosity = 3
+
+// redactedValue replaces the values of sensitive fields in the spec diff
+const redactedValue = "<redacted>"
+
+// sensitiveFields are the fields whose values the spec diff never logs: the sources of secret
+// volumes and the user and network data of cloud-init volumes, wherever they appear
+var sensitiveFields = []string{"secret", "cloudInitNoCloud", "cloudInitConfigDrive"}
+
+// listSelector matches the list element selectors of a field path, e.g. [name=data] or [0]
+var listSelector = regexp.MustCompile(`\[[^\]]*\]`)
+
+// fieldDiff is a field of the spec diff of a denied update. Old or New is nil on the side
+// that does not set the field.
+type fieldDiff struct {
+	Path string      `json:"path"`
+	Old  interface{} `json:"old"`
+	New  interface{} `json:"new"`
+}
+
+// logDeniedSpecDiff logs the field paths and values that still differ between the spec of the
+// neutralized copies, i.e. the changes no permitted category covered, for analysing disputed
+// denials. It is off unless the log verbosity is at least deniedDiffVerbosity. Sensitive
+// fields are logged as changed, but with their values redacted.
+func logDeniedSpecDiff(oldCopy, newCopy, newVM *kubevirtiov1.VirtualMachine) {
+	log := virtualmachinelog.V(deniedDiffVerbosity)
+	if !log.Enabled() {
+		return
+	}
+
+	diff, err := deniedSpecDiff(oldCopy, newCopy)
+	if err != nil {
+		virtualmachinelog.Error(err, "Failed to compute the spec diff of a denied update", "name", newVM.GetName(), "namespace", newVM.GetNamespace())
+		return
+	}
+	log.Info("Spec diff of denied VirtualMachine update", "name", newVM.GetName(), "namespace", newVM.GetNamespace(), "diff", diff)
+}
+
+// deniedSpecDiff returns the redacted fields whose values differ between the specs of the VMs,
+// sorted by path
+func deniedSpecDiff(oldVM, newVM *kubevirtiov1.VirtualMachine) ([]fieldDiff, error) {
+	var diff []fieldDiff
+	err := diffVMFields(&kubevirtiov1.VirtualMachine{Spec: oldVM.Spec}, &kubevirtiov1.VirtualMachine{Spec: newVM.Spec},
+		func(path string, oldValue, newValue interface{}) {
+			diff = append(diff, fieldDiff{Path: path, Old: redactField(path, oldValue), New: redactField(path, newValue)})
+		})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(diff, func(i, j int) bool { return diff[i].Path < diff[j].Path })
+	return diff, nil
+}
+
+// redactField returns the value of the field path with sensitive values redacted: the whole
+// value if the path is within a sensitive field, otherwise the sensitive fields nested in it
+func redactField(path string, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	for _, segment := range strings.Split(listSelector.ReplaceAllString(path, ""), ".") {
+		if slices.Contains(sensitiveFields, segment) {
+			return redactedValue
+		}
+	}
+	return redactNested(value)
+}
+
+// redactNested returns a copy of the unstructured value with the values of sensitive fields
+// replaced, at any depth
+func redactNested(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(value))
+		for key, nested := range value {
+			if slices.Contains(sensitiveFields, key) {
+				redacted[key] = redactedValue
+				continue
+			}
+			redacted[key] = redactNested(nested)
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(value))
+		for i, nested := range value {
+			redacted[i] = redactNested(nested)
+		}
+		return redacted
+	default:
+		return value
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	"github.com/go-logr/logr/funcr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("Spec diff of denied updates", func() {
+	const userData = "#cloud-config\npassword: hunter2"
+
+	var (
+		validator *VirtualMachineCustomValidator
+		mockPerm  *MockPermissionChecker
+		ctx       context.Context
+		oldVM     *kubevirtiov1.VirtualMachine
+		newVM     *kubevirtiov1.VirtualMachine
+		logLines  []string
+	)
+
+	// captureLogs replaces the webhook logger with one recording the lines up to the verbosity
+	captureLogs := func(verbosity int) {
+		originalLog := virtualmachinelog
+		DeferCleanup(func() { virtualmachinelog = originalLog })
+		virtualmachinelog = funcr.New(func(prefix, args string) {
+			logLines = append(logLines, args)
+		}, funcr.Options{Verbosity: verbosity})
+	}
+
+	BeforeEach(func() {
+		logLines = nil
+		mockPerm = &MockPermissionChecker{permissions: map[string]bool{"virtualmachines/compute-admin": true}}
+		validator = &VirtualMachineCustomValidator{
+			FieldCheckers:     DefaultFieldCheckers(),
+			PermissionChecker: mockPerm,
+		}
+		ctx = admission.NewContextWithRequest(context.Background(), admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				UserInfo: authenticationv1.UserInfo{Username: "test-user"},
+			},
+		})
+		oldVM = &kubevirtiov1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-vm", Namespace: "default"},
+			Spec: kubevirtiov1.VirtualMachineSpec{
+				Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+					Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+						Domain: kubevirtiov1.DomainSpec{
+							CPU: &kubevirtiov1.CPU{Cores: 2},
+							Devices: kubevirtiov1.Devices{
+								Disks: []kubevirtiov1.Disk{{Name: "rootdisk"}, {Name: "cloudinit"}},
+							},
+						},
+						Volumes: []kubevirtiov1.Volume{
+							{Name: "rootdisk", VolumeSource: kubevirtiov1.VolumeSource{DataVolume: &kubevirtiov1.DataVolumeSource{Name: "root"}}},
+							{Name: "cloudinit", VolumeSource: kubevirtiov1.VolumeSource{CloudInitNoCloud: &kubevirtiov1.CloudInitNoCloudSource{UserData: "#cloud-config"}}},
+						},
+					},
+				},
+			},
+		}
+		newVM = oldVM.DeepCopy()
+	})
+
+	It("should log the fields no permitted category covered, with the values of secret and cloud-init sources redacted", func() {
+		captureLogs(deniedDiffVerbosity)
+		newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+		newVM.Spec.Template.Spec.Volumes[0].DataVolume.Name = "other-root"
+		newVM.Spec.Template.Spec.Volumes[1].CloudInitNoCloud.UserData = userData
+		newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
+			Name:         "credentials",
+			VolumeSource: kubevirtiov1.VolumeSource{Secret: &kubevirtiov1.SecretVolumeSource{SecretName: "db-password"}},
+		})
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).To(HaveOccurred())
+
+		Expect(logLines).To(ContainElement(And(
+			ContainSubstring(`"msg"="Spec diff of denied VirtualMachine update"`),
+			ContainSubstring(`"name"="test-vm"`),
+			ContainSubstring(`{"path"="spec.template.spec.volumes[name=rootdisk].dataVolume.name" "old"="root" "new"="other-root"}`),
+			ContainSubstring(`{"path"="spec.template.spec.volumes[name=cloudinit].cloudInitNoCloud.userData" "old"="<redacted>" "new"="<redacted>"}`),
+			// funcr logs map keys in random order, so the keys of the added volume are matched separately
+			ContainSubstring(`{"path"="spec.template.spec.volumes[name=credentials]" "old"=null "new"={`),
+			ContainSubstring(`"name"="credentials"`),
+			ContainSubstring(`"secret"="<redacted>"`),
+			// The permitted compute change was neutralized
+			Not(ContainSubstring("spec.template.spec.domain.cpu")),
+		)))
+		for _, line := range logLines {
+			Expect(line).ToNot(ContainSubstring("hunter2"))
+			Expect(line).ToNot(ContainSubstring("db-password"))
+		}
+	})
+
+	It("should not log the diff below its verbosity", func() {
+		captureLogs(deniedDiffVerbosity - 1)
+		newVM.Spec.Template.Spec.Volumes[0].DataVolume.Name = "other-root"
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).To(HaveOccurred())
+
+		Expect(logLines).ToNot(BeEmpty())
+		Expect(logLines).ToNot(ContainElement(ContainSubstring("Spec diff of denied VirtualMachine update")))
+	})
+
+	It("should not log the diff of an allowed update", func() {
+		captureLogs(deniedDiffVerbosity)
+		newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(logLines).ToNot(ContainElement(ContainSubstring("Spec diff of denied VirtualMachine update")))
+	})
+
+	It("should redact sensitive fields within and below a reported field", func() {
+		volume := oldVM.Spec.Template.Spec.Volumes[1].DeepCopy()
+		volume.CloudInitNoCloud.UserData = userData
+		unstructuredVolume, err := runtime.DefaultUnstructuredConverter.ToUnstructured(volume)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(redactField("spec.template.spec.volumes[1]", unstructuredVolume)).To(Equal(map[string]interface{}{
+			"name":             "cloudinit",
+			"cloudInitNoCloud": redactedValue,
+		}))
+		Expect(redactField("spec.template.spec.volumes[1].cloudInitNoCloud", "anything")).To(Equal(redactedValue))
+		Expect(redactField("spec.template.spec.volumes[name=secret-like].dataVolume.name", "root")).To(Equal("root"))
+	})
+})
@@ -0,0 +1,292 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/yaml"
+)
+
+// PolicyConfigKey is the ConfigMap data key holding the reloadable policy
+const PolicyConfigKey = "policy.yaml"
+
+// PolicyConfig is the part of the webhook configuration that can be changed at runtime through
+// a ConfigMap. Unset fields keep the value configured at startup; set fields replace it, so
+// e.g. `disabledCheckers: []` enables every checker even if --disabled-checkers disabled some.
+type PolicyConfig struct {
+	// StrictMode denies users without full-admin or any subresource permission
+	StrictMode *bool `json:"strictMode,omitempty"`
+
+	// DisabledCheckers lists FieldPermissionChecker names that are not run
+	DisabledCheckers *[]string `json:"disabledCheckers,omitempty"`
+
+	// AllowGroups lists groups whose members are always allowed
+	AllowGroups *[]string `json:"allowGroups,omitempty"`
+
+	// DenyGroups lists groups whose members are always denied
+	DenyGroups *[]string `json:"denyGroups,omitempty"`
+
+	// ImmutableFields lists field paths no one may change, except full-admin with ImmutableFieldsAllowFullAdmin
+	ImmutableFields *[]string `json:"immutableFields,omitempty"`
+
+	// ImmutableFieldsAllowFullAdmin lets full-admin users change the ImmutableFields
+	ImmutableFieldsAllowFullAdmin *bool `json:"immutableFieldsAllowFullAdmin,omitempty"`
+}
+
+// ParsePolicyConfig parses a YAML (or JSON) policy, rejecting unknown fields
+func ParsePolicyConfig(data []byte) (*PolicyConfig, error) {
+	config := &PolicyConfig{}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse policy: %w", err)
+	}
+	return config, nil
+}
+
+// Policy is the resolved reloadable policy a validator applies to a request
+type Policy struct {
+	StrictMode      bool
+	AllowGroups     []string
+	DenyGroups      []string
+	FieldCheckers   []FieldPermissionChecker
+	ImmutableFields *ImmutableFieldsChecker
+}
+
+// applyTo returns a copy of the validator that uses the policy. The validator itself is shared
+// by concurrent requests and never modified.
+func (p *Policy) applyTo(v *VirtualMachineCustomValidator) *VirtualMachineCustomValidator {
+	applied := *v
+	applied.StrictMode = p.StrictMode
+	applied.AllowGroups = p.AllowGroups
+	applied.DenyGroups = p.DenyGroups
+	applied.FieldCheckers = p.FieldCheckers
+	applied.ImmutableFields = p.ImmutableFields
+	return &applied
+}
+
+// PolicyStore holds a validator's current Policy. Apply resolves a PolicyConfig against the
+// startup policy and swaps it in atomically, so every request sees either the old or the new
+// policy as a whole. An invalid config is rejected and the last good policy stays in effect.
+type PolicyStore struct {
+	startup  Policy
+	checkers []FieldPermissionChecker
+	current  atomic.Pointer[Policy]
+}
+
+// NewPolicyStore returns a store holding the startup policy. checkers are all configured field
+// checkers, in order, from which a config's DisabledCheckers are dropped.
+func NewPolicyStore(startup Policy, checkers []FieldPermissionChecker) *PolicyStore {
+	store := &PolicyStore{startup: startup, checkers: checkers}
+	store.current.Store(&store.startup)
+	return store
+}
+
+// Load returns the current policy
+func (s *PolicyStore) Load() *Policy {
+	return s.current.Load()
+}
+
+// Apply validates the config and makes it the current policy, or returns an error and keeps
+// the current one. A nil config restores the startup policy.
+func (s *PolicyStore) Apply(config *PolicyConfig) error {
+	policy, err := s.resolve(config)
+	if err != nil {
+		return err
+	}
+	s.current.Store(policy)
+	return nil
+}
+
+// resolve returns the startup policy with the fields set in the config replaced
+func (s *PolicyStore) resolve(config *PolicyConfig) (*Policy, error) {
+	policy := s.startup
+	if config == nil {
+		return &policy, nil
+	}
+
+	if config.StrictMode != nil {
+		policy.StrictMode = *config.StrictMode
+	}
+	if config.AllowGroups != nil {
+		policy.AllowGroups = *config.AllowGroups
+	}
+	if config.DenyGroups != nil {
+		policy.DenyGroups = *config.DenyGroups
+	}
+
+	if config.DisabledCheckers != nil {
+		checkers, err := withoutCheckers(s.checkers, *config.DisabledCheckers)
+		if err != nil {
+			return nil, fmt.Errorf("invalid disabledCheckers: %w", err)
+		}
+		if err := validateCheckerOrder(checkers); err != nil {
+			return nil, fmt.Errorf("invalid disabledCheckers: %w", err)
+		}
+		policy.FieldCheckers = checkers
+	}
+
+	if config.ImmutableFields != nil || config.ImmutableFieldsAllowFullAdmin != nil {
+		immutable := &ImmutableFieldsChecker{}
+		if policy.ImmutableFields != nil {
+			*immutable = *policy.ImmutableFields
+		}
+		if config.ImmutableFields != nil {
+			for _, path := range *config.ImmutableFields {
+				if path == "" || slices.Contains(strings.Split(path, "."), "") {
+					return nil, fmt.Errorf("invalid immutableFields: %q is not a dot-separated field path", path)
+				}
+			}
+			immutable.Paths = *config.ImmutableFields
+		}
+		if config.ImmutableFieldsAllowFullAdmin != nil {
+			immutable.AllowFullAdmin = *config.ImmutableFieldsAllowFullAdmin
+		}
+		policy.ImmutableFields = immutable
+	}
+	return &policy, nil
+}
+
+// PolicyReloader watches a single ConfigMap and applies the policy under its PolicyConfigKey
+// to a PolicyStore whenever it changes. Invalid policies are logged and ignored, keeping the
+// last good policy; deleting the ConfigMap restores the startup policy.
+type PolicyReloader struct {
+	Client    kubernetes.Interface
+	ConfigMap types.NamespacedName
+	Store     *PolicyStore
+}
+
+var _ manager.LeaderElectionRunnable = &PolicyReloader{}
+
+// NeedLeaderElection reports false: every webhook replica serves requests, so every replica
+// must reload its policy
+func (r *PolicyReloader) NeedLeaderElection() bool {
+	return false
+}
+
+// Start watches the ConfigMap until the context is done
+func (r *PolicyReloader) Start(ctx context.Context) error {
+	configMaps := r.Client.CoreV1().ConfigMaps(r.ConfigMap.Namespace)
+	selector := fields.OneTermEqualSelector("metadata.name", r.ConfigMap.Name).String()
+	listWatch := &toolscache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = selector
+			return configMaps.List(ctx, options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = selector
+			return configMaps.Watch(ctx, options)
+		},
+	}
+	informer := toolscache.NewSharedIndexInformer(listWatch, &corev1.ConfigMap{}, 0, toolscache.Indexers{})
+
+	if _, err := informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj any) { r.reload(obj) },
+		UpdateFunc: func(_, obj any) { r.reload(obj) },
+		DeleteFunc: func(obj any) { r.restore(obj) },
+	}); err != nil {
+		return fmt.Errorf("failed to watch policy ConfigMap %s: %w", r.ConfigMap, err)
+	}
+
+	informer.Run(ctx.Done())
+	return nil
+}
+
+// reload applies the policy of an added or updated ConfigMap
+func (r *PolicyReloader) reload(obj any) {
+	configMap, ok := obj.(*corev1.ConfigMap)
+	if !ok || configMap.Name != r.ConfigMap.Name {
+		return
+	}
+	log := virtualmachinelog.WithValues("configMap", r.ConfigMap, "resourceVersion", configMap.ResourceVersion)
+
+	data, ok := configMap.Data[PolicyConfigKey]
+	if !ok {
+		log.Error(nil, "Policy ConfigMap has no policy, keeping the current policy", "key", PolicyConfigKey)
+		return
+	}
+	config, err := ParsePolicyConfig([]byte(data))
+	if err == nil {
+		err = r.Store.Apply(config)
+	}
+	if err != nil {
+		log.Error(err, "Rejected invalid policy, keeping the current policy")
+		return
+	}
+	log.Info("Applied policy from ConfigMap")
+}
+
+// restore reinstates the startup policy once the ConfigMap is deleted
+func (r *PolicyReloader) restore(obj any) {
+	if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if configMap, ok := obj.(*corev1.ConfigMap); !ok || configMap.Name != r.ConfigMap.Name {
+		return
+	}
+
+	// The startup policy was valid at startup, so restoring it cannot fail
+	_ = r.Store.Apply(nil)
+	virtualmachinelog.Info("Policy ConfigMap deleted, restored the startup policy", "configMap", r.ConfigMap)
+}
+
+// setupPolicyReloader applies the policy ConfigMap, if it exists, and registers a
+// PolicyReloader with the manager. An invalid policy at startup fails setup, as no earlier
+// policy from the ConfigMap could be kept instead.
+func setupPolicyReloader(mgr ctrl.Manager, key types.NamespacedName, startup Policy, checkers []FieldPermissionChecker) (*PolicyStore, error) {
+	store := NewPolicyStore(startup, checkers)
+
+	// The cache is not started yet, so read the ConfigMap directly from the API server
+	configMap := &corev1.ConfigMap{}
+	err := mgr.GetAPIReader().Get(context.Background(), key, configMap)
+	switch {
+	case apierrors.IsNotFound(err):
+	case err != nil:
+		return nil, fmt.Errorf("failed to get policy ConfigMap %s: %w", key, err)
+	case configMap.Data[PolicyConfigKey] != "":
+		config, err := ParsePolicyConfig([]byte(configMap.Data[PolicyConfigKey]))
+		if err == nil {
+			err = store.Apply(config)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid policy ConfigMap %s: %w", key, err)
+		}
+	}
+
+	clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client for policy ConfigMap %s: %w", key, err)
+	}
+	if err := mgr.Add(&PolicyReloader{Client: clientset, ConfigMap: key, Store: store}); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("Reloadable policy", func() {
+	var (
+		store    *PolicyStore
+		checkers []FieldPermissionChecker
+	)
+
+	BeforeEach(func() {
+		checkers = DefaultFieldCheckers()
+		store = NewPolicyStore(Policy{
+			AllowGroups:   []string{"startup-admins"},
+			FieldCheckers: checkers,
+		}, checkers)
+	})
+
+	Describe("ParsePolicyConfig", func() {
+		It("should parse a policy", func() {
+			config, err := ParsePolicyConfig([]byte(`
+strictMode: true
+disabledCheckers: ["console"]
+denyGroups: ["contractors"]
+`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*config.StrictMode).To(BeTrue())
+			Expect(*config.DisabledCheckers).To(Equal([]string{"console"}))
+			Expect(*config.DenyGroups).To(Equal([]string{"contractors"}))
+			Expect(config.AllowGroups).To(BeNil())
+		})
+
+		It("should reject unknown fields", func() {
+			_, err := ParsePolicyConfig([]byte(`strictMod: true`))
+			Expect(err).To(MatchError(ContainSubstring("failed to parse policy")))
+		})
+	})
+
+	Describe("PolicyStore", func() {
+		It("should replace set fields and keep the startup value of unset fields", func() {
+			Expect(store.Apply(&PolicyConfig{StrictMode: boolPtr(true), DisabledCheckers: &[]string{"console", "storage"}})).To(Succeed())
+
+			policy := store.Load()
+			Expect(policy.StrictMode).To(BeTrue())
+			Expect(policy.AllowGroups).To(Equal([]string{"startup-admins"}))
+			Expect(policy.FieldCheckers).To(HaveLen(len(checkers) - 2))
+		})
+
+		It("should keep the last good policy when a config is invalid", func() {
+			Expect(store.Apply(&PolicyConfig{StrictMode: boolPtr(true)})).To(Succeed())
+			good := store.Load()
+
+			Expect(store.Apply(&PolicyConfig{StrictMode: boolPtr(false), DisabledCheckers: &[]string{"no-such-checker"}})).To(
+				MatchError(ContainSubstring(`unknown field permission checker "no-such-checker"`)))
+			Expect(store.Apply(&PolicyConfig{ImmutableFields: &[]string{"spec..running"}})).To(
+				MatchError(ContainSubstring("is not a dot-separated field path")))
+			Expect(store.Load()).To(BeIdenticalTo(good))
+		})
+
+		It("should layer immutable field settings over the startup ones", func() {
+			store = NewPolicyStore(Policy{
+				ImmutableFields: &ImmutableFieldsChecker{Paths: []string{firmwareUUIDPath}, AllowFullAdmin: true},
+			}, checkers)
+
+			Expect(store.Apply(&PolicyConfig{ImmutableFieldsAllowFullAdmin: boolPtr(false)})).To(Succeed())
+			Expect(store.Load().ImmutableFields).To(Equal(&ImmutableFieldsChecker{Paths: []string{firmwareUUIDPath}}))
+		})
+
+		It("should restore the startup policy", func() {
+			Expect(store.Apply(&PolicyConfig{AllowGroups: &[]string{}})).To(Succeed())
+			Expect(store.Apply(nil)).To(Succeed())
+
+			Expect(store.Load().AllowGroups).To(Equal([]string{"startup-admins"}))
+		})
+	})
+
+	Describe("PolicyReloader", func() {
+		var (
+			clientset *fake.Clientset
+			validator *VirtualMachineCustomValidator
+			mockPerm  *MockPermissionChecker
+			ctx       context.Context
+			cancel    context.CancelFunc
+			oldVM     *kubevirtiov1.VirtualMachine
+			newVM     *kubevirtiov1.VirtualMachine
+			key       types.NamespacedName
+		)
+
+		// policyConfigMap returns the ConfigMap holding the policy
+		policyConfigMap := func(policy string) *corev1.ConfigMap {
+			return &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+				Data:       map[string]string{PolicyConfigKey: policy},
+			}
+		}
+
+		// validate runs ValidateUpdate for a user without any subresource permission
+		validate := func() error {
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			return err
+		}
+
+		BeforeEach(func() {
+			key = types.NamespacedName{Namespace: "kubevirt-rbac-webhook-system", Name: "webhook-policy"}
+			clientset = fake.NewClientset(policyConfigMap("strictMode: false"))
+			mockPerm = &MockPermissionChecker{permissions: make(map[string]bool)}
+			validator = &VirtualMachineCustomValidator{
+				FieldCheckers:     checkers,
+				PermissionChecker: mockPerm,
+				Policy:            store,
+			}
+
+			ctx, cancel = context.WithCancel(admission.NewContextWithRequest(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: "test-user"},
+				},
+			}))
+			DeferCleanup(func() { cancel() })
+
+			// The fake clientset does not replay changes made between the informer's list and
+			// watch, so changes are only made once the watch is established
+			watching := make(chan struct{})
+			var once sync.Once
+			clientset.PrependWatchReactor("configmaps", func(action k8stesting.Action) (bool, watch.Interface, error) {
+				w, err := clientset.Tracker().Watch(action.GetResource(), action.GetNamespace())
+				once.Do(func() { close(watching) })
+				return true, w, err
+			})
+			go func() {
+				defer GinkgoRecover()
+				reloader := &PolicyReloader{Client: clientset, ConfigMap: key, Store: store}
+				Expect(reloader.Start(ctx)).To(Succeed())
+			}()
+
+			oldVM = &kubevirtiov1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-vm", Namespace: "default"},
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{CPU: &kubevirtiov1.CPU{Cores: 2}},
+						},
+					},
+				},
+			}
+			newVM = oldVM.DeepCopy()
+			newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+			Eventually(watching).Should(BeClosed())
+		})
+
+		It("should apply an updated policy on the next ValidateUpdate", func() {
+			_, err := clientset.CoreV1().ConfigMaps(key.Namespace).Update(ctx, policyConfigMap("strictMode: true"), metav1.UpdateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(validate).Should(MatchError(ContainSubstring("no applicable VM subresource permission")))
+		})
+
+		It("should keep the last good policy when the ConfigMap becomes invalid", func() {
+			_, err := clientset.CoreV1().ConfigMaps(key.Namespace).Update(ctx, policyConfigMap("strictMode: true"), metav1.UpdateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Eventually(validate).Should(HaveOccurred())
+
+			invalid := policyConfigMap("strictMode: false\ndisabledCheckers: [no-such-checker]")
+			_, err = clientset.CoreV1().ConfigMaps(key.Namespace).Update(ctx, invalid, metav1.UpdateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Consistently(validate, "200ms").Should(HaveOccurred())
+		})
+
+		It("should restore the startup policy when the ConfigMap is deleted", func() {
+			_, err := clientset.CoreV1().ConfigMaps(key.Namespace).Update(ctx, policyConfigMap("allowGroups: []\nstrictMode: true"), metav1.UpdateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Eventually(validate).Should(HaveOccurred())
+
+			Expect(clientset.CoreV1().ConfigMaps(key.Namespace).Delete(ctx, key.Name, metav1.DeleteOptions{})).To(Succeed())
+			Eventually(validate).Should(Succeed())
+			Expect(store.Load().AllowGroups).To(Equal([]string{"startup-admins"}))
+		})
+
+		It("should ignore other ConfigMaps", func() {
+			other := policyConfigMap("strictMode: true")
+			other.Name = "other"
+			_, err := clientset.CoreV1().ConfigMaps(key.Namespace).Create(ctx, other, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Consistently(validate, "200ms").Should(Succeed())
+		})
+	})
+})
@@ -0,0 +1,218 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CategorizeFieldPaths returns, for each category the update changes, the JSON field paths
+// of the changes within that category, e.g. {"storage": ["spec.template.spec.volumes[name=data]"]}.
+// Unchanged categories are omitted. Like CategorizeChanges it compares the unmodified objects,
+// so a path can appear under both a subset and its superset.
+//
+// A checker's Neutralize is its diff: a path belongs to a category when it differs between
+// the VMs but no longer does once the checker has neutralized copies of them. Paths are
+// dot-separated; list elements are addressed by name ([name=x]) when every element has a
+// unique name and by index ([0]) otherwise. An added or removed list element is reported
+// as a whole, any other change down to the changed leaf field.
+func CategorizeFieldPaths(oldVM, newVM *kubevirtiov1.VirtualMachine, checkers []FieldPermissionChecker) (map[string][]string, error) {
+	changed, err := diffVMFieldPaths(oldVM, newVM)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make(map[string][]string)
+	for _, checker := range checkers {
+		if !checker.HasChanged(oldVM, newVM) {
+			continue
+		}
+		oldCopy, newCopy := oldVM.DeepCopy(), newVM.DeepCopy()
+		checker.Neutralize(oldCopy, newCopy)
+		remaining, err := diffVMFieldPaths(oldCopy, newCopy)
+		if err != nil {
+			return nil, err
+		}
+
+		var paths []string
+		for _, path := range changed {
+			if !slices.Contains(remaining, path) {
+				paths = append(paths, path)
+			}
+		}
+		if len(paths) > 0 {
+			categories[checker.Name()] = paths
+		}
+	}
+	return categories, nil
+}
+
+// diffVMFieldPaths returns the sorted field paths whose values differ between the VMs,
+// ignoring status
+func diffVMFieldPaths(oldVM, newVM *kubevirtiov1.VirtualMachine) ([]string, error) {
+	var paths []string
+	err := diffVMFields(oldVM, newVM, func(path string, _, _ interface{}) {
+		paths = append(paths, path)
+	})
+	sort.Strings(paths)
+	return paths, err
+}
+
+// diffVMFields reports each field path whose values differ between the VMs, ignoring status,
+// with the differing values (nil on the side that does not set the field)
+func diffVMFields(oldVM, newVM *kubevirtiov1.VirtualMachine, report fieldDiffReporter) error {
+	oldObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(oldVM)
+	if err != nil {
+		return fmt.Errorf("failed to convert VirtualMachine %s: %w", client.ObjectKeyFromObject(oldVM), err)
+	}
+	newObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(newVM)
+	if err != nil {
+		return fmt.Errorf("failed to convert VirtualMachine %s: %w", client.ObjectKeyFromObject(newVM), err)
+	}
+	delete(oldObj, "status")
+	delete(newObj, "status")
+
+	diffFieldPaths("", oldObj, newObj, report)
+	return nil
+}
+
+// fieldDiffReporter receives a differing field path with its old and new values
+type fieldDiffReporter func(path string, oldValue, newValue interface{})
+
+// diffFieldPaths reports the paths below prefix whose values differ. A map that is absent
+// on one side is descended into, so its set fields are reported individually.
+func diffFieldPaths(prefix string, oldValue, newValue interface{}, report fieldDiffReporter) {
+	if equality.Semantic.DeepEqual(oldValue, newValue) {
+		return
+	}
+
+	oldMap, oldIsMap := oldValue.(map[string]interface{})
+	newMap, newIsMap := newValue.(map[string]interface{})
+	if (oldIsMap || oldValue == nil) && (newIsMap || newValue == nil) {
+		keys := make(map[string]struct{}, len(oldMap)+len(newMap))
+		for key := range oldMap {
+			keys[key] = struct{}{}
+		}
+		for key := range newMap {
+			keys[key] = struct{}{}
+		}
+		for key := range keys {
+			path := key
+			if prefix != "" {
+				path = prefix + "." + key
+			}
+			diffFieldPaths(path, oldMap[key], newMap[key], report)
+		}
+		return
+	}
+
+	oldList, oldIsList := oldValue.([]interface{})
+	newList, newIsList := newValue.([]interface{})
+	if (oldIsList || oldValue == nil) && (newIsList || newValue == nil) {
+		diffListFieldPaths(prefix, oldList, newList, report)
+		return
+	}
+
+	report(prefix, oldValue, newValue)
+}
+
+// unorderedLists are the paths of the named lists whose order has no meaning (see sameAsSet)
+var unorderedLists = []string{
+	"spec.template.spec.volumes",
+	"spec.template.spec.networks",
+	"spec.template.spec.domain.cpu.features",
+}
+
+// diffListFieldPaths matches list elements by name when every element of both lists has a
+// unique name, and by index otherwise. A changed order of the elements of both lists is
+// reported at the list itself, with the names in order, unless the list is unordered.
+func diffListFieldPaths(prefix string, oldList, newList []interface{}, report fieldDiffReporter) {
+	oldByName, oldNamed := elementsByName(oldList)
+	newByName, newNamed := elementsByName(newList)
+	if oldNamed && newNamed {
+		oldOrder, newOrder := commonNameOrder(oldList, newByName), commonNameOrder(newList, oldByName)
+		if !slices.Contains(unorderedLists, prefix) && !slices.Equal(oldOrder, newOrder) {
+			report(prefix, oldOrder, newOrder)
+		}
+		for name, oldElement := range oldByName {
+			path := fmt.Sprintf("%s[name=%s]", prefix, name)
+			if newElement, ok := newByName[name]; ok {
+				diffFieldPaths(path, oldElement, newElement, report)
+			} else {
+				report(path, oldElement, nil)
+			}
+		}
+		for name, newElement := range newByName {
+			if _, ok := oldByName[name]; !ok {
+				report(fmt.Sprintf("%s[name=%s]", prefix, name), nil, newElement)
+			}
+		}
+		return
+	}
+
+	for i := range max(len(oldList), len(newList)) {
+		path := fmt.Sprintf("%s[%d]", prefix, i)
+		switch {
+		case i >= len(oldList):
+			report(path, nil, newList[i])
+		case i >= len(newList):
+			report(path, oldList[i], nil)
+		default:
+			diffFieldPaths(path, oldList[i], newList[i], report)
+		}
+	}
+}
+
+// commonNameOrder returns the names of the list elements that are also in the other list, in order
+func commonNameOrder(list []interface{}, other map[string]interface{}) []string {
+	var names []string
+	for _, element := range list {
+		name := element.(map[string]interface{})["name"].(string)
+		if _, ok := other[name]; ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// elementsByName indexes list elements by their name field, reporting false unless every
+// element is an object with a unique, non-empty name
+func elementsByName(list []interface{}) (map[string]interface{}, bool) {
+	byName := make(map[string]interface{}, len(list))
+	for _, element := range list {
+		object, ok := element.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		name, ok := object["name"].(string)
+		if !ok || name == "" {
+			return nil, false
+		}
+		if _, duplicate := byName[name]; duplicate {
+			return nil, false
+		}
+		byName[name] = element
+	}
+	return byName, true
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"slices"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("CategorizeFieldPaths", func() {
+	var oldVM, newVM *kubevirtiov1.VirtualMachine
+
+	BeforeEach(func() {
+		oldVM = &kubevirtiov1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-vm", Namespace: "default", ResourceVersion: "1"},
+			Spec: kubevirtiov1.VirtualMachineSpec{
+				Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+					Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+						Domain: kubevirtiov1.DomainSpec{
+							CPU: &kubevirtiov1.CPU{Cores: 2},
+							Devices: kubevirtiov1.Devices{
+								Disks: []kubevirtiov1.Disk{{Name: "disk1"}},
+							},
+						},
+						Volumes: []kubevirtiov1.Volume{{
+							Name: "disk1",
+							VolumeSource: kubevirtiov1.VolumeSource{
+								DataVolume: &kubevirtiov1.DataVolumeSource{Name: "root"},
+							},
+						}},
+					},
+				},
+			},
+		}
+		newVM = oldVM.DeepCopy()
+		newVM.ResourceVersion = "2"
+	})
+
+	It("should return no categories for identical specs", func() {
+		paths, err := CategorizeFieldPaths(oldVM, newVM, DefaultFieldCheckers())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(paths).To(BeEmpty())
+	})
+
+	It("should report the added disk and volume under storage", func() {
+		newVM.Spec.Template.Spec.Domain.Devices.Disks = append(newVM.Spec.Template.Spec.Domain.Devices.Disks,
+			kubevirtiov1.Disk{Name: "data"})
+		newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
+			Name: "data",
+			VolumeSource: kubevirtiov1.VolumeSource{
+				DataVolume: &kubevirtiov1.DataVolumeSource{Name: "data-dv"},
+			},
+		})
+
+		paths, err := CategorizeFieldPaths(oldVM, newVM, DefaultFieldCheckers())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(paths).To(Equal(map[string][]string{
+			"storage": {
+				"spec.template.spec.domain.devices.disks[name=data]",
+				"spec.template.spec.volumes[name=data]",
+			},
+		}))
+	})
+
+	It("should report a changed volume source down to the changed field", func() {
+		newVM.Spec.Template.Spec.Volumes[0].DataVolume.Name = "root-v2"
+
+		paths, err := CategorizeFieldPaths(oldVM, newVM, DefaultFieldCheckers())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(paths).To(HaveKeyWithValue("storage", []string{"spec.template.spec.volumes[name=disk1].dataVolume.name"}))
+	})
+
+	It("should report a CPU change under compute and cpu-topology", func() {
+		newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+		newVM.Spec.Template.Spec.Domain.CPU.Sockets = 2
+
+		paths, err := CategorizeFieldPaths(oldVM, newVM, DefaultFieldCheckers())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(paths).To(Equal(map[string][]string{
+			"compute":      {"spec.template.spec.domain.cpu.cores"},
+			"cpu-topology": {"spec.template.spec.domain.cpu.sockets"},
+		}))
+	})
+
+	It("should split a multi-category update by category", func() {
+		newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+		newVM.Spec.Template.Spec.Domain.Devices.GPUs = []kubevirtiov1.GPU{{Name: "gpu1", DeviceName: "nvidia.com/GPU"}}
+
+		paths, err := CategorizeFieldPaths(oldVM, newVM, DefaultFieldCheckers())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(paths).To(HaveKeyWithValue("compute", []string{"spec.template.spec.domain.cpu.cores"}))
+		Expect(paths).To(HaveKeyWithValue("devices", []string{"spec.template.spec.domain.devices.gpus[name=gpu1]"}))
+		Expect(paths).ToNot(HaveKey("storage"))
+	})
+
+	It("should report a disk reordering at the disk list under storage", func() {
+		oldVM.Spec.Template.Spec.Domain.Devices.Disks = append(oldVM.Spec.Template.Spec.Domain.Devices.Disks, kubevirtiov1.Disk{Name: "data"})
+		newVM = oldVM.DeepCopy()
+		slices.Reverse(newVM.Spec.Template.Spec.Domain.Devices.Disks)
+
+		paths, err := CategorizeFieldPaths(oldVM, newVM, DefaultFieldCheckers())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(paths).To(Equal(map[string][]string{
+			"storage": {"spec.template.spec.domain.devices.disks"},
+		}))
+	})
+
+	It("should not report a volume reordering", func() {
+		oldVM.Spec.Template.Spec.Volumes = append(oldVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "data"})
+		newVM = oldVM.DeepCopy()
+		slices.Reverse(newVM.Spec.Template.Spec.Volumes)
+
+		paths, err := diffVMFieldPaths(oldVM, newVM)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(paths).To(BeEmpty())
+	})
+
+	It("should address unnamed list elements by index", func() {
+		newVM.Spec.Template.Spec.Tolerations = []corev1.Toleration{{Key: "dedicated"}}
+
+		paths, err := diffVMFieldPaths(oldVM, newVM)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(paths).To(ContainElement("spec.template.spec.tolerations[0]"))
+	})
+})
@@ -17,7 +17,12 @@ limitations under the License.
 package v1
 
 import (
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
 	kubevirtiov1 "kubevirt.io/api/core/v1"
 )
 
@@ -40,11 +45,72 @@ type FieldPermissionChecker interface {
 	Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine)
 }
 
+// DecisionContext carries the state of the VM being updated, so checkers can require
+// different permissions depending on it.
+type DecisionContext struct {
+	// Running is true when the VM has a VirtualMachineInstance, i.e. changes may be applied live
+	Running bool
+}
+
+// NewDecisionContext builds the DecisionContext for an update from the existing VM's status
+func NewDecisionContext(oldVM *kubevirtiov1.VirtualMachine) DecisionContext {
+	return DecisionContext{
+		Running: oldVM.Status.Created || oldVM.Status.Ready,
+	}
+}
+
+// ContextAwarePermissionChecker is implemented by checkers whose required subresource
+// depends on the DecisionContext (e.g. stricter rules for running VMs).
+// Subresource() still declares the base grant that opts a user in to granular checks.
+type ContextAwarePermissionChecker interface {
+	FieldPermissionChecker
+
+	// SubresourceFor returns the RBAC subresource required to modify these fields in the given context
+	SubresourceFor(dc DecisionContext) string
+}
+
+// requiredSubresource returns the subresource a checker requires in the given context
+func requiredSubresource(checker FieldPermissionChecker, dc DecisionContext) string {
+	if aware, ok := checker.(ContextAwarePermissionChecker); ok {
+		return aware.SubresourceFor(dc)
+	}
+	return checker.Subresource()
+}
+
+// SubsetPermissionChecker is implemented by checkers whose fields are also covered by another
+// checker (the superset). A subset must run before its superset: once the superset neutralized
+// the fields, the subset never sees them.
+type SubsetPermissionChecker interface {
+	FieldPermissionChecker
+
+	// IsSubsetOf reports whether the named checker covers this checker's fields, so it must
+	// run after this one. Checkers are named rather than identified by subresource, since
+	// several checkers may share one (e.g. autoattach and devices).
+	IsSubsetOf(name string) bool
+}
+
+// validateCheckerOrder returns an error if a checker runs after one of its supersets. The order
+// is otherwise only documented in comments, and a reordering would silently change which
+// subresource decides an update.
+func validateCheckerOrder(checkers []FieldPermissionChecker) error {
+	for i, superset := range checkers {
+		for _, checker := range checkers[i+1:] {
+			if subset, ok := checker.(SubsetPermissionChecker); ok && subset.IsSubsetOf(superset.Name()) {
+				return fmt.Errorf("field permission checker %q must run before %q, which is a superset of it",
+					subset.Name(), superset.Name())
+			}
+		}
+	}
+	return nil
+}
+
 // StoragePermissionChecker implements FieldPermissionChecker for storage-related fields.
 // It handles permissions for:
 // - Volumes (PVCs, DataVolumes, ConfigMaps, Secrets, etc.)
 // - Disks (how volumes are attached to the VM)
-// - Filesystems (virtio-fs mounts)
+// - Filesystems (virtio-fs mounts, also covered by the filesystem-admin subset)
+// serviceAccount volumes and their disks are excluded, see IdentityPermissionChecker: swapping the
+// service account a VM mounts is a privilege escalation, never a generic storage change.
 type StoragePermissionChecker struct{}
 
 var _ FieldPermissionChecker = &StoragePermissionChecker{}
@@ -58,15 +124,22 @@ func (s *StoragePermissionChecker) Subresource() string {
 }
 
 func (s *StoragePermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
-	// Storage-admin is a SUPERSET - it covers ALL storage including CD-ROMs and filesystems
-	// Compare ALL volume specifications (the backing storage)
-	oldVolumes := oldVM.Spec.Template.Spec.Volumes
-	newVolumes := newVM.Spec.Template.Spec.Volumes
-	volumesChanged := !equality.Semantic.DeepEqual(oldVolumes, newVolumes)
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
 
-	// Compare ALL disk specifications (how volumes are attached to the VM)
-	oldDisks := oldVM.Spec.Template.Spec.Domain.Devices.Disks
-	newDisks := newVM.Spec.Template.Spec.Domain.Devices.Disks
+	// Storage-admin is a SUPERSET - it covers ALL storage including CD-ROMs and filesystems,
+	// except the serviceAccount volumes left to identity-admin
+	serviceAccounts := serviceAccountVolumeNames(oldVM, newVM)
+
+	// Compare the volume specifications (the backing storage)
+	oldVolumes := selectByName(oldVM.Spec.Template.Spec.Volumes, volumeName, serviceAccounts, false)
+	newVolumes := selectByName(newVM.Spec.Template.Spec.Volumes, volumeName, serviceAccounts, false)
+	volumesChanged := !sameAsSet(oldVolumes, newVolumes, volumeName)
+
+	// Compare the disk specifications (how volumes are attached to the VM)
+	oldDisks := selectByName(oldVM.Spec.Template.Spec.Domain.Devices.Disks, diskName, serviceAccounts, false)
+	newDisks := selectByName(newVM.Spec.Template.Spec.Domain.Devices.Disks, diskName, serviceAccounts, false)
 	disksChanged := !equality.Semantic.DeepEqual(oldDisks, newDisks)
 
 	// Compare filesystems (virtio-fs mounts)
@@ -83,12 +156,14 @@ func (s *StoragePermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.Virtual
 		return
 	}
 
-	// Storage-admin is a SUPERSET - neutralize ALL storage (including CD-ROMs and filesystems)
-	oldVM.Spec.Template.Spec.Volumes = nil
-	newVM.Spec.Template.Spec.Volumes = nil
-
-	oldVM.Spec.Template.Spec.Domain.Devices.Disks = nil
-	newVM.Spec.Template.Spec.Domain.Devices.Disks = nil
+	// Storage-admin is a SUPERSET - neutralize ALL storage (including CD-ROMs and filesystems),
+	// keeping the serviceAccount volumes and their disks so that changing them without
+	// identity-admin is denied
+	serviceAccounts := serviceAccountVolumeNames(oldVM, newVM)
+	for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+		vm.Spec.Template.Spec.Volumes = selectByName(vm.Spec.Template.Spec.Volumes, volumeName, serviceAccounts, true)
+		vm.Spec.Template.Spec.Domain.Devices.Disks = selectByName(vm.Spec.Template.Spec.Domain.Devices.Disks, diskName, serviceAccounts, true)
+	}
 
 	oldVM.Spec.Template.Spec.Domain.Devices.Filesystems = nil
 	newVM.Spec.Template.Spec.Domain.Devices.Filesystems = nil
@@ -100,7 +175,7 @@ func (s *StoragePermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.Virtual
 // - CD-ROM volumes
 type CdromUserPermissionChecker struct{}
 
-var _ FieldPermissionChecker = &CdromUserPermissionChecker{}
+var _ SubsetPermissionChecker = &CdromUserPermissionChecker{}
 
 func (c *CdromUserPermissionChecker) Name() string {
 	return "cdrom"
@@ -110,12 +185,22 @@ func (c *CdromUserPermissionChecker) Subresource() string {
 	return "virtualmachines/cdrom-user"
 }
 
+func (c *CdromUserPermissionChecker) IsSubsetOf(name string) bool {
+	return name == "storage"
+}
+
 func (c *CdromUserPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
 	// CD-ROM operations: inject (media), eject (media), swap (media)
 	// Users can only change hotpluggable volumes attached to existing CD-ROM disks.
 	// Users CANNOT add or remove CD-ROM disks themselves.
 
-	// First verify that CD-ROM disk definitions haven't changed (count, names, config)
+	// Converting a disk between disk, lun and cdrom (e.g. a data disk becoming a CD-ROM)
+	// is never a media change, whatever happens to the volumes
+	if len(diskDeviceTypeChanges(oldVM, newVM)) > 0 {
+		return false
+	}
+
+	// Then verify that CD-ROM disk definitions haven't changed (count, names, config)
 	oldCdromDisks := c.getCdromDisks(oldVM)
 	newCdromDisks := c.getCdromDisks(newVM)
 
@@ -130,7 +215,7 @@ func (c *CdromUserPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.Virtu
 	newCdromVolumes := c.getHotpluggableCdromVolumes(newVM)
 
 	// Compare the volumes - any change indicates inject/eject/swap of media
-	return !equality.Semantic.DeepEqual(oldCdromVolumes, newCdromVolumes)
+	return !sameAsSet(oldCdromVolumes, newCdromVolumes, volumeName)
 }
 
 func (c *CdromUserPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
@@ -142,19 +227,12 @@ func (c *CdromUserPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.Virtu
 	oldCdromNames := c.getHotpluggableCdromVolumeNames(oldVM)
 	newCdromNames := c.getHotpluggableCdromVolumeNames(newVM)
 
-	// Combine both sets of names
-	cdromNames := make(map[string]bool)
-	for name := range oldCdromNames {
-		cdromNames[name] = true
-	}
-	for name := range newCdromNames {
-		cdromNames[name] = true
-	}
-
-	// Remove hotpluggable CD-ROM volumes from both VMs
-	// This neutralizes media changes (inject/eject/swap)
-	oldVM.Spec.Template.Spec.Volumes = c.filterOutVolumes(oldVM.Spec.Template.Spec.Volumes, cdromNames)
-	newVM.Spec.Template.Spec.Volumes = c.filterOutVolumes(newVM.Spec.Template.Spec.Volumes, cdromNames)
+	// Remove each VM's own hotpluggable CD-ROM volumes
+	// This neutralizes media changes (inject/eject/swap) between hotpluggable sources
+	// (DataVolume <-> PVC), while a swap to a non-hotpluggable source (e.g. hostDisk)
+	// stays visible and requires storage-admin
+	oldVM.Spec.Template.Spec.Volumes = c.filterOutVolumes(oldVM.Spec.Template.Spec.Volumes, oldCdromNames)
+	newVM.Spec.Template.Spec.Volumes = c.filterOutVolumes(newVM.Spec.Template.Spec.Volumes, newCdromNames)
 
 	// NOTE: We do NOT neutralize the CD-ROM disks themselves
 	// Users cannot add/remove CD-ROM disks - only swap media in existing drives
@@ -241,207 +319,2861 @@ func (c *CdromUserPermissionChecker) filterOutVolumes(volumes []kubevirtiov1.Vol
 	return filtered
 }
 
-// NetworkPermissionChecker implements FieldPermissionChecker for network-related fields.
+// diskDeviceType returns the device type of a disk: disk, lun, cdrom, or "" if none is set
+func diskDeviceType(disk *kubevirtiov1.Disk) string {
+	switch {
+	case disk.Disk != nil:
+		return "disk"
+	case disk.LUN != nil:
+		return "lun"
+	case disk.CDRom != nil:
+		return "cdrom"
+	}
+	return ""
+}
+
+// diskDeviceTypeChanges returns the names of disks present in both VMs whose device type changed.
+// Such transitions change the disk's semantics and always require storage-admin.
+func diskDeviceTypeChanges(oldVM, newVM *kubevirtiov1.VirtualMachine) []string {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return nil
+	}
+
+	oldTypes := make(map[string]string)
+	for i := range oldVM.Spec.Template.Spec.Domain.Devices.Disks {
+		disk := &oldVM.Spec.Template.Spec.Domain.Devices.Disks[i]
+		oldTypes[disk.Name] = diskDeviceType(disk)
+	}
+
+	var changed []string
+	for i := range newVM.Spec.Template.Spec.Domain.Devices.Disks {
+		disk := &newVM.Spec.Template.Spec.Domain.Devices.Disks[i]
+		if oldType, found := oldTypes[disk.Name]; found && oldType != diskDeviceType(disk) {
+			changed = append(changed, disk.Name)
+		}
+	}
+	return changed
+}
+
+// BootPermissionChecker implements FieldPermissionChecker for the boot configuration.
 // It handles permissions for:
-// - Network interfaces (spec.template.spec.domain.devices.interfaces)
-// - Networks (spec.template.spec.networks)
-type NetworkPermissionChecker struct{}
+// - Boot order of existing disks (spec.template.spec.domain.devices.disks[].bootOrder)
+// - Bootloader selection (spec.template.spec.domain.firmware.bootloader), e.g. BIOS or EFI
+// Changing the boot order is a common, lower-risk operation (e.g. booting from a CD-ROM to
+// reinstall), so it is granted without storage or firmware access. Turning EFI Secure Boot on
+// or off is not a selection and still requires full-admin.
+// This is a SUBSET of storage-admin: the rest of the disks must be unchanged. No role but
+// full-admin covers the rest of the firmware.
+type BootPermissionChecker struct{}
+
+var _ SubsetPermissionChecker = &BootPermissionChecker{}
+
+func (b *BootPermissionChecker) Name() string {
+	return "boot"
+}
 
-var _ FieldPermissionChecker = &NetworkPermissionChecker{}
+func (b *BootPermissionChecker) Subresource() string {
+	return "virtualmachines/boot-admin"
+}
 
-func (n *NetworkPermissionChecker) Name() string {
-	return "network"
+func (b *BootPermissionChecker) IsSubsetOf(name string) bool {
+	return name == "storage"
 }
 
-func (n *NetworkPermissionChecker) Subresource() string {
-	return "virtualmachines/network-admin"
+func (b *BootPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	return b.bootOrderChanged(oldVM, newVM) || b.bootloaderChanged(oldVM, newVM)
 }
 
-func (n *NetworkPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+func (b *BootPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
 	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Clear only the boot fields of the parts confined to them, leaving other disk and
+	// firmware changes for other checkers
+	if b.bootOrderChanged(oldVM, newVM) {
+		for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+			vm.Spec.Template.Spec.Domain.Devices.Disks = b.withoutBootOrder(vm.Spec.Template.Spec.Domain.Devices.Disks)
+		}
+	}
+	if b.bootloaderChanged(oldVM, newVM) {
+		for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+			vm.Spec.Template.Spec.Domain.Firmware = b.withoutBootloader(vm.Spec.Template.Spec.Domain.Firmware)
+		}
+	}
+}
+
+// bootOrderChanged reports whether the disks differ, but only in their boot order
+func (b *BootPermissionChecker) bootOrderChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	oldDisks := oldVM.Spec.Template.Spec.Domain.Devices.Disks
+	newDisks := newVM.Spec.Template.Spec.Domain.Devices.Disks
+	if equality.Semantic.DeepEqual(oldDisks, newDisks) {
 		return false
 	}
+	return equality.Semantic.DeepEqual(b.withoutBootOrder(oldDisks), b.withoutBootOrder(newDisks))
+}
 
-	// Compare network interfaces
-	oldInterfaces := oldVM.Spec.Template.Spec.Domain.Devices.Interfaces
-	newInterfaces := newVM.Spec.Template.Spec.Domain.Devices.Interfaces
-	interfacesChanged := !equality.Semantic.DeepEqual(oldInterfaces, newInterfaces)
+// bootloaderChanged reports whether the firmware differs, but only in the bootloader, and
+// without turning Secure Boot on or off
+func (b *BootPermissionChecker) bootloaderChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	oldFirmware := oldVM.Spec.Template.Spec.Domain.Firmware
+	newFirmware := newVM.Spec.Template.Spec.Domain.Firmware
+	if equality.Semantic.DeepEqual(oldFirmware, newFirmware) {
+		return false
+	}
+	return equality.Semantic.DeepEqual(b.withoutBootloader(oldFirmware), b.withoutBootloader(newFirmware)) &&
+		secureBootEnabled(oldFirmware) == secureBootEnabled(newFirmware)
+}
 
-	// Compare networks
-	oldNetworks := oldVM.Spec.Template.Spec.Networks
-	newNetworks := newVM.Spec.Template.Spec.Networks
-	networksChanged := !equality.Semantic.DeepEqual(oldNetworks, newNetworks)
+// withoutBootOrder returns a copy of the disks with the boot order cleared
+func (b *BootPermissionChecker) withoutBootOrder(disks []kubevirtiov1.Disk) []kubevirtiov1.Disk {
+	if disks == nil {
+		return nil
+	}
 
-	return interfacesChanged || networksChanged
+	stripped := make([]kubevirtiov1.Disk, len(disks))
+	for i, disk := range disks {
+		disk.BootOrder = nil
+		stripped[i] = disk
+	}
+	return stripped
 }
 
-func (n *NetworkPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+// withoutBootloader returns a copy of the firmware with the bootloader cleared,
+// or nil if nothing else is set
+func (b *BootPermissionChecker) withoutBootloader(firmware *kubevirtiov1.Firmware) *kubevirtiov1.Firmware {
+	if firmware == nil {
+		return nil
+	}
+
+	stripped := firmware.DeepCopy()
+	stripped.Bootloader = nil
+	if equality.Semantic.DeepEqual(*stripped, kubevirtiov1.Firmware{}) {
+		return nil
+	}
+	return stripped
+}
+
+// secureBootEnabled reports whether the firmware boots with EFI Secure Boot, which KubeVirt
+// enables by default for EFI
+func secureBootEnabled(firmware *kubevirtiov1.Firmware) bool {
+	if firmware == nil || firmware.Bootloader == nil || firmware.Bootloader.EFI == nil {
+		return false
+	}
+	secureBoot := firmware.Bootloader.EFI.SecureBoot
+	return secureBoot == nil || *secureBoot
+}
+
+// DiskTuningPermissionChecker implements FieldPermissionChecker for per-disk performance tuning.
+// It handles permissions for:
+// - Cache mode (spec.template.spec.domain.devices.disks[].cache)
+// - IO mode (spec.template.spec.domain.devices.disks[].io)
+// - Block size (spec.template.spec.domain.devices.disks[].blockSize)
+// Block size changes what the guest sees but not which volume backs the disk, so it is tuned
+// alongside cache and IO rather than requiring storage-admin.
+// This is a SUBSET of storage-admin: disk identity and volume bindings must be unchanged. Dedicated
+// IO threads are left to performance-admin (see DedicatedIOThreadPermissionChecker) and ignored here,
+// so tuning a disk and toggling its IO thread in one update needs both roles.
+type DiskTuningPermissionChecker struct{}
+
+var _ SubsetPermissionChecker = &DiskTuningPermissionChecker{}
+
+func (d *DiskTuningPermissionChecker) Name() string {
+	return "disk-tuning"
+}
+
+func (d *DiskTuningPermissionChecker) Subresource() string {
+	return "virtualmachines/disk-tuning-admin"
+}
+
+func (d *DiskTuningPermissionChecker) IsSubsetOf(name string) bool {
+	return name == "storage"
+}
+
+func (d *DiskTuningPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	oldDisks := withoutDedicatedIOThreads(oldVM.Spec.Template.Spec.Domain.Devices.Disks)
+	newDisks := withoutDedicatedIOThreads(newVM.Spec.Template.Spec.Domain.Devices.Disks)
+	if equality.Semantic.DeepEqual(oldDisks, newDisks) {
+		return false
+	}
+
+	// Only a tuning change if the disks are identical once the tuning fields are ignored
+	// (any other disk change, such as adding a disk or rebinding a volume, requires storage-admin)
+	return equality.Semantic.DeepEqual(d.withoutTuning(oldDisks), d.withoutTuning(newDisks))
+}
+
+func (d *DiskTuningPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
 	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
 		return
 	}
 
-	// Neutralize network interfaces
-	oldVM.Spec.Template.Spec.Domain.Devices.Interfaces = nil
-	newVM.Spec.Template.Spec.Domain.Devices.Interfaces = nil
+	// Clear only the tuning fields, leaving disk identity for other checkers
+	oldVM.Spec.Template.Spec.Domain.Devices.Disks = d.withoutTuning(oldVM.Spec.Template.Spec.Domain.Devices.Disks)
+	newVM.Spec.Template.Spec.Domain.Devices.Disks = d.withoutTuning(newVM.Spec.Template.Spec.Domain.Devices.Disks)
+}
 
-	// Neutralize networks
-	oldVM.Spec.Template.Spec.Networks = nil
-	newVM.Spec.Template.Spec.Networks = nil
+// withoutTuning returns a copy of the disks with the tuning fields cleared
+func (d *DiskTuningPermissionChecker) withoutTuning(disks []kubevirtiov1.Disk) []kubevirtiov1.Disk {
+	if disks == nil {
+		return nil
+	}
+
+	stripped := make([]kubevirtiov1.Disk, len(disks))
+	for i, disk := range disks {
+		disk.Cache = ""
+		disk.IO = ""
+		disk.BlockSize = nil
+		stripped[i] = disk
+	}
+	return stripped
 }
 
-// ComputePermissionChecker implements FieldPermissionChecker for compute-related fields.
+// DedicatedIOThreadPermissionChecker implements FieldPermissionChecker for dedicated disk IO threads.
 // It handles permissions for:
-// - CPU configuration (spec.template.spec.domain.cpu)
-// - Memory and resource requests/limits (spec.template.spec.domain.resources)
-type ComputePermissionChecker struct{}
+// - Dedicated IO thread (spec.template.spec.domain.devices.disks[].dedicatedIOThread)
+// Toggling a dedicated IO thread changes the threads allocated on the node, a node resource rather
+// than disk tuning, so it requires performance-admin instead of disk-tuning-admin. This is a SUBSET
+// of storage-admin: disk identity and volume bindings must be unchanged, while the disk-tuning
+// fields may change along with it.
+type DedicatedIOThreadPermissionChecker struct{}
 
-var _ FieldPermissionChecker = &ComputePermissionChecker{}
+var _ SubsetPermissionChecker = &DedicatedIOThreadPermissionChecker{}
 
-func (c *ComputePermissionChecker) Name() string {
-	return "compute"
+func (d *DedicatedIOThreadPermissionChecker) Name() string {
+	return "dedicated-io-thread"
 }
 
-func (c *ComputePermissionChecker) Subresource() string {
-	return "virtualmachines/compute-admin"
+func (d *DedicatedIOThreadPermissionChecker) Subresource() string {
+	return "virtualmachines/performance-admin"
 }
 
-func (c *ComputePermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+func (d *DedicatedIOThreadPermissionChecker) IsSubsetOf(name string) bool {
+	return name == "storage"
+}
+
+func (d *DedicatedIOThreadPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
 	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
 		return false
 	}
 
-	// Compare CPU configuration
-	oldCPU := oldVM.Spec.Template.Spec.Domain.CPU
-	newCPU := newVM.Spec.Template.Spec.Domain.CPU
-	cpuChanged := !equality.Semantic.DeepEqual(oldCPU, newCPU)
-
-	// Compare resource requirements (memory, limits, requests)
-	oldResources := oldVM.Spec.Template.Spec.Domain.Resources
-	newResources := newVM.Spec.Template.Spec.Domain.Resources
-	resourcesChanged := !equality.Semantic.DeepEqual(oldResources, newResources)
+	tuning := &DiskTuningPermissionChecker{}
+	oldDisks := tuning.withoutTuning(oldVM.Spec.Template.Spec.Domain.Devices.Disks)
+	newDisks := tuning.withoutTuning(newVM.Spec.Template.Spec.Domain.Devices.Disks)
+	if equality.Semantic.DeepEqual(oldDisks, newDisks) {
+		return false
+	}
 
-	return cpuChanged || resourcesChanged
+	// Only an IO thread change if the disks are identical once the IO threads are ignored
+	return equality.Semantic.DeepEqual(withoutDedicatedIOThreads(oldDisks), withoutDedicatedIOThreads(newDisks))
 }
 
-func (c *ComputePermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+func (d *DedicatedIOThreadPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
 	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
 		return
 	}
 
-	// Neutralize CPU
-	oldVM.Spec.Template.Spec.Domain.CPU = nil
-	newVM.Spec.Template.Spec.Domain.CPU = nil
+	// Clear only the IO threads, leaving the rest of the disks for other checkers
+	oldVM.Spec.Template.Spec.Domain.Devices.Disks = withoutDedicatedIOThreads(oldVM.Spec.Template.Spec.Domain.Devices.Disks)
+	newVM.Spec.Template.Spec.Domain.Devices.Disks = withoutDedicatedIOThreads(newVM.Spec.Template.Spec.Domain.Devices.Disks)
+}
+
+// withoutDedicatedIOThreads returns a copy of the disks with the dedicated IO thread cleared
+func withoutDedicatedIOThreads(disks []kubevirtiov1.Disk) []kubevirtiov1.Disk {
+	if disks == nil {
+		return nil
+	}
 
-	// Neutralize resources
-	oldVM.Spec.Template.Spec.Domain.Resources = kubevirtiov1.ResourceRequirements{}
-	newVM.Spec.Template.Spec.Domain.Resources = kubevirtiov1.ResourceRequirements{}
+	stripped := make([]kubevirtiov1.Disk, len(disks))
+	for i, disk := range disks {
+		disk.DedicatedIOThread = nil
+		stripped[i] = disk
+	}
+	return stripped
 }
 
-// DevicesPermissionChecker implements FieldPermissionChecker for device-related fields.
+// DiskIdentityPermissionChecker implements FieldPermissionChecker for guest-visible disk identifiers.
 // It handles permissions for:
-// - GPUs (spec.template.spec.domain.devices.gpus)
-// - Host devices (spec.template.spec.domain.devices.hostDevices)
-// - Watchdog (spec.template.spec.domain.devices.watchdog)
-// - TPM (spec.template.spec.domain.devices.tpm)
-// - Input devices (spec.template.spec.domain.devices.inputs)
-// NOTE: Does NOT include disks, interfaces, or filesystems (covered by storage/network)
-type DevicesPermissionChecker struct{}
+// - Serial number (spec.template.spec.domain.devices.disks[].serial)
+// Some licensing schemes key on disk serials, so changing them is attributed separately.
+// The vendored KubeVirt API has no disk WWN field; serial is the only such identifier.
+// This is a SUBSET of storage-admin: the rest of the disks and volume bindings must be unchanged.
+type DiskIdentityPermissionChecker struct{}
 
-var _ FieldPermissionChecker = &DevicesPermissionChecker{}
+var _ SubsetPermissionChecker = &DiskIdentityPermissionChecker{}
 
-func (d *DevicesPermissionChecker) Name() string {
-	return "devices"
+func (d *DiskIdentityPermissionChecker) Name() string {
+	return "disk-identity"
 }
 
-func (d *DevicesPermissionChecker) Subresource() string {
-	return "virtualmachines/devices-admin"
+func (d *DiskIdentityPermissionChecker) Subresource() string {
+	return "virtualmachines/disk-identity-admin"
 }
 
-func (d *DevicesPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+func (d *DiskIdentityPermissionChecker) IsSubsetOf(name string) bool {
+	return name == "storage"
+}
+
+func (d *DiskIdentityPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
 	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
 		return false
 	}
 
-	oldDevices := oldVM.Spec.Template.Spec.Domain.Devices
-	newDevices := newVM.Spec.Template.Spec.Domain.Devices
+	oldDisks := oldVM.Spec.Template.Spec.Domain.Devices.Disks
+	newDisks := newVM.Spec.Template.Spec.Domain.Devices.Disks
+	if equality.Semantic.DeepEqual(oldDisks, newDisks) {
+		return false
+	}
 
-	// Compare GPUs
-	gpusChanged := !equality.Semantic.DeepEqual(oldDevices.GPUs, newDevices.GPUs)
+	// Only an identity change if the disks are identical once the serials are ignored
+	// (any other disk change, such as adding a disk or rebinding a volume, requires storage-admin)
+	return equality.Semantic.DeepEqual(d.withoutIdentity(oldDisks), d.withoutIdentity(newDisks))
+}
 
-	// Compare host devices
-	hostDevicesChanged := !equality.Semantic.DeepEqual(oldDevices.HostDevices, newDevices.HostDevices)
+func (d *DiskIdentityPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
 
-	// Compare watchdog
-	watchdogChanged := !equality.Semantic.DeepEqual(oldDevices.Watchdog, newDevices.Watchdog)
+	// Clear only the identity fields, leaving the rest of the disks for other checkers
+	oldVM.Spec.Template.Spec.Domain.Devices.Disks = d.withoutIdentity(oldVM.Spec.Template.Spec.Domain.Devices.Disks)
+	newVM.Spec.Template.Spec.Domain.Devices.Disks = d.withoutIdentity(newVM.Spec.Template.Spec.Domain.Devices.Disks)
+}
 
-	// Compare TPM
-	tpmChanged := !equality.Semantic.DeepEqual(oldDevices.TPM, newDevices.TPM)
+// withoutIdentity returns a copy of the disks with the identity fields cleared
+func (d *DiskIdentityPermissionChecker) withoutIdentity(disks []kubevirtiov1.Disk) []kubevirtiov1.Disk {
+	if disks == nil {
+		return nil
+	}
 
-	// Compare input devices
-	inputsChanged := !equality.Semantic.DeepEqual(oldDevices.Inputs, newDevices.Inputs)
+	stripped := make([]kubevirtiov1.Disk, len(disks))
+	for i, disk := range disks {
+		disk.Serial = ""
+		stripped[i] = disk
+	}
+	return stripped
+}
+
+// DiskTagPermissionChecker implements FieldPermissionChecker for disk tags.
+// It handles permissions for:
+// - Tag (spec.template.spec.domain.devices.disks[].tag)
+// Cloud-init and ignition use tags to identify devices, so changing them is guest configuration
+// rather than storage allocation and is granted by config-admin.
+// This is a SUBSET of storage-admin: the rest of the disks and volume bindings must be unchanged.
+type DiskTagPermissionChecker struct{}
+
+var _ SubsetPermissionChecker = &DiskTagPermissionChecker{}
 
-	return gpusChanged || hostDevicesChanged || watchdogChanged || tpmChanged || inputsChanged
+func (d *DiskTagPermissionChecker) Name() string {
+	return "disk-tag"
 }
 
-func (d *DevicesPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+func (d *DiskTagPermissionChecker) Subresource() string {
+	return "virtualmachines/config-admin"
+}
+
+func (d *DiskTagPermissionChecker) IsSubsetOf(name string) bool {
+	return name == "storage"
+}
+
+func (d *DiskTagPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
 	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
-		return
+		return false
 	}
 
-	// Neutralize GPUs
-	oldVM.Spec.Template.Spec.Domain.Devices.GPUs = nil
-	newVM.Spec.Template.Spec.Domain.Devices.GPUs = nil
+	oldDisks := oldVM.Spec.Template.Spec.Domain.Devices.Disks
+	newDisks := newVM.Spec.Template.Spec.Domain.Devices.Disks
+	if equality.Semantic.DeepEqual(oldDisks, newDisks) {
+		return false
+	}
 
-	// Neutralize host devices
-	oldVM.Spec.Template.Spec.Domain.Devices.HostDevices = nil
-	newVM.Spec.Template.Spec.Domain.Devices.HostDevices = nil
+	// Only a tag change if the disks are identical once the tags are ignored
+	// (any other disk change, such as adding a disk or rebinding a volume, requires storage-admin)
+	return equality.Semantic.DeepEqual(d.withoutTags(oldDisks), d.withoutTags(newDisks))
+}
 
-	// Neutralize watchdog
-	oldVM.Spec.Template.Spec.Domain.Devices.Watchdog = nil
-	newVM.Spec.Template.Spec.Domain.Devices.Watchdog = nil
+func (d *DiskTagPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
 
-	// Neutralize TPM
-	oldVM.Spec.Template.Spec.Domain.Devices.TPM = nil
-	newVM.Spec.Template.Spec.Domain.Devices.TPM = nil
+	// Clear only the tags, leaving the rest of the disks for other checkers
+	oldVM.Spec.Template.Spec.Domain.Devices.Disks = d.withoutTags(oldVM.Spec.Template.Spec.Domain.Devices.Disks)
+	newVM.Spec.Template.Spec.Domain.Devices.Disks = d.withoutTags(newVM.Spec.Template.Spec.Domain.Devices.Disks)
+}
+
+// withoutTags returns a copy of the disks with the tags cleared
+func (d *DiskTagPermissionChecker) withoutTags(disks []kubevirtiov1.Disk) []kubevirtiov1.Disk {
+	if disks == nil {
+		return nil
+	}
 
-	// Neutralize input devices
-	oldVM.Spec.Template.Spec.Domain.Devices.Inputs = nil
-	newVM.Spec.Template.Spec.Domain.Devices.Inputs = nil
+	stripped := make([]kubevirtiov1.Disk, len(disks))
+	for i, disk := range disks {
+		disk.Tag = ""
+		stripped[i] = disk
+	}
+	return stripped
 }
 
-// LifecyclePermissionChecker implements FieldPermissionChecker for VM lifecycle fields.
+// SharedDiskPermissionChecker implements FieldPermissionChecker for disk data-integrity settings.
 // It handles permissions for:
-// - spec.running (bool: direct start/stop control)
-// - spec.runStrategy (string: advanced lifecycle strategy like Always, Halted, Manual, etc.)
-// Note: running and runStrategy are mutually exclusive in KubeVirt
-type LifecyclePermissionChecker struct{}
+// - Shareable (spec.template.spec.domain.devices.disks[].shareable)
+// - Error policy (spec.template.spec.domain.devices.disks[].errorPolicy)
+// Multi-writer disks can corrupt data when attached to several VMs, so these settings are
+// attributed to their own role.
+// This is a SUBSET of storage-admin: the rest of the disks and volume bindings must be unchanged.
+type SharedDiskPermissionChecker struct{}
 
-var _ FieldPermissionChecker = &LifecyclePermissionChecker{}
+var _ SubsetPermissionChecker = &SharedDiskPermissionChecker{}
 
-func (l *LifecyclePermissionChecker) Name() string {
-	return "lifecycle"
+func (s *SharedDiskPermissionChecker) Name() string {
+	return "shared-disk"
 }
 
-func (l *LifecyclePermissionChecker) Subresource() string {
-	return "virtualmachines/lifecycle-admin"
+func (s *SharedDiskPermissionChecker) Subresource() string {
+	return "virtualmachines/shared-disk-admin"
 }
 
-func (l *LifecyclePermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
-	// Check if running field has changed
-	runningChanged := !equality.Semantic.DeepEqual(oldVM.Spec.Running, newVM.Spec.Running)
+func (s *SharedDiskPermissionChecker) IsSubsetOf(name string) bool {
+	return name == "storage"
+}
 
-	// Check if runStrategy field has changed
-	runStrategyChanged := !equality.Semantic.DeepEqual(oldVM.Spec.RunStrategy, newVM.Spec.RunStrategy)
+func (s *SharedDiskPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	oldDisks := oldVM.Spec.Template.Spec.Domain.Devices.Disks
+	newDisks := newVM.Spec.Template.Spec.Domain.Devices.Disks
+	if equality.Semantic.DeepEqual(oldDisks, newDisks) {
+		return false
+	}
 
-	return runningChanged || runStrategyChanged
+	// Only a shared-disk change if the disks are identical once shareable and errorPolicy are ignored
+	// (any other disk change, such as adding a disk or rebinding a volume, requires storage-admin)
+	return equality.Semantic.DeepEqual(s.withoutSharing(oldDisks), s.withoutSharing(newDisks))
 }
 
-func (l *LifecyclePermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
-	// Neutralize running field
-	oldVM.Spec.Running = nil
-	newVM.Spec.Running = nil
+func (s *SharedDiskPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
 
-	// Neutralize runStrategy field
-	oldVM.Spec.RunStrategy = nil
-	newVM.Spec.RunStrategy = nil
+	// Clear only shareable and errorPolicy, leaving the rest of the disks for other checkers
+	oldVM.Spec.Template.Spec.Domain.Devices.Disks = s.withoutSharing(oldVM.Spec.Template.Spec.Domain.Devices.Disks)
+	newVM.Spec.Template.Spec.Domain.Devices.Disks = s.withoutSharing(newVM.Spec.Template.Spec.Domain.Devices.Disks)
+}
+
+// withoutSharing returns a copy of the disks with shareable and errorPolicy cleared
+func (s *SharedDiskPermissionChecker) withoutSharing(disks []kubevirtiov1.Disk) []kubevirtiov1.Disk {
+	if disks == nil {
+		return nil
+	}
+
+	stripped := make([]kubevirtiov1.Disk, len(disks))
+	for i, disk := range disks {
+		disk.Shareable = nil
+		disk.ErrorPolicy = nil
+		stripped[i] = disk
+	}
+	return stripped
+}
+
+// FilesystemPermissionChecker implements FieldPermissionChecker for virtio-fs filesystems.
+// It handles permissions for:
+// - Filesystems (spec.template.spec.domain.devices.filesystems)
+// - Volumes backing those filesystems (matched by name, not used by any disk)
+// This is a SUBSET of storage-admin: filesystems share host directories, so they are
+// separated from block storage (disks and their volumes).
+// With RequireRemovalAdmin, removed filesystems are left to FilesystemRemovalPermissionChecker.
+type FilesystemPermissionChecker struct {
+	// RequireRemovalAdmin excludes removals of filesystems from filesystem-admin, so
+	// disconnecting a workload from its data requires virtualmachines/filesystem-removal-admin
+	RequireRemovalAdmin bool
+}
+
+var _ SubsetPermissionChecker = &FilesystemPermissionChecker{}
+
+func (f *FilesystemPermissionChecker) Name() string {
+	return "filesystem"
+}
+
+func (f *FilesystemPermissionChecker) Subresource() string {
+	return "virtualmachines/filesystem-admin"
+}
+
+func (f *FilesystemPermissionChecker) IsSubsetOf(name string) bool {
+	return name == "storage"
+}
+
+func (f *FilesystemPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	// Removals are left to filesystem-removal-admin if required
+	if f.RequireRemovalAdmin {
+		oldVM = withoutFilesystemRemovals(oldVM, newVM)
+	}
+
+	// Compare filesystems (virtio-fs mounts)
+	oldFilesystems := oldVM.Spec.Template.Spec.Domain.Devices.Filesystems
+	newFilesystems := newVM.Spec.Template.Spec.Domain.Devices.Filesystems
+	filesystemsChanged := !equality.Semantic.DeepEqual(oldFilesystems, newFilesystems)
+
+	// Compare the volumes backing those filesystems
+	names := f.getFilesystemVolumeNames(oldVM, newVM)
+	oldVolumes := f.getVolumes(oldVM, names)
+	newVolumes := f.getVolumes(newVM, names)
+	volumesChanged := !sameAsSet(oldVolumes, newVolumes, volumeName)
+
+	return filesystemsChanged || volumesChanged
+}
+
+func (f *FilesystemPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Keep removals if they require filesystem-removal-admin
+	if f.RequireRemovalAdmin {
+		neutralizeKeepingFilesystemRemovals(oldVM, newVM, f.neutralize)
+		return
+	}
+	f.neutralize(oldVM, newVM)
+}
+
+// neutralize clears the filesystems and their backing volumes of both VMs
+func (f *FilesystemPermissionChecker) neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	// Compute the backing volume names before the filesystems are cleared
+	names := f.getFilesystemVolumeNames(oldVM, newVM)
+
+	// Neutralize filesystems
+	oldVM.Spec.Template.Spec.Domain.Devices.Filesystems = nil
+	newVM.Spec.Template.Spec.Domain.Devices.Filesystems = nil
+
+	// Remove the backing volumes from both VMs
+	oldVM.Spec.Template.Spec.Volumes = f.filterOutVolumes(oldVM.Spec.Template.Spec.Volumes, names)
+	newVM.Spec.Template.Spec.Volumes = f.filterOutVolumes(newVM.Spec.Template.Spec.Volumes, names)
+}
+
+// getFilesystemVolumeNames returns the names of filesystems in either VM that are not also used by a disk
+func (f *FilesystemPermissionChecker) getFilesystemVolumeNames(oldVM, newVM *kubevirtiov1.VirtualMachine) map[string]bool {
+	names := make(map[string]bool)
+	for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+		for _, filesystem := range vm.Spec.Template.Spec.Domain.Devices.Filesystems {
+			names[filesystem.Name] = true
+		}
+	}
+
+	// A volume attached as a disk is block storage, even if a filesystem shares its name
+	for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+		for _, disk := range vm.Spec.Template.Spec.Domain.Devices.Disks {
+			delete(names, disk.Name)
+		}
+	}
+	return names
+}
+
+// getVolumes returns the volumes with names in the provided set
+func (f *FilesystemPermissionChecker) getVolumes(vm *kubevirtiov1.VirtualMachine, names map[string]bool) []kubevirtiov1.Volume {
+	var volumes []kubevirtiov1.Volume
+	for _, vol := range vm.Spec.Template.Spec.Volumes {
+		if names[vol.Name] {
+			volumes = append(volumes, vol)
+		}
+	}
+	return volumes
+}
+
+// filterOutVolumes removes volumes with names in the provided set
+func (f *FilesystemPermissionChecker) filterOutVolumes(volumes []kubevirtiov1.Volume, namesToRemove map[string]bool) []kubevirtiov1.Volume {
+	var filtered []kubevirtiov1.Volume
+	for _, vol := range volumes {
+		if !namesToRemove[vol.Name] {
+			filtered = append(filtered, vol)
+		}
+	}
+	return filtered
+}
+
+// FilesystemUserPermissionChecker implements FieldPermissionChecker for PVC-backed virtio-fs filesystems.
+// It handles permissions for:
+// - Filesystems whose backing volume is a PersistentVolumeClaim or DataVolume
+// - Those backing volumes (matched by name, not used by any disk)
+// This is a SUBSET of filesystem-admin: any other backing (e.g. configMap, secret, downwardAPI)
+// exposes more than the user's own claims and still requires filesystem-admin. Changing the
+// virtio-fs parameters of an existing filesystem changes how the directory is shared with the
+// guest, not which claim is shared, so it also requires filesystem-admin.
+// With RequireRemovalAdmin, removed filesystems are left to FilesystemRemovalPermissionChecker.
+type FilesystemUserPermissionChecker struct {
+	// RequireRemovalAdmin excludes removals of filesystems from filesystem-user
+	RequireRemovalAdmin bool
+
+	filesystems FilesystemPermissionChecker
+}
+
+var _ SubsetPermissionChecker = &FilesystemUserPermissionChecker{}
+
+func (f *FilesystemUserPermissionChecker) Name() string {
+	return "filesystem-user"
+}
+
+func (f *FilesystemUserPermissionChecker) Subresource() string {
+	return "virtualmachines/filesystem-user"
+}
+
+func (f *FilesystemUserPermissionChecker) IsSubsetOf(name string) bool {
+	return slices.Contains([]string{"filesystem", "storage"}, name)
+}
+
+func (f *FilesystemUserPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	// Removals are left to filesystem-removal-admin if required
+	if f.RequireRemovalAdmin {
+		oldVM = withoutFilesystemRemovals(oldVM, newVM)
+	}
+
+	names := f.getClaimBackedNames(oldVM, newVM)
+	if len(names) == 0 {
+		return false
+	}
+
+	// Compare the claim-backed filesystems
+	oldFilesystems := f.getFilesystems(oldVM, names)
+	newFilesystems := f.getFilesystems(newVM, names)
+	filesystemsChanged := !equality.Semantic.DeepEqual(oldFilesystems, newFilesystems)
+
+	// Compare the claims backing them
+	oldVolumes := f.filesystems.getVolumes(oldVM, names)
+	newVolumes := f.filesystems.getVolumes(newVM, names)
+	volumesChanged := !sameAsSet(oldVolumes, newVolumes, volumeName)
+
+	return filesystemsChanged || volumesChanged
+}
+
+func (f *FilesystemUserPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Keep removals if they require filesystem-removal-admin
+	if f.RequireRemovalAdmin {
+		neutralizeKeepingFilesystemRemovals(oldVM, newVM, f.neutralize)
+		return
+	}
+	f.neutralize(oldVM, newVM)
+}
+
+// neutralize removes the claim-backed filesystems and their volumes from both VMs
+func (f *FilesystemUserPermissionChecker) neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	// Remove only claim-backed filesystems and their volumes, leaving the rest for filesystem-admin
+	names := f.getClaimBackedNames(oldVM, newVM)
+
+	oldVM.Spec.Template.Spec.Domain.Devices.Filesystems = f.filterOutFilesystems(oldVM.Spec.Template.Spec.Domain.Devices.Filesystems, names)
+	newVM.Spec.Template.Spec.Domain.Devices.Filesystems = f.filterOutFilesystems(newVM.Spec.Template.Spec.Domain.Devices.Filesystems, names)
+
+	oldVM.Spec.Template.Spec.Volumes = f.filesystems.filterOutVolumes(oldVM.Spec.Template.Spec.Volumes, names)
+	newVM.Spec.Template.Spec.Volumes = f.filesystems.filterOutVolumes(newVM.Spec.Template.Spec.Volumes, names)
+}
+
+// getClaimBackedNames returns the names of filesystems whose backing volume is a PVC or DataVolume
+// in every VM that defines it. A filesystem without a backing volume, or one switching to or from
+// another backing, is not claim-backed. Filesystems whose virtio-fs parameters change are left
+// to filesystem-admin.
+func (f *FilesystemUserPermissionChecker) getClaimBackedNames(oldVM, newVM *kubevirtiov1.VirtualMachine) map[string]bool {
+	names := f.filesystems.getFilesystemVolumeNames(oldVM, newVM)
+	for name := range filesystemParameterChanges(oldVM, newVM) {
+		delete(names, name)
+	}
+	claimBacked := make(map[string]bool)
+	for name := range names {
+		defined := false
+		onlyClaims := true
+		for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+			for _, vol := range vm.Spec.Template.Spec.Volumes {
+				if vol.Name != name {
+					continue
+				}
+				defined = true
+				if vol.PersistentVolumeClaim == nil && vol.DataVolume == nil {
+					onlyClaims = false
+				}
+			}
+		}
+		if defined && onlyClaims {
+			claimBacked[name] = true
+		}
+	}
+	return claimBacked
+}
+
+// getFilesystems returns the filesystems with names in the provided set
+func (f *FilesystemUserPermissionChecker) getFilesystems(vm *kubevirtiov1.VirtualMachine, names map[string]bool) []kubevirtiov1.Filesystem {
+	var filesystems []kubevirtiov1.Filesystem
+	for _, filesystem := range vm.Spec.Template.Spec.Domain.Devices.Filesystems {
+		if names[filesystem.Name] {
+			filesystems = append(filesystems, filesystem)
+		}
+	}
+	return filesystems
+}
+
+// filterOutFilesystems removes filesystems with names in the provided set
+func (f *FilesystemUserPermissionChecker) filterOutFilesystems(filesystems []kubevirtiov1.Filesystem, namesToRemove map[string]bool) []kubevirtiov1.Filesystem {
+	var filtered []kubevirtiov1.Filesystem
+	for _, filesystem := range filesystems {
+		if !namesToRemove[filesystem.Name] {
+			filtered = append(filtered, filesystem)
+		}
+	}
+	return filtered
+}
+
+// FilesystemRemovalPermissionChecker implements FieldPermissionChecker for removed virtio-fs filesystems.
+// It handles permissions for:
+// - Filesystems removed from spec.template.spec.domain.devices.filesystems
+// - Their backing volumes, if removed too (matched by name, not used by any disk)
+// Removing a mount can disconnect a workload from its data. By default this is a SUBSET of
+// filesystem-admin (and filesystem-user for claim-backed filesystems); with RequireRemovalAdmin on
+// those checkers, they no longer cover removals and filesystem-removal-admin (or storage-admin) is
+// required. Adding or modifying a filesystem is never a removal.
+type FilesystemRemovalPermissionChecker struct{}
+
+var _ SubsetPermissionChecker = &FilesystemRemovalPermissionChecker{}
+
+func (f *FilesystemRemovalPermissionChecker) Name() string {
+	return "filesystem-removal"
+}
+
+func (f *FilesystemRemovalPermissionChecker) Subresource() string {
+	return "virtualmachines/filesystem-removal-admin"
+}
+
+func (f *FilesystemRemovalPermissionChecker) IsSubsetOf(name string) bool {
+	return slices.Contains([]string{"filesystem", "storage"}, name)
+}
+
+func (f *FilesystemRemovalPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	filesystems, _ := filesystemRemovals(oldVM, newVM)
+	return len(filesystems) > 0
+}
+
+func (f *FilesystemRemovalPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Drop only the removed filesystems and volumes from the old VM, leaving other changes
+	// of the filesystems for filesystem-admin
+	stripped := withoutFilesystemRemovals(oldVM, newVM)
+	oldVM.Spec.Template.Spec.Domain.Devices.Filesystems = stripped.Spec.Template.Spec.Domain.Devices.Filesystems
+	oldVM.Spec.Template.Spec.Volumes = stripped.Spec.Template.Spec.Volumes
+}
+
+// filesystemParameterChanges returns the names of the filesystems in both VMs whose virtio-fs
+// parameters the update changes. The whole entry is compared, so parameters added to the API
+// later are covered too.
+func filesystemParameterChanges(oldVM, newVM *kubevirtiov1.VirtualMachine) map[string]bool {
+	oldFilesystems := make(map[string]kubevirtiov1.Filesystem)
+	for _, filesystem := range oldVM.Spec.Template.Spec.Domain.Devices.Filesystems {
+		oldFilesystems[filesystem.Name] = filesystem
+	}
+
+	names := make(map[string]bool)
+	for _, filesystem := range newVM.Spec.Template.Spec.Domain.Devices.Filesystems {
+		if oldFilesystem, found := oldFilesystems[filesystem.Name]; found && !equality.Semantic.DeepEqual(oldFilesystem, filesystem) {
+			names[filesystem.Name] = true
+		}
+	}
+	return names
+}
+
+// filesystemRemovals returns the names of the filesystems the update removes, and of their
+// backing volumes it removes too. A name also used by a disk is block storage, not a filesystem.
+func filesystemRemovals(oldVM, newVM *kubevirtiov1.VirtualMachine) (filesystems, volumes map[string]bool) {
+	filesystems = removedNames(oldVM.Spec.Template.Spec.Domain.Devices.Filesystems,
+		newVM.Spec.Template.Spec.Domain.Devices.Filesystems, filesystemName)
+	for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+		for _, disk := range vm.Spec.Template.Spec.Domain.Devices.Disks {
+			delete(filesystems, disk.Name)
+		}
+	}
+
+	volumes = make(map[string]bool)
+	for name := range removedNames(oldVM.Spec.Template.Spec.Volumes, newVM.Spec.Template.Spec.Volumes, volumeName) {
+		if filesystems[name] {
+			volumes[name] = true
+		}
+	}
+	return filesystems, volumes
+}
+
+// withoutFilesystemRemovals returns a copy of the old VM without the filesystems the update
+// removes and their removed backing volumes, or the old VM itself if nothing is removed
+func withoutFilesystemRemovals(oldVM, newVM *kubevirtiov1.VirtualMachine) *kubevirtiov1.VirtualMachine {
+	filesystems, volumes := filesystemRemovals(oldVM, newVM)
+	if len(filesystems) == 0 {
+		return oldVM
+	}
+
+	stripped := oldVM.DeepCopy()
+	spec := &stripped.Spec.Template.Spec
+	spec.Domain.Devices.Filesystems = selectByName(spec.Domain.Devices.Filesystems, filesystemName, filesystems, false)
+	spec.Volumes = selectByName(spec.Volumes, volumeName, volumes, false)
+	return stripped
+}
+
+// neutralizeKeepingFilesystemRemovals runs neutralize, then restores the filesystems the update
+// removes and their removed backing volumes on the old VM, so that the removals are still denied
+func neutralizeKeepingFilesystemRemovals(oldVM, newVM *kubevirtiov1.VirtualMachine, neutralize func(oldVM, newVM *kubevirtiov1.VirtualMachine)) {
+	filesystems, volumes := filesystemRemovals(oldVM, newVM)
+	spec := &oldVM.Spec.Template.Spec
+	removedFilesystems := selectByName(spec.Domain.Devices.Filesystems, filesystemName, filesystems, true)
+	removedVolumes := selectByName(spec.Volumes, volumeName, volumes, true)
+
+	neutralize(oldVM, newVM)
+
+	spec.Domain.Devices.Filesystems = append(selectByName(spec.Domain.Devices.Filesystems, filesystemName, filesystems, false), removedFilesystems...)
+	spec.Volumes = append(selectByName(spec.Volumes, volumeName, volumes, false), removedVolumes...)
+}
+
+// IdentityPermissionChecker implements FieldPermissionChecker for volumes that expose an identity
+// or sensitive data to the guest.
+// It handles permissions for:
+// - serviceAccount volumes (the token of a service account, i.e. the identity the VM assumes)
+// - secret volumes
+// - downwardAPI volumes
+// - Disks attaching those volumes (matched by name)
+// This is a SUBSET of storage-admin for secret and downwardAPI volumes. serviceAccount volumes are
+// carved out of storage-admin instead: swapping the mounted service account can let a VM assume a
+// more privileged identity, so only identity-admin (or full-admin) can change them. A volume
+// switching between a serviceAccount and another source belongs to neither and requires full-admin.
+type IdentityPermissionChecker struct{}
+
+var _ SubsetPermissionChecker = &IdentityPermissionChecker{}
+
+func (i *IdentityPermissionChecker) Name() string {
+	return "identity"
+}
+
+func (i *IdentityPermissionChecker) Subresource() string {
+	return "virtualmachines/identity-admin"
+}
+
+func (i *IdentityPermissionChecker) IsSubsetOf(name string) bool {
+	return name == "storage"
+}
+
+func (i *IdentityPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	names := i.getIdentityVolumeNames(oldVM, newVM)
+	if len(names) == 0 {
+		return false
+	}
+
+	// Compare the identity volumes
+	oldVolumes := i.getVolumes(oldVM, names)
+	newVolumes := i.getVolumes(newVM, names)
+	volumesChanged := !sameAsSet(oldVolumes, newVolumes, volumeName)
+
+	// Compare the disks attaching them
+	oldDisks := i.getDisks(oldVM, names)
+	newDisks := i.getDisks(newVM, names)
+	disksChanged := !equality.Semantic.DeepEqual(oldDisks, newDisks)
+
+	return volumesChanged || disksChanged
+}
+
+func (i *IdentityPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Remove only identity volumes and their disks, leaving the rest for storage-admin
+	names := i.getIdentityVolumeNames(oldVM, newVM)
+
+	for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+		var volumes []kubevirtiov1.Volume
+		for _, vol := range vm.Spec.Template.Spec.Volumes {
+			if !names[vol.Name] {
+				volumes = append(volumes, vol)
+			}
+		}
+		vm.Spec.Template.Spec.Volumes = volumes
+
+		var disks []kubevirtiov1.Disk
+		for _, disk := range vm.Spec.Template.Spec.Domain.Devices.Disks {
+			if !names[disk.Name] {
+				disks = append(disks, disk)
+			}
+		}
+		vm.Spec.Template.Spec.Domain.Devices.Disks = disks
+	}
+}
+
+// getIdentityVolumeNames returns the names of volumes that are identity volumes in every VM that
+// defines them. A volume switching to or from another source (e.g. a PVC) is not included,
+// so replacing regular storage still requires storage-admin.
+func (i *IdentityPermissionChecker) getIdentityVolumeNames(oldVM, newVM *kubevirtiov1.VirtualMachine) map[string]bool {
+	names := make(map[string]bool)
+	for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+		for _, vol := range vm.Spec.Template.Spec.Volumes {
+			if i.isIdentityVolume(&vol) {
+				names[vol.Name] = true
+			}
+		}
+	}
+
+	// A name that is regular storage in either VM stays with storage-admin
+	for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+		for _, vol := range vm.Spec.Template.Spec.Volumes {
+			if !i.isIdentityVolume(&vol) {
+				delete(names, vol.Name)
+			}
+		}
+	}
+	return names
+}
+
+// isIdentityVolume checks if a volume exposes a service account token or sensitive data
+func (i *IdentityPermissionChecker) isIdentityVolume(volume *kubevirtiov1.Volume) bool {
+	return volume.ServiceAccount != nil || volume.Secret != nil || volume.DownwardAPI != nil
+}
+
+// serviceAccountVolumeNames returns the names of volumes that mount a service account token in
+// either VM, with or without the same source in the other
+func serviceAccountVolumeNames(oldVM, newVM *kubevirtiov1.VirtualMachine) map[string]bool {
+	names := make(map[string]bool)
+	for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+		for _, vol := range vm.Spec.Template.Spec.Volumes {
+			if vol.ServiceAccount != nil {
+				names[vol.Name] = true
+			}
+		}
+	}
+	return names
+}
+
+// getVolumes returns the volumes with names in the provided set
+func (i *IdentityPermissionChecker) getVolumes(vm *kubevirtiov1.VirtualMachine, names map[string]bool) []kubevirtiov1.Volume {
+	var volumes []kubevirtiov1.Volume
+	for _, vol := range vm.Spec.Template.Spec.Volumes {
+		if names[vol.Name] {
+			volumes = append(volumes, vol)
+		}
+	}
+	return volumes
+}
+
+// getDisks returns the disks with names in the provided set
+func (i *IdentityPermissionChecker) getDisks(vm *kubevirtiov1.VirtualMachine, names map[string]bool) []kubevirtiov1.Disk {
+	var disks []kubevirtiov1.Disk
+	for _, disk := range vm.Spec.Template.Spec.Domain.Devices.Disks {
+		if names[disk.Name] {
+			disks = append(disks, disk)
+		}
+	}
+	return disks
+}
+
+// ConfigPermissionChecker implements FieldPermissionChecker for volumes that mount configuration
+// into the guest.
+// It handles permissions for:
+// - secret volumes
+// - configMap volumes
+// - downwardAPI volumes
+// - Disks attaching those volumes (matched by name)
+// This is a SUBSET of storage-admin, so config mounts can be delegated without full storage control.
+// Secret and downwardAPI volumes are also covered by identity-admin; either role allows changing them.
+type ConfigPermissionChecker struct{}
+
+var _ SubsetPermissionChecker = &ConfigPermissionChecker{}
+
+func (c *ConfigPermissionChecker) Name() string {
+	return "config"
+}
+
+func (c *ConfigPermissionChecker) Subresource() string {
+	return "virtualmachines/config-admin"
+}
+
+func (c *ConfigPermissionChecker) IsSubsetOf(name string) bool {
+	return name == "storage"
+}
+
+func (c *ConfigPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	names := c.getConfigVolumeNames(oldVM, newVM)
+	if len(names) == 0 {
+		return false
+	}
+
+	oldSpec := &oldVM.Spec.Template.Spec
+	newSpec := &newVM.Spec.Template.Spec
+	volumesChanged := !sameAsSet(
+		selectByName(oldSpec.Volumes, volumeName, names, true),
+		selectByName(newSpec.Volumes, volumeName, names, true), volumeName)
+	disksChanged := !equality.Semantic.DeepEqual(
+		selectByName(oldSpec.Domain.Devices.Disks, diskName, names, true),
+		selectByName(newSpec.Domain.Devices.Disks, diskName, names, true))
+
+	return volumesChanged || disksChanged
+}
+
+func (c *ConfigPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Remove only config volumes and their disks, leaving the rest for storage-admin
+	names := c.getConfigVolumeNames(oldVM, newVM)
+
+	for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+		spec := &vm.Spec.Template.Spec
+		spec.Volumes = selectByName(spec.Volumes, volumeName, names, false)
+		spec.Domain.Devices.Disks = selectByName(spec.Domain.Devices.Disks, diskName, names, false)
+	}
+}
+
+// getConfigVolumeNames returns the names of volumes that are config volumes in every VM that
+// defines them. A volume switching to or from another source (e.g. a PVC) is not included,
+// so replacing regular storage still requires storage-admin.
+func (c *ConfigPermissionChecker) getConfigVolumeNames(oldVM, newVM *kubevirtiov1.VirtualMachine) map[string]bool {
+	names := make(map[string]bool)
+	for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+		for _, vol := range vm.Spec.Template.Spec.Volumes {
+			if c.isConfigVolume(&vol) {
+				names[vol.Name] = true
+			}
+		}
+	}
+
+	// A name that is another kind of volume in either VM stays with storage-admin
+	for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+		for _, vol := range vm.Spec.Template.Spec.Volumes {
+			if !c.isConfigVolume(&vol) {
+				delete(names, vol.Name)
+			}
+		}
+	}
+	return names
+}
+
+// isConfigVolume checks if a volume mounts a Secret, ConfigMap or downward API data
+func (c *ConfigPermissionChecker) isConfigVolume(volume *kubevirtiov1.Volume) bool {
+	return volume.Secret != nil || volume.ConfigMap != nil || volume.DownwardAPI != nil
+}
+
+func volumeName(volume kubevirtiov1.Volume) string {
+	return volume.Name
+}
+
+func diskName(disk kubevirtiov1.Disk) string {
+	return disk.Name
+}
+
+// NetworkPermissionChecker implements FieldPermissionChecker for network-related fields.
+// It handles permissions for:
+// - Network interfaces (spec.template.spec.domain.devices.interfaces, including link state)
+// - Networks (spec.template.spec.networks)
+// SR-IOV interfaces and their networks are excluded (see SriovPermissionChecker), as are existing
+// interfaces whose MAC address, ports, PCI address or ACPI index changed (see NetworkSecurityPermissionChecker)
+// and binding changes to or from bridge (see BridgePermissionChecker).
+type NetworkPermissionChecker struct{}
+
+var _ FieldPermissionChecker = &NetworkPermissionChecker{}
+
+func (n *NetworkPermissionChecker) Name() string {
+	return "network"
+}
+
+func (n *NetworkPermissionChecker) Subresource() string {
+	return "virtualmachines/network-admin"
+}
+
+func (n *NetworkPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	excludedNames := n.getExcludedNames(oldVM, newVM)
+
+	// Compare network interfaces (excluding SR-IOV, security-relevant edits and bridge binding changes)
+	oldInterfaces := selectInterfaces(oldVM.Spec.Template.Spec.Domain.Devices.Interfaces, excludedNames, false)
+	newInterfaces := selectInterfaces(newVM.Spec.Template.Spec.Domain.Devices.Interfaces, excludedNames, false)
+	interfacesChanged := !equality.Semantic.DeepEqual(oldInterfaces, newInterfaces)
+
+	// Compare networks (excluding those backing excluded interfaces)
+	oldNetworks := selectNetworks(oldVM.Spec.Template.Spec.Networks, excludedNames, false)
+	newNetworks := selectNetworks(newVM.Spec.Template.Spec.Networks, excludedNames, false)
+	networksChanged := !sameAsSet(oldNetworks, newNetworks, networkName)
+
+	return interfacesChanged || networksChanged
+}
+
+func (n *NetworkPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Keep SR-IOV interfaces and their networks, they require sriov-admin, interfaces with
+	// security-relevant edits, they require network-security-admin, and bridge binding changes,
+	// they require bridge-admin
+	excludedNames := n.getExcludedNames(oldVM, newVM)
+
+	// Neutralize network interfaces
+	oldVM.Spec.Template.Spec.Domain.Devices.Interfaces = selectInterfaces(oldVM.Spec.Template.Spec.Domain.Devices.Interfaces, excludedNames, true)
+	newVM.Spec.Template.Spec.Domain.Devices.Interfaces = selectInterfaces(newVM.Spec.Template.Spec.Domain.Devices.Interfaces, excludedNames, true)
+
+	// Neutralize networks
+	oldVM.Spec.Template.Spec.Networks = selectNetworks(oldVM.Spec.Template.Spec.Networks, excludedNames, true)
+	newVM.Spec.Template.Spec.Networks = selectNetworks(newVM.Spec.Template.Spec.Networks, excludedNames, true)
+}
+
+// getExcludedNames returns the names of SR-IOV interfaces, of existing interfaces with
+// security-relevant edits and of interfaces with bridge binding changes, which network-admin
+// does not cover
+func (n *NetworkPermissionChecker) getExcludedNames(oldVM, newVM *kubevirtiov1.VirtualMachine) map[string]bool {
+	names := getSriovInterfaceNames(oldVM, newVM)
+	for name := range getNetworkSecurityChangeNames(oldVM, newVM) {
+		names[name] = true
+	}
+	for name := range getBridgeBindingChangeNames(oldVM, newVM) {
+		names[name] = true
+	}
+	return names
+}
+
+// SriovPermissionChecker implements FieldPermissionChecker for SR-IOV network interfaces.
+// It handles permissions for:
+// - Interfaces with an SR-IOV binding (spec.template.spec.domain.devices.interfaces[].sriov)
+// - Networks backing those interfaces (matched by name)
+// SR-IOV interfaces consume scarce node VF resources, so they are carved out of network-admin.
+type SriovPermissionChecker struct{}
+
+var _ FieldPermissionChecker = &SriovPermissionChecker{}
+
+func (s *SriovPermissionChecker) Name() string {
+	return "sriov"
+}
+
+func (s *SriovPermissionChecker) Subresource() string {
+	return "virtualmachines/sriov-admin"
+}
+
+func (s *SriovPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	// An interface switching to or from SR-IOV is included via its name
+	sriovNames := getSriovInterfaceNames(oldVM, newVM)
+
+	oldInterfaces := selectInterfaces(oldVM.Spec.Template.Spec.Domain.Devices.Interfaces, sriovNames, true)
+	newInterfaces := selectInterfaces(newVM.Spec.Template.Spec.Domain.Devices.Interfaces, sriovNames, true)
+	interfacesChanged := !equality.Semantic.DeepEqual(oldInterfaces, newInterfaces)
+
+	oldNetworks := selectNetworks(oldVM.Spec.Template.Spec.Networks, sriovNames, true)
+	newNetworks := selectNetworks(newVM.Spec.Template.Spec.Networks, sriovNames, true)
+	networksChanged := !sameAsSet(oldNetworks, newNetworks, networkName)
+
+	return interfacesChanged || networksChanged
+}
+
+func (s *SriovPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Remove only SR-IOV interfaces and their networks, leaving the rest for network-admin
+	sriovNames := getSriovInterfaceNames(oldVM, newVM)
+
+	oldVM.Spec.Template.Spec.Domain.Devices.Interfaces = selectInterfaces(oldVM.Spec.Template.Spec.Domain.Devices.Interfaces, sriovNames, false)
+	newVM.Spec.Template.Spec.Domain.Devices.Interfaces = selectInterfaces(newVM.Spec.Template.Spec.Domain.Devices.Interfaces, sriovNames, false)
+
+	oldVM.Spec.Template.Spec.Networks = selectNetworks(oldVM.Spec.Template.Spec.Networks, sriovNames, false)
+	newVM.Spec.Template.Spec.Networks = selectNetworks(newVM.Spec.Template.Spec.Networks, sriovNames, false)
+}
+
+// MultusPermissionChecker implements FieldPermissionChecker for Multus secondary networks.
+// It handles permissions for:
+// - Networks attached to a NetworkAttachmentDefinition (spec.template.spec.networks[].multus)
+// - Interfaces connected to those networks (matched by name)
+// This is a SUBSET of network-admin: Multus networks can bridge to external VLANs, so they can be
+// granted on their own. Pod networking, networks switching to or from Multus, and SR-IOV networks
+// (see SriovPermissionChecker) are not included, nor are binding changes to or from bridge
+// (see BridgePermissionChecker).
+type MultusPermissionChecker struct{}
+
+var _ SubsetPermissionChecker = &MultusPermissionChecker{}
+
+func (m *MultusPermissionChecker) Name() string {
+	return "multus"
+}
+
+func (m *MultusPermissionChecker) Subresource() string {
+	return "virtualmachines/multus-admin"
+}
+
+func (m *MultusPermissionChecker) IsSubsetOf(name string) bool {
+	return name == "network"
+}
+
+func (m *MultusPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	multusNames := getMultusNetworkNames(oldVM, newVM)
+	if len(multusNames) == 0 {
+		return false
+	}
+
+	oldInterfaces := selectInterfaces(oldVM.Spec.Template.Spec.Domain.Devices.Interfaces, multusNames, true)
+	newInterfaces := selectInterfaces(newVM.Spec.Template.Spec.Domain.Devices.Interfaces, multusNames, true)
+	interfacesChanged := !equality.Semantic.DeepEqual(oldInterfaces, newInterfaces)
+
+	oldNetworks := selectNetworks(oldVM.Spec.Template.Spec.Networks, multusNames, true)
+	newNetworks := selectNetworks(newVM.Spec.Template.Spec.Networks, multusNames, true)
+	networksChanged := !sameAsSet(oldNetworks, newNetworks, networkName)
+
+	return interfacesChanged || networksChanged
+}
+
+func (m *MultusPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Remove only Multus networks and their interfaces, leaving pod networking for network-admin
+	multusNames := getMultusNetworkNames(oldVM, newVM)
+
+	oldVM.Spec.Template.Spec.Domain.Devices.Interfaces = selectInterfaces(oldVM.Spec.Template.Spec.Domain.Devices.Interfaces, multusNames, false)
+	newVM.Spec.Template.Spec.Domain.Devices.Interfaces = selectInterfaces(newVM.Spec.Template.Spec.Domain.Devices.Interfaces, multusNames, false)
+
+	oldVM.Spec.Template.Spec.Networks = selectNetworks(oldVM.Spec.Template.Spec.Networks, multusNames, false)
+	newVM.Spec.Template.Spec.Networks = selectNetworks(newVM.Spec.Template.Spec.Networks, multusNames, false)
+}
+
+// getMultusNetworkNames returns the names of networks that are Multus networks in every VM that
+// defines them. A network switching to or from another source (e.g. pod) is not included, so
+// crossing that boundary still requires network-admin. SR-IOV networks are excluded, they
+// require sriov-admin, as are networks whose interface has security-relevant edits, they
+// require network-security-admin, and networks whose interface has a bridge binding change,
+// they require bridge-admin.
+func getMultusNetworkNames(oldVM, newVM *kubevirtiov1.VirtualMachine) map[string]bool {
+	sriovNames := getSriovInterfaceNames(oldVM, newVM)
+	securityNames := getNetworkSecurityChangeNames(oldVM, newVM)
+	bridgeNames := getBridgeBindingChangeNames(oldVM, newVM)
+
+	names := make(map[string]bool)
+	excluded := make(map[string]bool)
+	for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+		for _, network := range vm.Spec.Template.Spec.Networks {
+			if network.Multus != nil && !sriovNames[network.Name] && !securityNames[network.Name] && !bridgeNames[network.Name] {
+				names[network.Name] = true
+			} else {
+				excluded[network.Name] = true
+			}
+		}
+	}
+	for name := range excluded {
+		delete(names, name)
+	}
+	return names
+}
+
+// LinkStatePermissionChecker implements FieldPermissionChecker for interface link state.
+// It handles permissions for:
+// - Link state (spec.template.spec.domain.devices.interfaces[].state)
+// Taking a link down or up is an operational action rather than a reconfiguration, so it can be
+// granted to operators on its own. This is a SUBSET of network-admin: the interfaces must be
+// otherwise unchanged.
+type LinkStatePermissionChecker struct{}
+
+var _ SubsetPermissionChecker = &LinkStatePermissionChecker{}
+
+func (l *LinkStatePermissionChecker) Name() string {
+	return "link-state"
+}
+
+func (l *LinkStatePermissionChecker) Subresource() string {
+	return "virtualmachines/network-operator"
+}
+
+func (l *LinkStatePermissionChecker) IsSubsetOf(name string) bool {
+	return name == "network"
+}
+
+func (l *LinkStatePermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	oldInterfaces := oldVM.Spec.Template.Spec.Domain.Devices.Interfaces
+	newInterfaces := newVM.Spec.Template.Spec.Domain.Devices.Interfaces
+	if equality.Semantic.DeepEqual(oldInterfaces, newInterfaces) {
+		return false
+	}
+
+	// Only a link state change if the interfaces are identical once the state is ignored
+	// (any other interface change, such as adding an interface, requires network-admin)
+	return equality.Semantic.DeepEqual(l.withoutState(oldInterfaces), l.withoutState(newInterfaces))
+}
+
+func (l *LinkStatePermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Clear only the link state, leaving the rest of the interfaces for other checkers
+	oldVM.Spec.Template.Spec.Domain.Devices.Interfaces = l.withoutState(oldVM.Spec.Template.Spec.Domain.Devices.Interfaces)
+	newVM.Spec.Template.Spec.Domain.Devices.Interfaces = l.withoutState(newVM.Spec.Template.Spec.Domain.Devices.Interfaces)
+}
+
+// withoutState returns a copy of the interfaces with the link state cleared
+func (l *LinkStatePermissionChecker) withoutState(interfaces []kubevirtiov1.Interface) []kubevirtiov1.Interface {
+	if interfaces == nil {
+		return nil
+	}
+
+	stripped := make([]kubevirtiov1.Interface, len(interfaces))
+	for i, iface := range interfaces {
+		iface.State = ""
+		stripped[i] = iface
+	}
+	return stripped
+}
+
+// NetworkTuningPermissionChecker implements FieldPermissionChecker for model-only edits of existing
+// network interfaces. It handles permissions for:
+// - Model (spec.template.spec.domain.devices.interfaces[].model, e.g. virtio or e1000)
+// The model affects performance and guest driver compatibility but not what the interface is
+// connected to. The VM API has no interface MTU, it is taken from the network. This is a SUBSET of
+// network-admin and multus-admin: the interfaces must be otherwise unchanged, and SR-IOV interfaces
+// are excluded (see SriovPermissionChecker).
+type NetworkTuningPermissionChecker struct{}
+
+var _ SubsetPermissionChecker = &NetworkTuningPermissionChecker{}
+
+func (n *NetworkTuningPermissionChecker) Name() string {
+	return "network-tuning"
+}
+
+func (n *NetworkTuningPermissionChecker) Subresource() string {
+	return "virtualmachines/network-tuning-admin"
+}
+
+func (n *NetworkTuningPermissionChecker) IsSubsetOf(name string) bool {
+	return slices.Contains([]string{"network", "multus"}, name)
+}
+
+func (n *NetworkTuningPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	return len(getModelChangeNames(oldVM, newVM)) > 0
+}
+
+func (n *NetworkTuningPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Clear only the model of the edited interfaces, leaving the rest of them for other checkers
+	names := getModelChangeNames(oldVM, newVM)
+	oldVM.Spec.Template.Spec.Domain.Devices.Interfaces = n.withoutModel(oldVM.Spec.Template.Spec.Domain.Devices.Interfaces, names)
+	newVM.Spec.Template.Spec.Domain.Devices.Interfaces = n.withoutModel(newVM.Spec.Template.Spec.Domain.Devices.Interfaces, names)
+}
+
+// withoutModel returns a copy of the interfaces with the model cleared on those in the set
+func (n *NetworkTuningPermissionChecker) withoutModel(interfaces []kubevirtiov1.Interface, names map[string]bool) []kubevirtiov1.Interface {
+	if interfaces == nil {
+		return nil
+	}
+
+	stripped := make([]kubevirtiov1.Interface, len(interfaces))
+	for i, iface := range interfaces {
+		if names[iface.Name] {
+			iface.Model = ""
+		}
+		stripped[i] = iface
+	}
+	return stripped
+}
+
+// getModelChangeNames returns the names of non-SR-IOV interfaces present in both VMs whose model
+// changed and that are otherwise identical
+func getModelChangeNames(oldVM, newVM *kubevirtiov1.VirtualMachine) map[string]bool {
+	sriovNames := getSriovInterfaceNames(oldVM, newVM)
+
+	oldInterfaces := make(map[string]kubevirtiov1.Interface)
+	for _, iface := range oldVM.Spec.Template.Spec.Domain.Devices.Interfaces {
+		oldInterfaces[iface.Name] = iface
+	}
+
+	names := make(map[string]bool)
+	for _, newIface := range newVM.Spec.Template.Spec.Domain.Devices.Interfaces {
+		oldIface, found := oldInterfaces[newIface.Name]
+		if !found || sriovNames[newIface.Name] || oldIface.Model == newIface.Model {
+			continue
+		}
+		oldIface.Model, newIface.Model = "", ""
+		if equality.Semantic.DeepEqual(oldIface, newIface) {
+			names[newIface.Name] = true
+		}
+	}
+	return names
+}
+
+// NetworkPortsPermissionChecker implements FieldPermissionChecker for port-only edits of existing
+// network interfaces. It handles permissions for:
+// - Ports (spec.template.spec.domain.devices.interfaces[].ports)
+// Ports expose services of the guest and affect network policy, which is finer-grained than
+// reconfiguring the interface. This is a SUBSET of network-security-admin: the interfaces must be
+// otherwise unchanged, and SR-IOV interfaces are excluded (see SriovPermissionChecker).
+type NetworkPortsPermissionChecker struct{}
+
+var _ SubsetPermissionChecker = &NetworkPortsPermissionChecker{}
+
+func (n *NetworkPortsPermissionChecker) Name() string {
+	return "network-ports"
+}
+
+func (n *NetworkPortsPermissionChecker) Subresource() string {
+	return "virtualmachines/network-ports-admin"
+}
+
+func (n *NetworkPortsPermissionChecker) IsSubsetOf(name string) bool {
+	return slices.Contains([]string{"network-security", "network", "multus"}, name)
+}
+
+func (n *NetworkPortsPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	return len(getPortChangeNames(oldVM, newVM)) > 0
+}
+
+func (n *NetworkPortsPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Clear only the ports of the edited interfaces, leaving the rest of them for other checkers
+	names := getPortChangeNames(oldVM, newVM)
+	oldVM.Spec.Template.Spec.Domain.Devices.Interfaces = n.withoutPorts(oldVM.Spec.Template.Spec.Domain.Devices.Interfaces, names)
+	newVM.Spec.Template.Spec.Domain.Devices.Interfaces = n.withoutPorts(newVM.Spec.Template.Spec.Domain.Devices.Interfaces, names)
+}
+
+// withoutPorts returns a copy of the interfaces with the ports cleared on those in the set
+func (n *NetworkPortsPermissionChecker) withoutPorts(interfaces []kubevirtiov1.Interface, names map[string]bool) []kubevirtiov1.Interface {
+	if interfaces == nil {
+		return nil
+	}
+
+	stripped := make([]kubevirtiov1.Interface, len(interfaces))
+	for i, iface := range interfaces {
+		if names[iface.Name] {
+			iface.Ports = nil
+		}
+		stripped[i] = iface
+	}
+	return stripped
+}
+
+// getPortChangeNames returns the names of non-SR-IOV interfaces present in both VMs whose ports
+// changed and that are otherwise identical
+func getPortChangeNames(oldVM, newVM *kubevirtiov1.VirtualMachine) map[string]bool {
+	sriovNames := getSriovInterfaceNames(oldVM, newVM)
+
+	oldInterfaces := make(map[string]kubevirtiov1.Interface)
+	for _, iface := range oldVM.Spec.Template.Spec.Domain.Devices.Interfaces {
+		oldInterfaces[iface.Name] = iface
+	}
+
+	names := make(map[string]bool)
+	for _, newIface := range newVM.Spec.Template.Spec.Domain.Devices.Interfaces {
+		oldIface, found := oldInterfaces[newIface.Name]
+		if !found || sriovNames[newIface.Name] || equality.Semantic.DeepEqual(oldIface.Ports, newIface.Ports) {
+			continue
+		}
+		oldIface.Ports, newIface.Ports = nil, nil
+		if equality.Semantic.DeepEqual(oldIface, newIface) {
+			names[newIface.Name] = true
+		}
+	}
+	return names
+}
+
+// NetworkSecurityPermissionChecker implements FieldPermissionChecker for security-relevant edits
+// of existing network interfaces. It handles permissions for:
+// - MAC address (spec.template.spec.domain.devices.interfaces[].macAddress)
+// - Ports (spec.template.spec.domain.devices.interfaces[].ports)
+// - PCI address (spec.template.spec.domain.devices.interfaces[].pciAddress)
+// - ACPI index (spec.template.spec.domain.devices.interfaces[].acpiIndex)
+// Editing the MAC address of an existing interface can be used for MAC spoofing on bridged
+// networks, and moving its PCI address or ACPI index renames the NIC in the guest, so these edits
+// are carved out of network-admin and multus-admin. Adding or removing an interface, with or
+// without an explicit address, stays with them. SR-IOV interfaces are excluded (see SriovPermissionChecker).
+type NetworkSecurityPermissionChecker struct{}
+
+var _ SubsetPermissionChecker = &NetworkSecurityPermissionChecker{}
+
+func (n *NetworkSecurityPermissionChecker) Name() string {
+	return "network-security"
+}
+
+func (n *NetworkSecurityPermissionChecker) Subresource() string {
+	return "virtualmachines/network-security-admin"
+}
+
+func (n *NetworkSecurityPermissionChecker) IsSubsetOf(name string) bool {
+	return slices.Contains([]string{"network", "multus"}, name)
+}
+
+func (n *NetworkSecurityPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	return len(getNetworkSecurityChangeNames(oldVM, newVM)) > 0
+}
+
+func (n *NetworkSecurityPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Clear only the security fields of the edited interfaces, leaving the rest of them
+	// (and their networks) for network-admin or multus-admin
+	names := getNetworkSecurityChangeNames(oldVM, newVM)
+	oldVM.Spec.Template.Spec.Domain.Devices.Interfaces = n.withoutSecurity(oldVM.Spec.Template.Spec.Domain.Devices.Interfaces, names)
+	newVM.Spec.Template.Spec.Domain.Devices.Interfaces = n.withoutSecurity(newVM.Spec.Template.Spec.Domain.Devices.Interfaces, names)
+}
+
+// withoutSecurity returns a copy of the interfaces with the security fields cleared on those in the set
+func (n *NetworkSecurityPermissionChecker) withoutSecurity(interfaces []kubevirtiov1.Interface, names map[string]bool) []kubevirtiov1.Interface {
+	if interfaces == nil {
+		return nil
+	}
+
+	stripped := make([]kubevirtiov1.Interface, len(interfaces))
+	for i, iface := range interfaces {
+		if names[iface.Name] {
+			iface.MacAddress = ""
+			iface.Ports = nil
+			iface.PciAddress = ""
+			iface.ACPIIndex = 0
+		}
+		stripped[i] = iface
+	}
+	return stripped
+}
+
+// getNetworkSecurityChangeNames returns the names of non-SR-IOV interfaces present in both VMs
+// whose MAC address, ports, PCI address or ACPI index changed
+func getNetworkSecurityChangeNames(oldVM, newVM *kubevirtiov1.VirtualMachine) map[string]bool {
+	sriovNames := getSriovInterfaceNames(oldVM, newVM)
+
+	oldInterfaces := make(map[string]*kubevirtiov1.Interface)
+	for i := range oldVM.Spec.Template.Spec.Domain.Devices.Interfaces {
+		iface := &oldVM.Spec.Template.Spec.Domain.Devices.Interfaces[i]
+		oldInterfaces[iface.Name] = iface
+	}
+
+	names := make(map[string]bool)
+	for i := range newVM.Spec.Template.Spec.Domain.Devices.Interfaces {
+		newIface := &newVM.Spec.Template.Spec.Domain.Devices.Interfaces[i]
+		oldIface, found := oldInterfaces[newIface.Name]
+		if !found || sriovNames[newIface.Name] {
+			continue
+		}
+		if oldIface.MacAddress != newIface.MacAddress || oldIface.PciAddress != newIface.PciAddress ||
+			oldIface.ACPIIndex != newIface.ACPIIndex || !equality.Semantic.DeepEqual(oldIface.Ports, newIface.Ports) {
+			names[newIface.Name] = true
+		}
+	}
+	return names
+}
+
+// BridgePermissionChecker implements FieldPermissionChecker for bridge bindings of network interfaces.
+// It handles permissions for:
+// - The binding of interfaces switching to or from a bridge binding (spec.template.spec.domain.devices.interfaces[].bridge)
+// - The binding of interfaces added with a bridge binding
+// A bridge binding puts the VM directly on the node's L2 network, as opposed to masquerade, so
+// these binding changes are carved out of network-admin and multus-admin: adding a bridged
+// interface requires them for the interface and bridge-admin for its binding. Only explicit bridge
+// bindings are detected, and removing an interface stays with network-admin. SR-IOV interfaces are
+// excluded (see SriovPermissionChecker).
+type BridgePermissionChecker struct{}
+
+var _ SubsetPermissionChecker = &BridgePermissionChecker{}
+
+func (b *BridgePermissionChecker) Name() string {
+	return "bridge"
+}
+
+func (b *BridgePermissionChecker) Subresource() string {
+	return "virtualmachines/bridge-admin"
+}
+
+func (b *BridgePermissionChecker) IsSubsetOf(name string) bool {
+	return slices.Contains([]string{"network", "multus"}, name)
+}
+
+func (b *BridgePermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	return len(getBridgeBindingChangeNames(oldVM, newVM)) > 0
+}
+
+func (b *BridgePermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Clear only the binding of the changed interfaces, leaving the rest of them (and their
+	// networks) for network-admin or multus-admin
+	names := getBridgeBindingChangeNames(oldVM, newVM)
+	oldVM.Spec.Template.Spec.Domain.Devices.Interfaces = withoutBindings(oldVM.Spec.Template.Spec.Domain.Devices.Interfaces, names)
+	newVM.Spec.Template.Spec.Domain.Devices.Interfaces = withoutBindings(newVM.Spec.Template.Spec.Domain.Devices.Interfaces, names)
+}
+
+// getBridgeBindingChangeNames returns the names of non-SR-IOV interfaces that switch to or from a
+// bridge binding, or that are added with one
+func getBridgeBindingChangeNames(oldVM, newVM *kubevirtiov1.VirtualMachine) map[string]bool {
+	sriovNames := getSriovInterfaceNames(oldVM, newVM)
+
+	oldBridged := make(map[string]bool)
+	for _, iface := range oldVM.Spec.Template.Spec.Domain.Devices.Interfaces {
+		oldBridged[iface.Name] = iface.Bridge != nil
+	}
+
+	names := make(map[string]bool)
+	for _, newIface := range newVM.Spec.Template.Spec.Domain.Devices.Interfaces {
+		bridged, found := oldBridged[newIface.Name]
+		if sriovNames[newIface.Name] || (!found && newIface.Bridge == nil) || (found && bridged == (newIface.Bridge != nil)) {
+			continue
+		}
+		names[newIface.Name] = true
+	}
+	return names
+}
+
+// withoutBindings returns a copy of the interfaces with the binding cleared on those in the set
+func withoutBindings(interfaces []kubevirtiov1.Interface, names map[string]bool) []kubevirtiov1.Interface {
+	if interfaces == nil {
+		return nil
+	}
+
+	stripped := make([]kubevirtiov1.Interface, len(interfaces))
+	for i, iface := range interfaces {
+		if names[iface.Name] {
+			iface.InterfaceBindingMethod = kubevirtiov1.InterfaceBindingMethod{}
+			iface.Binding = nil
+		}
+		stripped[i] = iface
+	}
+	return stripped
+}
+
+// getSriovInterfaceNames returns the names of interfaces with an SR-IOV binding in either VM
+func getSriovInterfaceNames(oldVM, newVM *kubevirtiov1.VirtualMachine) map[string]bool {
+	names := make(map[string]bool)
+	for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+		for _, iface := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
+			if iface.SRIOV != nil {
+				names[iface.Name] = true
+			}
+		}
+	}
+	return names
+}
+
+// selectInterfaces returns the interfaces whose name is (match=true) or is not (match=false) in the set
+func selectInterfaces(interfaces []kubevirtiov1.Interface, names map[string]bool, match bool) []kubevirtiov1.Interface {
+	var selected []kubevirtiov1.Interface
+	for _, iface := range interfaces {
+		if names[iface.Name] == match {
+			selected = append(selected, iface)
+		}
+	}
+	return selected
+}
+
+// selectNetworks returns the networks whose name is (match=true) or is not (match=false) in the set
+func selectNetworks(networks []kubevirtiov1.Network, names map[string]bool, match bool) []kubevirtiov1.Network {
+	var selected []kubevirtiov1.Network
+	for _, network := range networks {
+		if names[network.Name] == match {
+			selected = append(selected, network)
+		}
+	}
+	return selected
+}
+
+// ComputePermissionChecker implements FieldPermissionChecker for compute-related fields.
+// It handles permissions for:
+// - CPU configuration (spec.template.spec.domain.cpu, including features, also covered by the cpu-features-admin subset)
+// CPU placement (dedicated CPUs, emulator thread, NUMA, realtime) is excluded, see CPUPinningPermissionChecker.
+// CPU sockets and threads are excluded too, see CPUTopologyPermissionChecker; cores stay with compute.
+// - Memory and resource requests/limits (spec.template.spec.domain.resources, the memory limit also covered by the memory-limit-user subset)
+// - Guest memory and hugepages (spec.template.spec.domain.memory)
+// Memory increases of hugepage-backed VMs are excluded, see HugepagesPermissionChecker.
+// With RequireLiveAdminWhenRunning, changes to a running VM require compute-live-admin instead.
+type ComputePermissionChecker struct {
+	// RequireLiveAdminWhenRunning requires virtualmachines/compute-live-admin for changes to
+	// running VMs, since live-applied compute changes affect the workload immediately
+	RequireLiveAdminWhenRunning bool
+}
+
+var _ ContextAwarePermissionChecker = &ComputePermissionChecker{}
+
+func (c *ComputePermissionChecker) Name() string {
+	return "compute"
+}
+
+func (c *ComputePermissionChecker) Subresource() string {
+	return "virtualmachines/compute-admin"
+}
+
+func (c *ComputePermissionChecker) SubresourceFor(dc DecisionContext) string {
+	if c.RequireLiveAdminWhenRunning && dc.Running {
+		return "virtualmachines/compute-live-admin"
+	}
+	return c.Subresource()
+}
+
+func (c *ComputePermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	// Memory increases of hugepage-backed VMs are left to hugepages-admin
+	newVM = withoutHugepageMemoryIncreases(oldVM, newVM)
+
+	// Compare CPU configuration, except the placement left to cpu-pinning-admin and the
+	// sockets and threads left to cpu-topology-admin
+	oldCPU := withoutCPUTopology(withoutCPUPlacement(oldVM.Spec.Template.Spec.Domain.CPU))
+	newCPU := withoutCPUTopology(withoutCPUPlacement(newVM.Spec.Template.Spec.Domain.CPU))
+	cpuChanged := !sameCPU(oldCPU, newCPU)
+
+	// Compare resource requirements (memory, limits, requests)
+	oldResources := oldVM.Spec.Template.Spec.Domain.Resources
+	newResources := newVM.Spec.Template.Spec.Domain.Resources
+	resourcesChanged := !equality.Semantic.DeepEqual(oldResources, newResources)
+
+	// Compare memory (guest size, hugepages)
+	oldMemory := oldVM.Spec.Template.Spec.Domain.Memory
+	newMemory := newVM.Spec.Template.Spec.Domain.Memory
+	memoryChanged := !equality.Semantic.DeepEqual(oldMemory, newMemory)
+
+	return cpuChanged || resourcesChanged || memoryChanged
+}
+
+func (c *ComputePermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Neutralize CPU, keeping the placement and the sockets and threads so that changing them
+	// without cpu-pinning-admin or cpu-topology-admin is denied
+	oldVM.Spec.Template.Spec.Domain.CPU = cpuCarveOuts(oldVM.Spec.Template.Spec.Domain.CPU)
+	newVM.Spec.Template.Spec.Domain.CPU = cpuCarveOuts(newVM.Spec.Template.Spec.Domain.CPU)
+
+	// Neutralize resources and memory, keeping the increases of a hugepage-backed VM so that
+	// making them without hugepages-admin is denied
+	request, guest := hugepageMemoryIncreases(oldVM, newVM)
+	var hugepages *kubevirtiov1.Hugepages
+	if newVM.Spec.Template.Spec.Domain.Memory != nil {
+		hugepages = newVM.Spec.Template.Spec.Domain.Memory.Hugepages
+	}
+	oldResources, oldMemory := hugepageMemoryIncreaseOnly(oldVM, request, guest, hugepages)
+	newResources, newMemory := hugepageMemoryIncreaseOnly(newVM, request, guest, hugepages)
+	oldVM.Spec.Template.Spec.Domain.Resources, oldVM.Spec.Template.Spec.Domain.Memory = oldResources, oldMemory
+	newVM.Spec.Template.Spec.Domain.Resources, newVM.Spec.Template.Spec.Domain.Memory = newResources, newMemory
+}
+
+// CPUPinningPermissionChecker implements FieldPermissionChecker for CPU and thread placement.
+// It handles permissions for:
+// - Dedicated CPU placement (spec.template.spec.domain.cpu.dedicatedCpuPlacement)
+// - Emulator thread isolation (spec.template.spec.domain.cpu.isolateEmulatorThread)
+// - Guest NUMA topology and realtime tuning (spec.template.spec.domain.cpu.numa/realtime)
+// - IOThreads policy and count (spec.template.spec.domain.ioThreadsPolicy/ioThreads)
+// Placement reserves whole pCPUs on the node and affects node CPU accounting, so it is carved
+// out of compute-admin: compute-admin alone cannot change it.
+type CPUPinningPermissionChecker struct{}
+
+var _ FieldPermissionChecker = &CPUPinningPermissionChecker{}
+
+func (c *CPUPinningPermissionChecker) Name() string {
+	return "cpu-pinning"
+}
+
+func (c *CPUPinningPermissionChecker) Subresource() string {
+	return "virtualmachines/cpu-pinning-admin"
+}
+
+func (c *CPUPinningPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	oldDomain := &oldVM.Spec.Template.Spec.Domain
+	newDomain := &newVM.Spec.Template.Spec.Domain
+	return !equality.Semantic.DeepEqual(cpuPlacement(oldDomain.CPU), cpuPlacement(newDomain.CPU)) ||
+		!equality.Semantic.DeepEqual(oldDomain.IOThreadsPolicy, newDomain.IOThreadsPolicy) ||
+		!equality.Semantic.DeepEqual(oldDomain.IOThreads, newDomain.IOThreads)
+}
+
+func (c *CPUPinningPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Clear only the placement, leaving the rest of the CPU settings for compute-admin
+	for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+		domain := &vm.Spec.Template.Spec.Domain
+		domain.CPU = withoutCPUPlacement(domain.CPU)
+		domain.IOThreadsPolicy = nil
+		domain.IOThreads = nil
+	}
+}
+
+// cpuPlacement returns the placement fields of the CPU settings, or nil if none are set
+func cpuPlacement(cpu *kubevirtiov1.CPU) *kubevirtiov1.CPU {
+	if cpu == nil {
+		return nil
+	}
+
+	placement := &kubevirtiov1.CPU{
+		DedicatedCPUPlacement: cpu.DedicatedCPUPlacement,
+		IsolateEmulatorThread: cpu.IsolateEmulatorThread,
+		NUMA:                  cpu.NUMA.DeepCopy(),
+		Realtime:              cpu.Realtime.DeepCopy(),
+	}
+	if equality.Semantic.DeepEqual(*placement, kubevirtiov1.CPU{}) {
+		return nil
+	}
+	return placement
+}
+
+// withoutCPUPlacement returns a copy of the CPU settings with the placement fields cleared,
+// or nil if nothing else is set
+func withoutCPUPlacement(cpu *kubevirtiov1.CPU) *kubevirtiov1.CPU {
+	if cpu == nil {
+		return nil
+	}
+
+	stripped := cpu.DeepCopy()
+	stripped.DedicatedCPUPlacement = false
+	stripped.IsolateEmulatorThread = false
+	stripped.NUMA = nil
+	stripped.Realtime = nil
+	if equality.Semantic.DeepEqual(*stripped, kubevirtiov1.CPU{}) {
+		return nil
+	}
+	return stripped
+}
+
+// CPUTopologyPermissionChecker implements FieldPermissionChecker for the guest CPU topology.
+// It handles permissions for:
+// - CPU sockets (spec.template.spec.domain.cpu.sockets)
+// - CPU threads per core (spec.template.spec.domain.cpu.threads)
+// Software is often licensed per socket, so the socket and thread topology is carved out of
+// compute-admin: compute-admin alone cannot change it, but can still change the cores.
+type CPUTopologyPermissionChecker struct{}
+
+var _ FieldPermissionChecker = &CPUTopologyPermissionChecker{}
+
+func (c *CPUTopologyPermissionChecker) Name() string {
+	return "cpu-topology"
+}
+
+func (c *CPUTopologyPermissionChecker) Subresource() string {
+	return "virtualmachines/cpu-topology-admin"
+}
+
+func (c *CPUTopologyPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	return !equality.Semantic.DeepEqual(cpuTopology(oldVM.Spec.Template.Spec.Domain.CPU), cpuTopology(newVM.Spec.Template.Spec.Domain.CPU))
+}
+
+func (c *CPUTopologyPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Clear only the sockets and threads, leaving the rest of the CPU settings for other checkers
+	for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+		domain := &vm.Spec.Template.Spec.Domain
+		domain.CPU = withoutCPUTopology(domain.CPU)
+	}
+}
+
+// cpuTopology returns the sockets and threads of the CPU settings, or nil if neither is set
+func cpuTopology(cpu *kubevirtiov1.CPU) *kubevirtiov1.CPU {
+	if cpu == nil || (cpu.Sockets == 0 && cpu.Threads == 0) {
+		return nil
+	}
+	return &kubevirtiov1.CPU{Sockets: cpu.Sockets, Threads: cpu.Threads}
+}
+
+// withoutCPUTopology returns a copy of the CPU settings with the sockets and threads cleared,
+// or nil if nothing else is set
+func withoutCPUTopology(cpu *kubevirtiov1.CPU) *kubevirtiov1.CPU {
+	if cpu == nil {
+		return nil
+	}
+
+	stripped := cpu.DeepCopy()
+	stripped.Sockets = 0
+	stripped.Threads = 0
+	if equality.Semantic.DeepEqual(*stripped, kubevirtiov1.CPU{}) {
+		return nil
+	}
+	return stripped
+}
+
+// cpuCarveOuts returns the CPU settings carved out of compute-admin, the placement and the
+// sockets and threads, or nil if none are set
+func cpuCarveOuts(cpu *kubevirtiov1.CPU) *kubevirtiov1.CPU {
+	carved := cpuPlacement(cpu)
+	if topology := cpuTopology(cpu); topology != nil {
+		if carved == nil {
+			carved = &kubevirtiov1.CPU{}
+		}
+		carved.Sockets, carved.Threads = topology.Sockets, topology.Threads
+	}
+	return carved
+}
+
+// HugepagesPermissionChecker implements FieldPermissionChecker for the memory of hugepage-backed VMs.
+// It handles permissions for:
+// - Increases of the memory request (spec.template.spec.domain.resources.requests.memory)
+// - Increases of the guest memory (spec.template.spec.domain.memory.guest)
+// when the VM is backed by hugepages (spec.template.spec.domain.memory.hugepages). Its memory is
+// then served from the node's preallocated hugepages, which are scarce, so such increases are
+// carved out of compute-admin and memory-resize-user: they cannot make them on their own.
+// Decreases and every other memory setting, including hugepages themselves, stay with compute-admin.
+type HugepagesPermissionChecker struct{}
+
+var _ FieldPermissionChecker = &HugepagesPermissionChecker{}
+
+func (h *HugepagesPermissionChecker) Name() string {
+	return "hugepages"
+}
+
+func (h *HugepagesPermissionChecker) Subresource() string {
+	return "virtualmachines/hugepages-admin"
+}
+
+func (h *HugepagesPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	request, guest := hugepageMemoryIncreases(oldVM, newVM)
+	return request || guest
+}
+
+func (h *HugepagesPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	// Revert only the increases, leaving every other memory change for compute-admin
+	revertHugepageMemoryIncreases(oldVM, newVM)
+}
+
+// hugepageMemoryIncreases reports whether the update increases the memory request or the guest
+// memory of a VM that is backed by hugepages once updated
+func hugepageMemoryIncreases(oldVM, newVM *kubevirtiov1.VirtualMachine) (request, guest bool) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false, false
+	}
+
+	oldDomain := &oldVM.Spec.Template.Spec.Domain
+	newDomain := &newVM.Spec.Template.Spec.Domain
+	if newDomain.Memory == nil || newDomain.Memory.Hugepages == nil {
+		return false, false
+	}
+
+	oldRequest := oldDomain.Resources.Requests[corev1.ResourceMemory]
+	newRequest := newDomain.Resources.Requests[corev1.ResourceMemory]
+	request = newRequest.Cmp(oldRequest) > 0
+
+	var oldGuest, newGuest resource.Quantity
+	if oldDomain.Memory != nil && oldDomain.Memory.Guest != nil {
+		oldGuest = *oldDomain.Memory.Guest
+	}
+	if newDomain.Memory.Guest != nil {
+		newGuest = *newDomain.Memory.Guest
+	}
+	guest = newGuest.Cmp(oldGuest) > 0
+	return request, guest
+}
+
+// revertHugepageMemoryIncreases sets the increased memory request and guest memory of a
+// hugepage-backed VM back to their old values in the new VM
+func revertHugepageMemoryIncreases(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	request, guest := hugepageMemoryIncreases(oldVM, newVM)
+	if !request && !guest {
+		return
+	}
+
+	oldDomain := &oldVM.Spec.Template.Spec.Domain
+	newDomain := &newVM.Spec.Template.Spec.Domain
+	if request {
+		if oldRequest, found := oldDomain.Resources.Requests[corev1.ResourceMemory]; found {
+			newDomain.Resources.Requests[corev1.ResourceMemory] = oldRequest.DeepCopy()
+		} else {
+			delete(newDomain.Resources.Requests, corev1.ResourceMemory)
+			if len(newDomain.Resources.Requests) == 0 {
+				newDomain.Resources.Requests = nil
+			}
+		}
+	}
+	if guest {
+		newDomain.Memory.Guest = nil
+		if oldDomain.Memory != nil && oldDomain.Memory.Guest != nil {
+			oldGuest := oldDomain.Memory.Guest.DeepCopy()
+			newDomain.Memory.Guest = &oldGuest
+		}
+	}
+}
+
+// withoutHugepageMemoryIncreases returns the new VM with the increases left to hugepages-admin
+// reverted, as a copy if there are any
+func withoutHugepageMemoryIncreases(oldVM, newVM *kubevirtiov1.VirtualMachine) *kubevirtiov1.VirtualMachine {
+	if request, guest := hugepageMemoryIncreases(oldVM, newVM); !request && !guest {
+		return newVM
+	}
+
+	reverted := newVM.DeepCopy()
+	revertHugepageMemoryIncreases(oldVM, reverted)
+	return reverted
+}
+
+// hugepageMemoryIncreaseOnly returns the resources and memory settings of the VM reduced to the
+// fields increased on a hugepage-backed VM, with the given hugepages so the increase is still
+// detected, or empty settings if there is no such increase
+func hugepageMemoryIncreaseOnly(vm *kubevirtiov1.VirtualMachine, request, guest bool, hugepages *kubevirtiov1.Hugepages) (kubevirtiov1.ResourceRequirements, *kubevirtiov1.Memory) {
+	if !request && !guest {
+		return kubevirtiov1.ResourceRequirements{}, nil
+	}
+
+	domain := &vm.Spec.Template.Spec.Domain
+	var resources kubevirtiov1.ResourceRequirements
+	if value, found := domain.Resources.Requests[corev1.ResourceMemory]; request && found {
+		resources.Requests = corev1.ResourceList{corev1.ResourceMemory: value.DeepCopy()}
+	}
+	memory := &kubevirtiov1.Memory{Hugepages: hugepages.DeepCopy()}
+	if guest && domain.Memory != nil && domain.Memory.Guest != nil {
+		value := domain.Memory.Guest.DeepCopy()
+		memory.Guest = &value
+	}
+	return resources, memory
+}
+
+// MemoryResizePermissionChecker implements FieldPermissionChecker for guest memory resizing.
+// It handles permissions for:
+// - Guest memory (spec.template.spec.domain.memory.guest)
+// This is a SUBSET of compute-admin: hugepages pin node resources and maxGuest bounds
+// memory hotplug, so both still require compute-admin. Growing the guest memory of a
+// hugepage-backed VM is not a resize, see HugepagesPermissionChecker.
+type MemoryResizePermissionChecker struct{}
+
+var _ SubsetPermissionChecker = &MemoryResizePermissionChecker{}
+
+func (m *MemoryResizePermissionChecker) Name() string {
+	return "memory-resize"
+}
+
+func (m *MemoryResizePermissionChecker) Subresource() string {
+	return "virtualmachines/memory-resize-user"
+}
+
+func (m *MemoryResizePermissionChecker) IsSubsetOf(name string) bool {
+	return name == "compute"
+}
+
+func (m *MemoryResizePermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	oldMemory := oldVM.Spec.Template.Spec.Domain.Memory
+	newMemory := newVM.Spec.Template.Spec.Domain.Memory
+	if equality.Semantic.DeepEqual(oldMemory, newMemory) {
+		return false
+	}
+
+	// Growing the guest memory of a hugepage-backed VM requires hugepages-admin
+	if _, guest := hugepageMemoryIncreases(oldVM, newVM); guest {
+		return false
+	}
+
+	// Only a resize if the memory settings are identical once the guest size is ignored
+	// (any hugepages or maxGuest change requires compute-admin)
+	return equality.Semantic.DeepEqual(m.withoutGuest(oldMemory), m.withoutGuest(newMemory))
+}
+
+func (m *MemoryResizePermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Clear only the guest size, leaving hugepages and maxGuest for compute-admin
+	oldVM.Spec.Template.Spec.Domain.Memory = m.withoutGuest(oldVM.Spec.Template.Spec.Domain.Memory)
+	newVM.Spec.Template.Spec.Domain.Memory = m.withoutGuest(newVM.Spec.Template.Spec.Domain.Memory)
+}
+
+// withoutGuest returns a copy of the memory settings with the guest size cleared,
+// or nil if nothing else is set (so adding memory.guest to a VM without memory settings is a resize)
+func (m *MemoryResizePermissionChecker) withoutGuest(memory *kubevirtiov1.Memory) *kubevirtiov1.Memory {
+	if memory == nil {
+		return nil
+	}
+
+	stripped := memory.DeepCopy()
+	stripped.Guest = nil
+	if equality.Semantic.DeepEqual(*stripped, kubevirtiov1.Memory{}) {
+		return nil
+	}
+	return stripped
+}
+
+// MemoryLimitPermissionChecker implements FieldPermissionChecker for memory limit changes.
+// It handles permissions for:
+// - Memory limit (spec.template.spec.domain.resources.limits.memory)
+// Raising a limit allows overcommit without reserving more node capacity, unlike raising a
+// request. This is a SUBSET of compute-admin: requests, other limits and overcommitGuestOverhead
+// still require compute-admin, so a change to both the memory request and limit does too.
+type MemoryLimitPermissionChecker struct{}
+
+var _ SubsetPermissionChecker = &MemoryLimitPermissionChecker{}
+
+func (m *MemoryLimitPermissionChecker) Name() string {
+	return "memory-limit"
+}
+
+func (m *MemoryLimitPermissionChecker) Subresource() string {
+	return "virtualmachines/memory-limit-user"
+}
+
+func (m *MemoryLimitPermissionChecker) IsSubsetOf(name string) bool {
+	return name == "compute"
+}
+
+func (m *MemoryLimitPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	oldResources := oldVM.Spec.Template.Spec.Domain.Resources
+	newResources := newVM.Spec.Template.Spec.Domain.Resources
+	if equality.Semantic.DeepEqual(oldResources, newResources) {
+		return false
+	}
+
+	// Only a limit change if the resources are identical once the memory limit is ignored
+	// (any request change requires compute-admin)
+	return equality.Semantic.DeepEqual(withoutMemoryLimit(oldResources), withoutMemoryLimit(newResources))
+}
+
+func (m *MemoryLimitPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Clear only the memory limit, leaving requests and other limits for compute-admin
+	oldVM.Spec.Template.Spec.Domain.Resources = withoutMemoryLimit(oldVM.Spec.Template.Spec.Domain.Resources)
+	newVM.Spec.Template.Spec.Domain.Resources = withoutMemoryLimit(newVM.Spec.Template.Spec.Domain.Resources)
+}
+
+// withoutMemoryLimit returns a copy of the resource requirements without the memory limit
+func withoutMemoryLimit(resources kubevirtiov1.ResourceRequirements) kubevirtiov1.ResourceRequirements {
+	stripped := resources.DeepCopy()
+	delete(stripped.Limits, corev1.ResourceMemory)
+	if len(stripped.Limits) == 0 {
+		stripped.Limits = nil
+	}
+	return *stripped
+}
+
+// CPUFeaturesPermissionChecker implements FieldPermissionChecker for guest CPU feature flags.
+// It handles permissions for:
+// - CPU features (spec.template.spec.domain.cpu.features)
+// Feature flags can expose speculative-execution-relevant instructions or break migration,
+// so they can be granted on their own. This is a SUBSET of compute-admin: the rest of the
+// CPU configuration (e.g. cores, model) still requires compute-admin.
+type CPUFeaturesPermissionChecker struct{}
+
+var _ SubsetPermissionChecker = &CPUFeaturesPermissionChecker{}
+
+func (c *CPUFeaturesPermissionChecker) Name() string {
+	return "cpu-features"
+}
+
+func (c *CPUFeaturesPermissionChecker) Subresource() string {
+	return "virtualmachines/cpu-features-admin"
+}
+
+func (c *CPUFeaturesPermissionChecker) IsSubsetOf(name string) bool {
+	return name == "compute"
+}
+
+func (c *CPUFeaturesPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	oldCPU := oldVM.Spec.Template.Spec.Domain.CPU
+	newCPU := newVM.Spec.Template.Spec.Domain.CPU
+	if sameCPU(oldCPU, newCPU) {
+		return false
+	}
+
+	// Only a feature change if the CPU settings are identical once the features are ignored
+	// (any other CPU change, such as the core count, requires compute-admin)
+	return equality.Semantic.DeepEqual(c.withoutFeatures(oldCPU), c.withoutFeatures(newCPU))
+}
+
+func (c *CPUFeaturesPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Clear only the features, leaving the rest of the CPU settings for compute-admin
+	oldVM.Spec.Template.Spec.Domain.CPU = c.withoutFeatures(oldVM.Spec.Template.Spec.Domain.CPU)
+	newVM.Spec.Template.Spec.Domain.CPU = c.withoutFeatures(newVM.Spec.Template.Spec.Domain.CPU)
+}
+
+// withoutFeatures returns a copy of the CPU settings with the features cleared,
+// or nil if nothing else is set (so adding cpu.features to a VM without CPU settings is a feature change)
+func (c *CPUFeaturesPermissionChecker) withoutFeatures(cpu *kubevirtiov1.CPU) *kubevirtiov1.CPU {
+	if cpu == nil {
+		return nil
+	}
+
+	stripped := cpu.DeepCopy()
+	stripped.Features = nil
+	if equality.Semantic.DeepEqual(*stripped, kubevirtiov1.CPU{}) {
+		return nil
+	}
+	return stripped
+}
+
+// DevicesPermissionChecker implements FieldPermissionChecker for device-related fields.
+// It handles permissions for:
+// - GPUs (spec.template.spec.domain.devices.gpus), also covered by gpu-admin
+// - Host devices (spec.template.spec.domain.devices.hostDevices)
+// - Watchdog (spec.template.spec.domain.devices.watchdog)
+// - TPM (spec.template.spec.domain.devices.tpm)
+// - Input devices (spec.template.spec.domain.devices.inputs)
+// - Random number generator (spec.template.spec.domain.devices.rng)
+// NOTE: Does NOT include disks, interfaces, or filesystems (covered by storage/network)
+// With RequireInputAdminForTypeChanges, input type/bus changes are left to InputPermissionChecker.
+// With RequireFullAdminForRemovals, removed GPUs and host devices are left for full-admin.
+type DevicesPermissionChecker struct {
+	// RequireInputAdminForTypeChanges excludes input type/bus changes (see InputPermissionChecker)
+	// from devices-admin, so they require virtualmachines/input-admin
+	RequireInputAdminForTypeChanges bool
+
+	// RequireFullAdminForRemovals excludes removals of GPUs and host devices from devices-admin,
+	// so losing one from a VM requires virtualmachines/full-admin. Adding them is unaffected.
+	RequireFullAdminForRemovals bool
+}
+
+var _ FieldPermissionChecker = &DevicesPermissionChecker{}
+
+func (d *DevicesPermissionChecker) Name() string {
+	return "devices"
+}
+
+func (d *DevicesPermissionChecker) Subresource() string {
+	return "virtualmachines/devices-admin"
+}
+
+func (d *DevicesPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	oldDevices := oldVM.Spec.Template.Spec.Domain.Devices
+	newDevices := newVM.Spec.Template.Spec.Domain.Devices
+
+	// Compare GPUs and host devices, excluding removals if they require full-admin
+	removedGPUs, removedHostDevices := d.privilegedRemovals(oldVM, newVM)
+	gpusChanged := !equality.Semantic.DeepEqual(selectByName(oldDevices.GPUs, gpuName, removedGPUs, false),
+		selectByName(newDevices.GPUs, gpuName, removedGPUs, false))
+	hostDevicesChanged := !equality.Semantic.DeepEqual(selectByName(oldDevices.HostDevices, hostDeviceName, removedHostDevices, false),
+		selectByName(newDevices.HostDevices, hostDeviceName, removedHostDevices, false))
+
+	// Compare watchdog
+	watchdogChanged := !equality.Semantic.DeepEqual(oldDevices.Watchdog, newDevices.Watchdog)
+
+	// Compare TPM
+	tpmChanged := !equality.Semantic.DeepEqual(oldDevices.TPM, newDevices.TPM)
+
+	// Compare input devices
+	privilegedInputs := d.privilegedInputNames(oldVM, newVM)
+	inputsChanged := !equality.Semantic.DeepEqual(selectInputs(oldDevices.Inputs, privilegedInputs, false),
+		selectInputs(newDevices.Inputs, privilegedInputs, false))
+
+	// Compare RNG device
+	rngChanged := !equality.Semantic.DeepEqual(oldDevices.Rng, newDevices.Rng)
+
+	return gpusChanged || hostDevicesChanged || watchdogChanged || tpmChanged || inputsChanged || rngChanged
+}
+
+func (d *DevicesPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Neutralize GPUs and host devices, keeping removals if they require full-admin
+	removedGPUs, removedHostDevices := d.privilegedRemovals(oldVM, newVM)
+	oldVM.Spec.Template.Spec.Domain.Devices.GPUs = selectByName(oldVM.Spec.Template.Spec.Domain.Devices.GPUs, gpuName, removedGPUs, true)
+	newVM.Spec.Template.Spec.Domain.Devices.GPUs = nil
+
+	oldVM.Spec.Template.Spec.Domain.Devices.HostDevices = selectByName(oldVM.Spec.Template.Spec.Domain.Devices.HostDevices, hostDeviceName, removedHostDevices, true)
+	newVM.Spec.Template.Spec.Domain.Devices.HostDevices = nil
+
+	// Neutralize watchdog
+	oldVM.Spec.Template.Spec.Domain.Devices.Watchdog = nil
+	newVM.Spec.Template.Spec.Domain.Devices.Watchdog = nil
+
+	// Neutralize TPM
+	oldVM.Spec.Template.Spec.Domain.Devices.TPM = nil
+	newVM.Spec.Template.Spec.Domain.Devices.TPM = nil
+
+	// Neutralize input devices, keeping type/bus changes if they require input-admin
+	privilegedInputs := d.privilegedInputNames(oldVM, newVM)
+	oldVM.Spec.Template.Spec.Domain.Devices.Inputs = selectInputs(oldVM.Spec.Template.Spec.Domain.Devices.Inputs, privilegedInputs, true)
+	newVM.Spec.Template.Spec.Domain.Devices.Inputs = selectInputs(newVM.Spec.Template.Spec.Domain.Devices.Inputs, privilegedInputs, true)
+
+	// Neutralize RNG device
+	oldVM.Spec.Template.Spec.Domain.Devices.Rng = nil
+	newVM.Spec.Template.Spec.Domain.Devices.Rng = nil
+}
+
+// privilegedInputNames returns the input type/bus changes devices-admin does not cover
+// (none unless RequireInputAdminForTypeChanges is set)
+func (d *DevicesPermissionChecker) privilegedInputNames(oldVM, newVM *kubevirtiov1.VirtualMachine) map[string]bool {
+	if !d.RequireInputAdminForTypeChanges {
+		return nil
+	}
+	return getInputTypeChangeNames(oldVM, newVM)
+}
+
+// privilegedRemovals returns the names of removed GPUs and host devices, which devices-admin
+// does not cover (none unless RequireFullAdminForRemovals is set)
+func (d *DevicesPermissionChecker) privilegedRemovals(oldVM, newVM *kubevirtiov1.VirtualMachine) (gpus, hostDevices map[string]bool) {
+	if !d.RequireFullAdminForRemovals {
+		return nil, nil
+	}
+
+	oldDevices := oldVM.Spec.Template.Spec.Domain.Devices
+	newDevices := newVM.Spec.Template.Spec.Domain.Devices
+	return removedNames(oldDevices.GPUs, newDevices.GPUs, gpuName),
+		removedNames(oldDevices.HostDevices, newDevices.HostDevices, hostDeviceName)
+}
+
+func gpuName(gpu kubevirtiov1.GPU) string {
+	return gpu.Name
+}
+
+func hostDeviceName(device kubevirtiov1.HostDevice) string {
+	return device.Name
+}
+
+// removedNames returns the names of the items in oldItems that are missing from newItems
+func removedNames[T any](oldItems, newItems []T, name func(T) string) map[string]bool {
+	kept := make(map[string]bool, len(newItems))
+	for _, item := range newItems {
+		kept[name(item)] = true
+	}
+
+	removed := make(map[string]bool)
+	for _, item := range oldItems {
+		if !kept[name(item)] {
+			removed[name(item)] = true
+		}
+	}
+	return removed
+}
+
+// selectByName returns the items whose name is (match=true) or is not (match=false) in the set
+func selectByName[T any](items []T, name func(T) string, names map[string]bool, match bool) []T {
+	var selected []T
+	for _, item := range items {
+		if names[name(item)] == match {
+			selected = append(selected, item)
+		}
+	}
+	return selected
+}
+
+// InputPermissionChecker implements FieldPermissionChecker for input device type/bus changes.
+// It handles permissions for:
+// - Inputs whose type or bus changes (spec.template.spec.domain.devices.inputs[].type/bus)
+// - Added inputs that are not a standard tablet (e.g. a keyboard)
+// Converting input devices can matter for VDI security. By default this is a SUBSET of
+// devices-admin; with DevicesPermissionChecker.RequireInputAdminForTypeChanges, devices-admin no
+// longer covers these changes and input-admin is required. Adding or removing a tablet stays devices-admin.
+type InputPermissionChecker struct{}
+
+var _ SubsetPermissionChecker = &InputPermissionChecker{}
+
+func (i *InputPermissionChecker) Name() string {
+	return "input"
+}
+
+func (i *InputPermissionChecker) Subresource() string {
+	return "virtualmachines/input-admin"
+}
+
+func (i *InputPermissionChecker) IsSubsetOf(name string) bool {
+	return name == "devices"
+}
+
+func (i *InputPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	// Every name in the set is a type/bus change or an added non-tablet input
+	return len(getInputTypeChangeNames(oldVM, newVM)) > 0
+}
+
+func (i *InputPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Remove only the converted inputs, leaving other input changes for devices-admin
+	names := getInputTypeChangeNames(oldVM, newVM)
+	oldVM.Spec.Template.Spec.Domain.Devices.Inputs = selectInputs(oldVM.Spec.Template.Spec.Domain.Devices.Inputs, names, false)
+	newVM.Spec.Template.Spec.Domain.Devices.Inputs = selectInputs(newVM.Spec.Template.Spec.Domain.Devices.Inputs, names, false)
+}
+
+// getInputTypeChangeNames returns the names of inputs present in both VMs whose type or bus
+// changed, and of inputs added as anything but a tablet
+func getInputTypeChangeNames(oldVM, newVM *kubevirtiov1.VirtualMachine) map[string]bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return nil
+	}
+
+	oldInputs := make(map[string]kubevirtiov1.Input)
+	for _, input := range oldVM.Spec.Template.Spec.Domain.Devices.Inputs {
+		oldInputs[input.Name] = input
+	}
+
+	names := make(map[string]bool)
+	for _, input := range newVM.Spec.Template.Spec.Domain.Devices.Inputs {
+		oldInput, found := oldInputs[input.Name]
+		switch {
+		case found && (oldInput.Type != input.Type || oldInput.Bus != input.Bus):
+			names[input.Name] = true
+		case !found && input.Type != kubevirtiov1.InputTypeTablet:
+			names[input.Name] = true
+		}
+	}
+	return names
+}
+
+// selectInputs returns the inputs whose name is (match=true) or is not (match=false) in the set
+func selectInputs(inputs []kubevirtiov1.Input, names map[string]bool, match bool) []kubevirtiov1.Input {
+	var selected []kubevirtiov1.Input
+	for _, input := range inputs {
+		if names[input.Name] == match {
+			selected = append(selected, input)
+		}
+	}
+	return selected
+}
+
+// ConsolePermissionChecker implements FieldPermissionChecker for remote console display settings.
+// It handles permissions for:
+// - vGPU display options (spec.template.spec.domain.devices.gpus[].virtualGPUOptions)
+// This lets VDI helpdesk staff tweak the display of existing GPUs without GPU allocation rights.
+// This is a SUBSET of gpu-admin and devices-admin: the GPUs themselves must be unchanged.
+type ConsolePermissionChecker struct{}
+
+var _ SubsetPermissionChecker = &ConsolePermissionChecker{}
+
+func (c *ConsolePermissionChecker) Name() string {
+	return "console"
+}
+
+func (c *ConsolePermissionChecker) Subresource() string {
+	return "virtualmachines/console-admin"
+}
+
+func (c *ConsolePermissionChecker) IsSubsetOf(name string) bool {
+	return name == "gpu" || name == "devices"
+}
+
+func (c *ConsolePermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	oldGPUs := oldVM.Spec.Template.Spec.Domain.Devices.GPUs
+	newGPUs := newVM.Spec.Template.Spec.Domain.Devices.GPUs
+	if equality.Semantic.DeepEqual(oldGPUs, newGPUs) {
+		return false
+	}
+
+	// Only a display change if the GPUs are identical once their display options are ignored
+	// (adding, removing or reassigning a GPU requires gpu-admin)
+	return equality.Semantic.DeepEqual(withoutDisplayOptions(oldGPUs), withoutDisplayOptions(newGPUs))
+}
+
+func (c *ConsolePermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Clear only the display options, leaving the GPUs for gpu-admin
+	oldVM.Spec.Template.Spec.Domain.Devices.GPUs = withoutDisplayOptions(oldVM.Spec.Template.Spec.Domain.Devices.GPUs)
+	newVM.Spec.Template.Spec.Domain.Devices.GPUs = withoutDisplayOptions(newVM.Spec.Template.Spec.Domain.Devices.GPUs)
+}
+
+// withoutDisplayOptions returns a copy of the GPUs with their vGPU options cleared
+func withoutDisplayOptions(gpus []kubevirtiov1.GPU) []kubevirtiov1.GPU {
+	if gpus == nil {
+		return nil
+	}
+
+	stripped := make([]kubevirtiov1.GPU, len(gpus))
+	for i, gpu := range gpus {
+		gpu.VirtualGPUOptions = nil
+		stripped[i] = gpu
+	}
+	return stripped
+}
+
+// GPUPermissionChecker implements FieldPermissionChecker for GPU allocation.
+// It handles permissions for:
+// - GPUs (spec.template.spec.domain.devices.gpus), e.g. adding one or changing its deviceName
+// Every GPU consumes node GPU quota and its deviceName selects the pool it draws from, so GPU
+// allocation can be delegated without host device passthrough.
+// This is a SUBSET of devices-admin. With RequireFullAdminForRemovals, removed GPUs are left for full-admin.
+type GPUPermissionChecker struct {
+	// RequireFullAdminForRemovals excludes removals of GPUs from gpu-admin, matching
+	// DevicesPermissionChecker.RequireFullAdminForRemovals
+	RequireFullAdminForRemovals bool
+}
+
+var _ SubsetPermissionChecker = &GPUPermissionChecker{}
+
+func (g *GPUPermissionChecker) Name() string {
+	return "gpu"
+}
+
+func (g *GPUPermissionChecker) Subresource() string {
+	return "virtualmachines/gpu-admin"
+}
+
+func (g *GPUPermissionChecker) IsSubsetOf(name string) bool {
+	return name == "devices"
+}
+
+func (g *GPUPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	removed := g.privilegedRemovals(oldVM, newVM)
+	return !equality.Semantic.DeepEqual(selectByName(oldVM.Spec.Template.Spec.Domain.Devices.GPUs, gpuName, removed, false),
+		selectByName(newVM.Spec.Template.Spec.Domain.Devices.GPUs, gpuName, removed, false))
+}
+
+func (g *GPUPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Keep removals if they require full-admin
+	removed := g.privilegedRemovals(oldVM, newVM)
+	oldVM.Spec.Template.Spec.Domain.Devices.GPUs = selectByName(oldVM.Spec.Template.Spec.Domain.Devices.GPUs, gpuName, removed, true)
+	newVM.Spec.Template.Spec.Domain.Devices.GPUs = nil
+}
+
+// privilegedRemovals returns the names of removed GPUs, which gpu-admin does not cover
+// (none unless RequireFullAdminForRemovals is set)
+func (g *GPUPermissionChecker) privilegedRemovals(oldVM, newVM *kubevirtiov1.VirtualMachine) map[string]bool {
+	if !g.RequireFullAdminForRemovals {
+		return nil
+	}
+	return removedNames(oldVM.Spec.Template.Spec.Domain.Devices.GPUs, newVM.Spec.Template.Spec.Domain.Devices.GPUs, gpuName)
+}
+
+// AutoattachPermissionChecker implements FieldPermissionChecker for the autoattach device toggles.
+// It handles permissions for:
+// - spec.template.spec.domain.devices.autoattachPodInterface (default pod network)
+// - spec.template.spec.domain.devices.autoattachGraphicsDevice (VNC console)
+// - spec.template.spec.domain.devices.autoattachSerialConsole (serial console)
+// - spec.template.spec.domain.devices.autoattachMemBalloon
+// - spec.template.spec.domain.devices.autoattachInputDevice
+// - spec.template.spec.domain.devices.autoattachVSOCK
+// These toggles implicitly add or remove devices, so they are granted by devices-admin.
+type AutoattachPermissionChecker struct{}
+
+var _ FieldPermissionChecker = &AutoattachPermissionChecker{}
+
+func (a *AutoattachPermissionChecker) Name() string {
+	return "autoattach"
+}
+
+func (a *AutoattachPermissionChecker) Subresource() string {
+	return "virtualmachines/devices-admin"
+}
+
+func (a *AutoattachPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	oldDevices := oldVM.Spec.Template.Spec.Domain.Devices
+	newDevices := newVM.Spec.Template.Spec.Domain.Devices
+
+	return !equality.Semantic.DeepEqual(oldDevices.AutoattachPodInterface, newDevices.AutoattachPodInterface) ||
+		!equality.Semantic.DeepEqual(oldDevices.AutoattachGraphicsDevice, newDevices.AutoattachGraphicsDevice) ||
+		!equality.Semantic.DeepEqual(oldDevices.AutoattachSerialConsole, newDevices.AutoattachSerialConsole) ||
+		!equality.Semantic.DeepEqual(oldDevices.AutoattachMemBalloon, newDevices.AutoattachMemBalloon) ||
+		!equality.Semantic.DeepEqual(oldDevices.AutoattachInputDevice, newDevices.AutoattachInputDevice) ||
+		!equality.Semantic.DeepEqual(oldDevices.AutoattachVSOCK, newDevices.AutoattachVSOCK)
+}
+
+func (a *AutoattachPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	for _, devices := range []*kubevirtiov1.Devices{
+		&oldVM.Spec.Template.Spec.Domain.Devices,
+		&newVM.Spec.Template.Spec.Domain.Devices,
+	} {
+		devices.AutoattachPodInterface = nil
+		devices.AutoattachGraphicsDevice = nil
+		devices.AutoattachSerialConsole = nil
+		devices.AutoattachMemBalloon = nil
+		devices.AutoattachInputDevice = nil
+		devices.AutoattachVSOCK = nil
+	}
+}
+
+// LifecyclePermissionChecker implements FieldPermissionChecker for VM lifecycle fields.
+// It handles permissions for:
+// - spec.running (bool: direct start/stop control)
+// - spec.runStrategy (string: advanced lifecycle strategy like Always, Halted, Manual, etc.)
+// - spec.template.spec.terminationGracePeriodSeconds (0 stops the guest ungracefully and can lose data)
+// Note: running and runStrategy are mutually exclusive in KubeVirt. Migrating a VM from one to
+// the other changes both fields and is a lifecycle change. Setting both is not rejected here:
+// it is a lifecycle change like any other, and KubeVirt's own validation rejects the VM.
+type LifecyclePermissionChecker struct{}
+
+var _ FieldPermissionChecker = &LifecyclePermissionChecker{}
+
+func (l *LifecyclePermissionChecker) Name() string {
+	return "lifecycle"
+}
+
+func (l *LifecyclePermissionChecker) Subresource() string {
+	return "virtualmachines/lifecycle-admin"
+}
+
+func (l *LifecyclePermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	// Check if running field has changed
+	runningChanged := !equality.Semantic.DeepEqual(oldVM.Spec.Running, newVM.Spec.Running)
+
+	// Check if runStrategy field has changed
+	runStrategyChanged := !equality.Semantic.DeepEqual(oldVM.Spec.RunStrategy, newVM.Spec.RunStrategy)
+
+	// Check if the termination grace period has changed
+	gracePeriodChanged := oldVM.Spec.Template != nil && newVM.Spec.Template != nil &&
+		!equality.Semantic.DeepEqual(oldVM.Spec.Template.Spec.TerminationGracePeriodSeconds, newVM.Spec.Template.Spec.TerminationGracePeriodSeconds)
+
+	return runningChanged || runStrategyChanged || gracePeriodChanged
+}
+
+func (l *LifecyclePermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	// Neutralize running field
+	oldVM.Spec.Running = nil
+	newVM.Spec.Running = nil
+
+	// Neutralize runStrategy field
+	oldVM.Spec.RunStrategy = nil
+	newVM.Spec.RunStrategy = nil
+
+	// Neutralize the termination grace period
+	if oldVM.Spec.Template != nil && newVM.Spec.Template != nil {
+		oldVM.Spec.Template.Spec.TerminationGracePeriodSeconds = nil
+		newVM.Spec.Template.Spec.TerminationGracePeriodSeconds = nil
+	}
+}
+
+// TemplateMetadataPermissionChecker implements FieldPermissionChecker for VM template metadata.
+// It handles permissions for:
+// - Template labels (spec.template.metadata.labels)
+// - Template annotations (spec.template.metadata.annotations)
+// These are applied to the VMI and its pod, so they can affect scheduling and selectors.
+// NOTE: Distinct from the VM's own metadata (labels/annotations on the VirtualMachine object)
+type TemplateMetadataPermissionChecker struct{}
+
+var _ FieldPermissionChecker = &TemplateMetadataPermissionChecker{}
+
+func (t *TemplateMetadataPermissionChecker) Name() string {
+	return "template-metadata"
+}
+
+func (t *TemplateMetadataPermissionChecker) Subresource() string {
+	return "virtualmachines/template-metadata-admin"
+}
+
+func (t *TemplateMetadataPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+
+	// Compare template labels
+	oldLabels := oldVM.Spec.Template.ObjectMeta.Labels
+	newLabels := newVM.Spec.Template.ObjectMeta.Labels
+	labelsChanged := !equality.Semantic.DeepEqual(oldLabels, newLabels)
+
+	// Compare template annotations
+	oldAnnotations := oldVM.Spec.Template.ObjectMeta.Annotations
+	newAnnotations := newVM.Spec.Template.ObjectMeta.Annotations
+	annotationsChanged := !equality.Semantic.DeepEqual(oldAnnotations, newAnnotations)
+
+	return labelsChanged || annotationsChanged
+}
+
+func (t *TemplateMetadataPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return
+	}
+
+	// Neutralize template labels
+	oldVM.Spec.Template.ObjectMeta.Labels = nil
+	newVM.Spec.Template.ObjectMeta.Labels = nil
+
+	// Neutralize template annotations
+	oldVM.Spec.Template.ObjectMeta.Annotations = nil
+	newVM.Spec.Template.ObjectMeta.Annotations = nil
+}
+
+// InstancetypeRevisionPermissionChecker implements FieldPermissionChecker for instancetype and
+// preference revision pins.
+// It handles permissions for:
+// - Instancetype revision (spec.instancetype.revisionName)
+// - Preference revision (spec.preference.revisionName)
+// The revision names the ControllerRevision the VM is configured from, so changing the pin
+// reconfigures the VM. Changing the named instancetype or preference (name, kind, inferFromVolume)
+// is not a revision change and is not covered by instancetype-admin.
+// With IncludeInstancetypeChanges, switching the named instancetype is covered as well.
+type InstancetypeRevisionPermissionChecker struct {
+	// IncludeInstancetypeChanges covers switching the instancetype (spec.instancetype.name/kind)
+	// of a VM that keeps one; adding or removing the instancetype still requires full-admin
+	IncludeInstancetypeChanges bool
+}
+
+var _ FieldPermissionChecker = &InstancetypeRevisionPermissionChecker{}
+
+func (i *InstancetypeRevisionPermissionChecker) Name() string {
+	return "instancetype"
+}
+
+func (i *InstancetypeRevisionPermissionChecker) Subresource() string {
+	return "virtualmachines/instancetype-admin"
+}
+
+func (i *InstancetypeRevisionPermissionChecker) HasChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	return i.instancetypeChanged(oldVM.Spec.Instancetype, newVM.Spec.Instancetype) ||
+		preferenceRevisionChanged(oldVM.Spec.Preference, newVM.Spec.Preference)
+}
+
+func (i *InstancetypeRevisionPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	// Clear only revision-only changes (and switches, if included), so that other changes are still denied
+	if i.instancetypeChanged(oldVM.Spec.Instancetype, newVM.Spec.Instancetype) {
+		for _, matcher := range []*kubevirtiov1.InstancetypeMatcher{oldVM.Spec.Instancetype, newVM.Spec.Instancetype} {
+			matcher.RevisionName = ""
+			if i.IncludeInstancetypeChanges {
+				matcher.Name, matcher.Kind = "", ""
+			}
+		}
+	}
+	if preferenceRevisionChanged(oldVM.Spec.Preference, newVM.Spec.Preference) {
+		oldVM.Spec.Preference.RevisionName = ""
+		newVM.Spec.Preference.RevisionName = ""
+	}
+}
+
+// instancetypeChanged reports whether the instancetype matchers differ only in the revision
+// name, or with IncludeInstancetypeChanges also in the instancetype name and kind
+func (i *InstancetypeRevisionPermissionChecker) instancetypeChanged(oldMatcher, newMatcher *kubevirtiov1.InstancetypeMatcher) bool {
+	if !i.IncludeInstancetypeChanges {
+		return instancetypeRevisionChanged(oldMatcher, newMatcher)
+	}
+	if oldMatcher == nil || newMatcher == nil || equality.Semantic.DeepEqual(oldMatcher, newMatcher) {
+		return false
+	}
+
+	oldStripped := *oldMatcher.DeepCopy()
+	newStripped := *newMatcher.DeepCopy()
+	oldStripped.RevisionName, oldStripped.Name, oldStripped.Kind = "", "", ""
+	newStripped.RevisionName, newStripped.Name, newStripped.Kind = "", "", ""
+	return equality.Semantic.DeepEqual(oldStripped, newStripped)
+}
+
+// instancetypeRevisionChanged reports whether only the revision name differs between the matchers
+func instancetypeRevisionChanged(oldMatcher, newMatcher *kubevirtiov1.InstancetypeMatcher) bool {
+	if oldMatcher == nil || newMatcher == nil || oldMatcher.RevisionName == newMatcher.RevisionName {
+		return false
+	}
+
+	oldStripped := *oldMatcher.DeepCopy()
+	newStripped := *newMatcher.DeepCopy()
+	oldStripped.RevisionName = ""
+	newStripped.RevisionName = ""
+	return equality.Semantic.DeepEqual(oldStripped, newStripped)
+}
+
+// preferenceRevisionChanged reports whether only the revision name differs between the matchers
+func preferenceRevisionChanged(oldMatcher, newMatcher *kubevirtiov1.PreferenceMatcher) bool {
+	if oldMatcher == nil || newMatcher == nil || oldMatcher.RevisionName == newMatcher.RevisionName {
+		return false
+	}
+
+	oldStripped := *oldMatcher.DeepCopy()
+	newStripped := *newMatcher.DeepCopy()
+	oldStripped.RevisionName = ""
+	newStripped.RevisionName = ""
+	return equality.Semantic.DeepEqual(oldStripped, newStripped)
 }
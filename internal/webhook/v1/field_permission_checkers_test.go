@@ -17,10 +17,15 @@ limitations under the License.
 package v1
 
 import (
+	"slices"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubevirtiov1 "kubevirt.io/api/core/v1"
 )
 
@@ -30,6 +35,51 @@ func strategyPtr(s string) *kubevirtiov1.VirtualMachineRunStrategy {
 	return &strategy
 }
 
+// Helper function for creating boot order pointers in tests
+func bootOrderPtr(order uint) *uint {
+	return &order
+}
+
+// serviceAccountVM returns a VM with a PVC volume and a volume mounting the named service account
+func serviceAccountVM(serviceAccountName string) *kubevirtiov1.VirtualMachine {
+	return &kubevirtiov1.VirtualMachine{
+		Spec: kubevirtiov1.VirtualMachineSpec{
+			Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+				Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+					Domain: kubevirtiov1.DomainSpec{
+						Devices: kubevirtiov1.Devices{
+							Disks: []kubevirtiov1.Disk{{Name: "rootdisk"}, {Name: "sa"}},
+						},
+					},
+					Volumes: []kubevirtiov1.Volume{
+						{
+							Name: "rootdisk",
+							VolumeSource: kubevirtiov1.VolumeSource{
+								PersistentVolumeClaim: &kubevirtiov1.PersistentVolumeClaimVolumeSource{},
+							},
+						},
+						{
+							Name: "sa",
+							VolumeSource: kubevirtiov1.VolumeSource{
+								ServiceAccount: &kubevirtiov1.ServiceAccountVolumeSource{ServiceAccountName: serviceAccountName},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Helper function for listing the volume names of a VM in tests
+func volumeNames(vm *kubevirtiov1.VirtualMachine) []string {
+	var names []string
+	for _, volume := range vm.Spec.Template.Spec.Volumes {
+		names = append(names, volume.Name)
+	}
+	return names
+}
+
 var _ = Describe("Field Permission Checkers", func() {
 	Describe("StoragePermissionChecker", func() {
 		var checker *StoragePermissionChecker
@@ -181,9 +231,39 @@ var _ = Describe("Field Permission Checkers", func() {
 
 				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
 			})
+
+			It("should not detect changing the mounted service account", func() {
+				oldVM := serviceAccountVM("default")
+				newVM := serviceAccountVM("cluster-admin")
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect a regular volume switching to a serviceAccount", func() {
+				oldVM := serviceAccountVM("default")
+				oldVM.Spec.Template.Spec.Volumes[1].VolumeSource = kubevirtiov1.VolumeSource{
+					PersistentVolumeClaim: &kubevirtiov1.PersistentVolumeClaimVolumeSource{},
+				}
+				newVM := serviceAccountVM("default")
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
 		})
 
 		Context("Neutralize", func() {
+			It("should keep serviceAccount volumes and their disks", func() {
+				oldVM := serviceAccountVM("default")
+				newVM := serviceAccountVM("cluster-admin")
+
+				checker.Neutralize(oldVM, newVM)
+
+				for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+					Expect(volumeNames(vm)).To(Equal([]string{"sa"}))
+					Expect(vm.Spec.Template.Spec.Domain.Devices.Disks).To(Equal([]kubevirtiov1.Disk{{Name: "sa"}}))
+				}
+				Expect(newVM.Spec.Template.Spec.Volumes[0].ServiceAccount.ServiceAccountName).To(Equal("cluster-admin"))
+			})
+
 			It("should set volumes, disks, and filesystems to nil in both VMs", func() {
 				oldVM := &kubevirtiov1.VirtualMachine{
 					Spec: kubevirtiov1.VirtualMachineSpec{
@@ -1086,239 +1166,706 @@ var _ = Describe("Field Permission Checkers", func() {
 		})
 	})
 
-	Describe("NetworkPermissionChecker", func() {
-		var checker *NetworkPermissionChecker
+	Describe("diskDeviceTypeChanges", func() {
+		vmWithDisks := func(disks ...kubevirtiov1.Disk) *kubevirtiov1.VirtualMachine {
+			return &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{Disks: disks},
+							},
+						},
+					},
+				},
+			}
+		}
+		dataDisk := kubevirtiov1.Disk{Name: "data", DiskDevice: kubevirtiov1.DiskDevice{Disk: &kubevirtiov1.DiskTarget{Bus: "virtio"}}}
+		lunDisk := kubevirtiov1.Disk{Name: "data", DiskDevice: kubevirtiov1.DiskDevice{LUN: &kubevirtiov1.LunTarget{Bus: "scsi"}}}
+		cdromDisk := kubevirtiov1.Disk{Name: "data", DiskDevice: kubevirtiov1.DiskDevice{CDRom: &kubevirtiov1.CDRomTarget{Bus: "sata"}}}
+
+		It("should report disk to cdrom and disk to lun transitions", func() {
+			Expect(diskDeviceTypeChanges(vmWithDisks(dataDisk), vmWithDisks(cdromDisk))).To(Equal([]string{"data"}))
+			Expect(diskDeviceTypeChanges(vmWithDisks(dataDisk), vmWithDisks(lunDisk))).To(Equal([]string{"data"}))
+			Expect(diskDeviceTypeChanges(vmWithDisks(cdromDisk), vmWithDisks(dataDisk))).To(Equal([]string{"data"}))
+		})
 
-		BeforeEach(func() {
-			checker = &NetworkPermissionChecker{}
+		It("should not report changes within a device type", func() {
+			sataDisk := dataDisk
+			sataDisk.Disk = &kubevirtiov1.DiskTarget{Bus: "sata"}
+
+			Expect(diskDeviceTypeChanges(vmWithDisks(dataDisk), vmWithDisks(sataDisk))).To(BeEmpty())
 		})
 
-		It("should have correct name and subresource", func() {
-			Expect(checker.Name()).To(Equal("network"))
-			Expect(checker.Subresource()).To(Equal("virtualmachines/network-admin"))
+		It("should not report added or removed disks", func() {
+			other := cdromDisk
+			other.Name = "other"
+
+			Expect(diskDeviceTypeChanges(vmWithDisks(dataDisk), vmWithDisks(dataDisk, other))).To(BeEmpty())
+			Expect(diskDeviceTypeChanges(vmWithDisks(dataDisk, other), vmWithDisks(dataDisk))).To(BeEmpty())
 		})
 
-		Context("HasChanged", func() {
-			It("should detect when interfaces are added", func() {
-				oldVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
-							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
-								Domain: kubevirtiov1.DomainSpec{
-									Devices: kubevirtiov1.Devices{
-										Interfaces: []kubevirtiov1.Interface{
-											{Name: "default"},
-										},
-									},
-								},
-							},
-						},
-					},
-				}
+		It("should not claim a disk to cdrom conversion as a CD-ROM media change", func() {
+			checker := &CdromUserPermissionChecker{}
+			oldVM := vmWithDisks(dataDisk)
+			oldVM.Spec.Template.Spec.Volumes = []kubevirtiov1.Volume{{
+				Name:         "data",
+				VolumeSource: kubevirtiov1.VolumeSource{DataVolume: &kubevirtiov1.DataVolumeSource{Name: "data", Hotpluggable: true}},
+			}}
+			newVM := vmWithDisks(cdromDisk)
+			newVM.Spec.Template.Spec.Volumes = []kubevirtiov1.Volume{{
+				Name:         "data",
+				VolumeSource: kubevirtiov1.VolumeSource{DataVolume: &kubevirtiov1.DataVolumeSource{Name: "ubuntu-iso", Hotpluggable: true}},
+			}}
+
+			Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+		})
+	})
 
-				newVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
-							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
-								Domain: kubevirtiov1.DomainSpec{
-									Devices: kubevirtiov1.Devices{
-										Interfaces: []kubevirtiov1.Interface{
-											{Name: "default"},
-											{Name: "secondary"},
-										},
+	Describe("BootPermissionChecker", func() {
+		var (
+			checker *BootPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &BootPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Firmware: &kubevirtiov1.Firmware{
+									Serial:     "fw-serial",
+									Bootloader: &kubevirtiov1.Bootloader{BIOS: &kubevirtiov1.BIOS{}},
+								},
+								Devices: kubevirtiov1.Devices{
+									Disks: []kubevirtiov1.Disk{
+										{Name: "rootdisk", BootOrder: bootOrderPtr(1)},
+										{Name: "cdrom", DiskDevice: kubevirtiov1.DiskDevice{CDRom: &kubevirtiov1.CDRomTarget{}}},
 									},
 								},
 							},
 						},
 					},
-				}
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("boot"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/boot-admin"))
+			Expect(checker.IsSubsetOf("storage")).To(BeTrue())
+		})
+
+		Context("HasChanged", func() {
+			It("should detect boot order changes of existing disks", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].BootOrder = bootOrderPtr(2)
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[1].BootOrder = bootOrderPtr(1)
 
 				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
 			})
 
-			It("should detect when networks are added", func() {
-				oldVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
-							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
-								Networks: []kubevirtiov1.Network{
-									{Name: "default"},
-								},
-							},
-						},
-					},
+			It("should detect switching from BIOS to EFI without Secure Boot", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Firmware.Bootloader = &kubevirtiov1.Bootloader{
+					EFI: &kubevirtiov1.EFI{SecureBoot: boolPtr(false)},
 				}
 
-				newVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
-							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
-								Networks: []kubevirtiov1.Network{
-									{Name: "default"},
-									{Name: "secondary"},
-								},
-							},
-						},
-					},
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect switching Secure Boot on", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Firmware.Bootloader = &kubevirtiov1.Bootloader{EFI: &kubevirtiov1.EFI{}}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect boot order changes combined with other disk changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].BootOrder = bootOrderPtr(2)
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Serial = "SN-0002"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect boot order of added disks", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks = append(newVM.Spec.Template.Spec.Domain.Devices.Disks,
+					kubevirtiov1.Disk{Name: "disk2", BootOrder: bootOrderPtr(2)})
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect other firmware changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Firmware.Serial = "fw-serial-2"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should clear the boot fields but keep the rest", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].BootOrder = bootOrderPtr(2)
+				newVM.Spec.Template.Spec.Domain.Firmware.Bootloader = &kubevirtiov1.Bootloader{
+					EFI: &kubevirtiov1.EFI{SecureBoot: boolPtr(false)},
 				}
 
-				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(equality.Semantic.DeepEqual(oldVM, newVM)).To(BeTrue())
+				Expect(newVM.Spec.Template.Spec.Domain.Firmware).To(Equal(&kubevirtiov1.Firmware{Serial: "fw-serial"}))
 			})
 
-			It("should detect when both interfaces and networks are modified", func() {
-				oldVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
-							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
-								Domain: kubevirtiov1.DomainSpec{
-									Devices: kubevirtiov1.Devices{
-										Interfaces: []kubevirtiov1.Interface{
-											{Name: "default"},
-										},
-									},
-								},
-								Networks: []kubevirtiov1.Network{
-									{Name: "default"},
-								},
-							},
-						},
-					},
+			It("should keep the boot order of a disk change it does not cover", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].BootOrder = bootOrderPtr(2)
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Serial = "SN-0002"
+				newVM.Spec.Template.Spec.Domain.Firmware.Bootloader = &kubevirtiov1.Bootloader{
+					EFI: &kubevirtiov1.EFI{SecureBoot: boolPtr(false)},
 				}
 
-				newVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
-							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
-								Domain: kubevirtiov1.DomainSpec{
-									Devices: kubevirtiov1.Devices{
-										Interfaces: []kubevirtiov1.Interface{
-											{Name: "default"},
-											{Name: "secondary"},
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Disks[0].BootOrder).To(Equal(bootOrderPtr(2)))
+				Expect(newVM.Spec.Template.Spec.Domain.Firmware.Bootloader).To(BeNil())
+			})
+		})
+	})
+
+	Describe("DiskTuningPermissionChecker", func() {
+		var (
+			checker *DiskTuningPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &DiskTuningPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{
+									Disks: []kubevirtiov1.Disk{
+										{
+											Name:  "rootdisk",
+											Cache: kubevirtiov1.CacheNone,
+											DiskDevice: kubevirtiov1.DiskDevice{
+												Disk: &kubevirtiov1.DiskTarget{Bus: "virtio"},
+											},
 										},
 									},
 								},
-								Networks: []kubevirtiov1.Network{
-									{Name: "default"},
-									{Name: "secondary"},
-								},
+							},
+							Volumes: []kubevirtiov1.Volume{
+								{Name: "rootdisk"},
 							},
 						},
 					},
-				}
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("disk-tuning"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/disk-tuning-admin"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect a cache mode change alone", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Cache = kubevirtiov1.CacheWriteBack
 
 				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
 			})
 
-			It("should not detect changes when network state is identical", func() {
-				oldVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
-							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
-								Domain: kubevirtiov1.DomainSpec{
-									Devices: kubevirtiov1.Devices{
-										Interfaces: []kubevirtiov1.Interface{
-											{Name: "default"},
-										},
-									},
-								},
-								Networks: []kubevirtiov1.Network{
-									{Name: "default"},
-								},
-							},
-						},
-					},
+			It("should detect an IO mode change along with a dedicated IO thread change", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].IO = kubevirtiov1.IONative
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].DedicatedIOThread = boolPtr(true)
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect a block size change alone", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].BlockSize = &kubevirtiov1.BlockSize{
+					Custom: &kubevirtiov1.CustomBlockSize{Logical: 4096, Physical: 4096},
 				}
 
-				newVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
-							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
-								Domain: kubevirtiov1.DomainSpec{
-									Devices: kubevirtiov1.Devices{
-										Interfaces: []kubevirtiov1.Interface{
-											{Name: "default"},
-										},
-									},
-								},
-								Networks: []kubevirtiov1.Network{
-									{Name: "default"},
-								},
-							},
-						},
-					},
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect changes when disks are identical", func() {
+				newVM := oldVM.DeepCopy()
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not claim changes when disk identity also changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Cache = kubevirtiov1.CacheWriteBack
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].DiskDevice = kubevirtiov1.DiskDevice{
+					Disk: &kubevirtiov1.DiskTarget{Bus: "sata"},
 				}
 
 				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
 			})
+
+			It("should leave a pciAddress change to storage-admin", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Cache = kubevirtiov1.CacheWriteBack
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Disk.PciAddress = "0000:81:01.0"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+				Expect((&StoragePermissionChecker{}).HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not claim changes when a disk is added", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks = append(newVM.Spec.Template.Spec.Domain.Devices.Disks,
+					kubevirtiov1.Disk{Name: "datadisk"})
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should handle nil templates", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template = nil
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
 		})
 
 		Context("Neutralize", func() {
-			It("should neutralize both interfaces and networks", func() {
-				oldVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
-							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
-								Domain: kubevirtiov1.DomainSpec{
-									Devices: kubevirtiov1.Devices{
-										Interfaces: []kubevirtiov1.Interface{
-											{Name: "default"},
-										},
+			It("should clear tuning fields but keep disk identity", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Cache = kubevirtiov1.CacheWriteBack
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].IO = kubevirtiov1.IONative
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(equality.Semantic.DeepEqual(oldVM.Spec.Template.Spec.Domain.Devices.Disks,
+					newVM.Spec.Template.Spec.Domain.Devices.Disks)).To(BeTrue())
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Disks).To(HaveLen(1))
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Name).To(Equal("rootdisk"))
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Cache).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("DedicatedIOThreadPermissionChecker", func() {
+		var (
+			checker *DedicatedIOThreadPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &DedicatedIOThreadPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{
+									Disks: []kubevirtiov1.Disk{
+										{Name: "rootdisk", Cache: kubevirtiov1.CacheNone},
 									},
 								},
-								Networks: []kubevirtiov1.Network{
-									{Name: "default"},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("dedicated-io-thread"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/performance-admin"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect a dedicated IO thread toggle", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].DedicatedIOThread = boolPtr(true)
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+				Expect((&DiskTuningPermissionChecker{}).HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should detect a toggle along with a cache change, which it leaves to disk-tuning", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].DedicatedIOThread = boolPtr(true)
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Cache = kubevirtiov1.CacheWriteBack
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+				Expect((&DiskTuningPermissionChecker{}).HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect a cache change alone", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Cache = kubevirtiov1.CacheWriteBack
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not claim changes when a disk is added", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks = append(newVM.Spec.Template.Spec.Domain.Devices.Disks,
+					kubevirtiov1.Disk{Name: "datadisk", DedicatedIOThread: boolPtr(true)})
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should clear only the dedicated IO threads", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].DedicatedIOThread = boolPtr(true)
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Cache = kubevirtiov1.CacheWriteBack
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Disks).To(Equal([]kubevirtiov1.Disk{
+					{Name: "rootdisk", Cache: kubevirtiov1.CacheWriteBack},
+				}))
+				Expect(oldVM.Spec.Template.Spec.Domain.Devices.Disks[0].DedicatedIOThread).To(BeNil())
+			})
+		})
+	})
+
+	Describe("DiskIdentityPermissionChecker", func() {
+		var (
+			checker *DiskIdentityPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &DiskIdentityPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{
+									Disks: []kubevirtiov1.Disk{
+										{
+											Name:   "rootdisk",
+											Serial: "SN-0001",
+											DiskDevice: kubevirtiov1.DiskDevice{
+												Disk: &kubevirtiov1.DiskTarget{Bus: "virtio"},
+											},
+										},
+									},
 								},
 							},
+							Volumes: []kubevirtiov1.Volume{
+								{Name: "rootdisk"},
+							},
 						},
 					},
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("disk-identity"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/disk-identity-admin"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect a serial change alone", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Serial = "SN-0002"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect a serial being removed", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Serial = ""
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect changes when disks are identical", func() {
+				newVM := oldVM.DeepCopy()
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not claim changes when other disk fields also change", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Serial = "SN-0002"
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].DiskDevice = kubevirtiov1.DiskDevice{
+					Disk: &kubevirtiov1.DiskTarget{Bus: "sata"},
 				}
 
-				newVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
-							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
-								Domain: kubevirtiov1.DomainSpec{
-									Devices: kubevirtiov1.Devices{
-										Interfaces: []kubevirtiov1.Interface{
-											{Name: "default"},
-											{Name: "secondary"},
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not claim changes when a disk is added", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks = append(newVM.Spec.Template.Spec.Domain.Devices.Disks,
+					kubevirtiov1.Disk{Name: "datadisk", Serial: "SN-0003"})
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should handle nil templates", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template = nil
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should clear serials but keep the rest of the disks", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Serial = "SN-0002"
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(equality.Semantic.DeepEqual(oldVM.Spec.Template.Spec.Domain.Devices.Disks,
+					newVM.Spec.Template.Spec.Domain.Devices.Disks)).To(BeTrue())
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Name).To(Equal("rootdisk"))
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Serial).To(BeEmpty())
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Disk).ToNot(BeNil())
+			})
+		})
+	})
+
+	Describe("DiskTagPermissionChecker", func() {
+		var (
+			checker *DiskTagPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &DiskTagPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{
+									Disks: []kubevirtiov1.Disk{
+										{
+											Name: "datadisk",
+											Tag:  "data",
+											DiskDevice: kubevirtiov1.DiskDevice{
+												Disk: &kubevirtiov1.DiskTarget{Bus: "virtio"},
+											},
 										},
 									},
 								},
-								Networks: []kubevirtiov1.Network{
-									{Name: "default"},
-									{Name: "secondary"},
+							},
+							Volumes: []kubevirtiov1.Volume{
+								{Name: "datadisk"},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("disk-tag"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/config-admin"))
+			Expect(checker.IsSubsetOf("storage")).To(BeTrue())
+		})
+
+		Context("HasChanged", func() {
+			It("should detect a tag change alone", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Tag = "scratch"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect a tag being removed", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Tag = ""
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect changes when disks are identical", func() {
+				newVM := oldVM.DeepCopy()
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not claim changes when other disk fields also change", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Tag = "scratch"
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Serial = "SN-0001"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not claim changes when a tagged disk is added", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks = append(newVM.Spec.Template.Spec.Domain.Devices.Disks,
+					kubevirtiov1.Disk{Name: "scratch", Tag: "scratch"})
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should handle nil templates", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template = nil
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should clear tags but keep the rest of the disks", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Tag = "scratch"
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(equality.Semantic.DeepEqual(oldVM.Spec.Template.Spec.Domain.Devices.Disks,
+					newVM.Spec.Template.Spec.Domain.Devices.Disks)).To(BeTrue())
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Tag).To(BeEmpty())
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Name).To(Equal("datadisk"))
+			})
+		})
+	})
+
+	Describe("SharedDiskPermissionChecker", func() {
+		var (
+			checker *SharedDiskPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &SharedDiskPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{
+									Disks: []kubevirtiov1.Disk{
+										{
+											Name: "datadisk",
+											DiskDevice: kubevirtiov1.DiskDevice{
+												Disk: &kubevirtiov1.DiskTarget{Bus: "virtio"},
+											},
+										},
+									},
 								},
 							},
+							Volumes: []kubevirtiov1.Volume{
+								{Name: "datadisk"},
+							},
 						},
 					},
-				}
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("shared-disk"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/shared-disk-admin"))
+			Expect(checker.IsSubsetOf("storage")).To(BeTrue())
+		})
+
+		Context("HasChanged", func() {
+			It("should detect shareable being toggled", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Shareable = boolPtr(true)
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect an error policy change", func() {
+				newVM := oldVM.DeepCopy()
+				policy := kubevirtiov1.DiskErrorPolicyReport
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].ErrorPolicy = &policy
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect changes when disks are identical", func() {
+				newVM := oldVM.DeepCopy()
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not claim changes when other disk fields also change", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Shareable = boolPtr(true)
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Serial = "SN-0001"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not claim changes when a shareable disk is added", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks = append(newVM.Spec.Template.Spec.Domain.Devices.Disks,
+					kubevirtiov1.Disk{Name: "shared", Shareable: boolPtr(true)})
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should handle nil templates", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template = nil
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should clear shareable and error policy but keep the rest of the disks", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Shareable = boolPtr(true)
 
 				checker.Neutralize(oldVM, newVM)
 
-				// Interfaces and networks should be nil
-				Expect(oldVM.Spec.Template.Spec.Domain.Devices.Interfaces).To(BeNil())
-				Expect(newVM.Spec.Template.Spec.Domain.Devices.Interfaces).To(BeNil())
-				Expect(oldVM.Spec.Template.Spec.Networks).To(BeNil())
-				Expect(newVM.Spec.Template.Spec.Networks).To(BeNil())
+				Expect(equality.Semantic.DeepEqual(oldVM.Spec.Template.Spec.Domain.Devices.Disks,
+					newVM.Spec.Template.Spec.Domain.Devices.Disks)).To(BeTrue())
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Shareable).To(BeNil())
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Disk).ToNot(BeNil())
 			})
+		})
+	})
 
-			It("should make network-only changes invisible to DeepEqual", func() {
+	Describe("NetworkPermissionChecker", func() {
+		var checker *NetworkPermissionChecker
+
+		BeforeEach(func() {
+			checker = &NetworkPermissionChecker{}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("network"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/network-admin"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect when interfaces are added", func() {
 				oldVM := &kubevirtiov1.VirtualMachine{
 					Spec: kubevirtiov1.VirtualMachineSpec{
 						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
 							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
 								Domain: kubevirtiov1.DomainSpec{
-									CPU: &kubevirtiov1.CPU{Cores: 2},
 									Devices: kubevirtiov1.Devices{
 										Interfaces: []kubevirtiov1.Interface{
 											{Name: "default"},
 										},
 									},
 								},
-								Networks: []kubevirtiov1.Network{
-									{Name: "default"},
-								},
 							},
 						},
 					},
@@ -1329,16 +1876,224 @@ var _ = Describe("Field Permission Checkers", func() {
 						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
 							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
 								Domain: kubevirtiov1.DomainSpec{
-									CPU: &kubevirtiov1.CPU{Cores: 2}, // Same
 									Devices: kubevirtiov1.Devices{
 										Interfaces: []kubevirtiov1.Interface{
 											{Name: "default"},
-											{Name: "secondary"}, // Different
+											{Name: "secondary"},
 										},
 									},
 								},
-								Networks: []kubevirtiov1.Network{
-									{Name: "default"},
+							},
+						},
+					},
+				}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect when networks are added", func() {
+				oldVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Networks: []kubevirtiov1.Network{
+									{Name: "default"},
+								},
+							},
+						},
+					},
+				}
+
+				newVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Networks: []kubevirtiov1.Network{
+									{Name: "default"},
+									{Name: "secondary"},
+								},
+							},
+						},
+					},
+				}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect when both interfaces and networks are modified", func() {
+				oldVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{
+									Devices: kubevirtiov1.Devices{
+										Interfaces: []kubevirtiov1.Interface{
+											{Name: "default"},
+										},
+									},
+								},
+								Networks: []kubevirtiov1.Network{
+									{Name: "default"},
+								},
+							},
+						},
+					},
+				}
+
+				newVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{
+									Devices: kubevirtiov1.Devices{
+										Interfaces: []kubevirtiov1.Interface{
+											{Name: "default"},
+											{Name: "secondary"},
+										},
+									},
+								},
+								Networks: []kubevirtiov1.Network{
+									{Name: "default"},
+									{Name: "secondary"},
+								},
+							},
+						},
+					},
+				}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect changes when network state is identical", func() {
+				oldVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{
+									Devices: kubevirtiov1.Devices{
+										Interfaces: []kubevirtiov1.Interface{
+											{Name: "default"},
+										},
+									},
+								},
+								Networks: []kubevirtiov1.Network{
+									{Name: "default"},
+								},
+							},
+						},
+					},
+				}
+
+				newVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{
+									Devices: kubevirtiov1.Devices{
+										Interfaces: []kubevirtiov1.Interface{
+											{Name: "default"},
+										},
+									},
+								},
+								Networks: []kubevirtiov1.Network{
+									{Name: "default"},
+								},
+							},
+						},
+					},
+				}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should neutralize both interfaces and networks", func() {
+				oldVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{
+									Devices: kubevirtiov1.Devices{
+										Interfaces: []kubevirtiov1.Interface{
+											{Name: "default"},
+										},
+									},
+								},
+								Networks: []kubevirtiov1.Network{
+									{Name: "default"},
+								},
+							},
+						},
+					},
+				}
+
+				newVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{
+									Devices: kubevirtiov1.Devices{
+										Interfaces: []kubevirtiov1.Interface{
+											{Name: "default"},
+											{Name: "secondary"},
+										},
+									},
+								},
+								Networks: []kubevirtiov1.Network{
+									{Name: "default"},
+									{Name: "secondary"},
+								},
+							},
+						},
+					},
+				}
+
+				checker.Neutralize(oldVM, newVM)
+
+				// Interfaces and networks should be nil
+				Expect(oldVM.Spec.Template.Spec.Domain.Devices.Interfaces).To(BeNil())
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Interfaces).To(BeNil())
+				Expect(oldVM.Spec.Template.Spec.Networks).To(BeNil())
+				Expect(newVM.Spec.Template.Spec.Networks).To(BeNil())
+			})
+
+			It("should make network-only changes invisible to DeepEqual", func() {
+				oldVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{
+									CPU: &kubevirtiov1.CPU{Cores: 2},
+									Devices: kubevirtiov1.Devices{
+										Interfaces: []kubevirtiov1.Interface{
+											{Name: "default"},
+										},
+									},
+								},
+								Networks: []kubevirtiov1.Network{
+									{Name: "default"},
+								},
+							},
+						},
+					},
+				}
+
+				newVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{
+									CPU: &kubevirtiov1.CPU{Cores: 2}, // Same
+									Devices: kubevirtiov1.Devices{
+										Interfaces: []kubevirtiov1.Interface{
+											{Name: "default"},
+											{Name: "secondary"}, // Different
+										},
+									},
+								},
+								Networks: []kubevirtiov1.Network{
+									{Name: "default"},
 									{Name: "secondary"}, // Different
 								},
 							},
@@ -1395,404 +2150,3077 @@ var _ = Describe("Field Permission Checkers", func() {
 					},
 				}
 
-				checker.Neutralize(oldVM, newVM)
+				checker.Neutralize(oldVM, newVM)
+
+				// After neutralization, non-network changes (CPU) should still be visible
+				Expect(equality.Semantic.DeepEqual(oldVM.Spec, newVM.Spec)).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("MultusPermissionChecker", func() {
+		var (
+			checker *MultusPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		multus := func(name string) kubevirtiov1.Network {
+			return kubevirtiov1.Network{
+				Name:          name,
+				NetworkSource: kubevirtiov1.NetworkSource{Multus: &kubevirtiov1.MultusNetwork{NetworkName: name + "-nad"}},
+			}
+		}
+
+		BeforeEach(func() {
+			checker = &MultusPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{
+									Interfaces: []kubevirtiov1.Interface{{Name: "default"}, {Name: "vlan100"}},
+								},
+							},
+							Networks: []kubevirtiov1.Network{*kubevirtiov1.DefaultPodNetwork(), multus("vlan100")},
+						},
+					},
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("multus"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/multus-admin"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect an added Multus network", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks, multus("vlan200"))
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect a changed NetworkAttachmentDefinition", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Networks[1].Multus.NetworkName = "other-nad"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect pod network changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Model = "e1000"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not claim a network switching between pod and Multus", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Networks[0] = multus("default")
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not claim MAC address edits of existing interfaces", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[1].MacAddress = "02:00:00:00:00:02"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not claim SR-IOV Multus networks", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces, kubevirtiov1.Interface{
+					Name:                   "vf",
+					InterfaceBindingMethod: kubevirtiov1.InterfaceBindingMethod{SRIOV: &kubevirtiov1.InterfaceSRIOV{}},
+				})
+				newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks, multus("vf"))
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should handle nil templates", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template = nil
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should remove Multus networks and their interfaces but keep pod networking", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks, multus("vlan200"))
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(newVM.Spec.Template.Spec.Networks).To(Equal([]kubevirtiov1.Network{*kubevirtiov1.DefaultPodNetwork()}))
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Interfaces).To(Equal([]kubevirtiov1.Interface{{Name: "default"}}))
+				Expect(oldVM.Spec.Template.Spec.Networks).To(Equal(newVM.Spec.Template.Spec.Networks))
+			})
+		})
+	})
+
+	Describe("LinkStatePermissionChecker", func() {
+		var (
+			checker *LinkStatePermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &LinkStatePermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{
+									Interfaces: []kubevirtiov1.Interface{{Name: "default"}, {Name: "eth1"}},
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("link-state"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/network-operator"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect link state changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[1].State = kubevirtiov1.InterfaceStateLinkDown
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect other interface changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[1].Model = "e1000"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect a link state change combined with a removed interface", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].State = kubevirtiov1.InterfaceStateLinkDown
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = newVM.Spec.Template.Spec.Domain.Devices.Interfaces[:1]
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should handle nil templates", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template = nil
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should clear only the link state", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[1].State = kubevirtiov1.InterfaceStateLinkDown
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[1].Model = "e1000"
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Interfaces).To(Equal([]kubevirtiov1.Interface{
+					{Name: "default"}, {Name: "eth1", Model: "e1000"},
+				}))
+			})
+		})
+	})
+
+	Describe("NetworkTuningPermissionChecker", func() {
+		var (
+			checker *NetworkTuningPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &NetworkTuningPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{
+									Interfaces: []kubevirtiov1.Interface{
+										{Name: "default", Model: "virtio"},
+										{Name: "vf", Model: "virtio", InterfaceBindingMethod: kubevirtiov1.InterfaceBindingMethod{SRIOV: &kubevirtiov1.InterfaceSRIOV{}}},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("network-tuning"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/network-tuning-admin"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect a model change of an otherwise unchanged interface", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Model = "e1000"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect a model change combined with another edit of the interface", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Model = "e1000"
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Ports = []kubevirtiov1.Port{{Port: 80}}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect an interface added with a model", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces,
+					kubevirtiov1.Interface{Name: "eth1", Model: "e1000"})
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect a model change of an SR-IOV interface", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[1].Model = "e1000"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should handle nil templates", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template = nil
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should clear only the model of the edited interfaces", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Model = "e1000"
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces,
+					kubevirtiov1.Interface{Name: "eth1", Model: "e1000"})
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(oldVM.Spec.Template.Spec.Domain.Devices.Interfaces[0]).To(Equal(kubevirtiov1.Interface{Name: "default"}))
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0]).To(Equal(kubevirtiov1.Interface{Name: "default"}))
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Interfaces[2]).To(Equal(kubevirtiov1.Interface{Name: "eth1", Model: "e1000"}))
+			})
+		})
+	})
+
+	Describe("NetworkPortsPermissionChecker", func() {
+		var (
+			checker         *NetworkPortsPermissionChecker
+			securityChecker *NetworkSecurityPermissionChecker
+			oldVM           *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &NetworkPortsPermissionChecker{}
+			securityChecker = &NetworkSecurityPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{
+									Interfaces: []kubevirtiov1.Interface{
+										{Name: "default", MacAddress: "02:00:00:00:00:01", Ports: []kubevirtiov1.Port{{Port: 22}}},
+										{Name: "eth1"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("network-ports"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/network-ports-admin"))
+			Expect(checker.IsSubsetOf("network-security")).To(BeTrue())
+		})
+
+		Context("HasChanged", func() {
+			It("should detect adding, changing and removing ports of existing interfaces", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[1].Ports = []kubevirtiov1.Port{{Port: 80}}
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+
+				newVM = oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Ports[0].Protocol = "UDP"
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+
+				newVM = oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Ports = nil
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect port changes of otherwise edited interfaces", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Ports = nil
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress = "02:00:00:00:00:02"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect added interfaces with ports", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces,
+					kubevirtiov1.Interface{Name: "eth2", Ports: []kubevirtiov1.Port{{Port: 80}}})
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect SR-IOV interfaces", func() {
+				oldVM.Spec.Template.Spec.Domain.Devices.Interfaces[1].SRIOV = &kubevirtiov1.InterfaceSRIOV{}
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[1].Ports = []kubevirtiov1.Port{{Port: 80}}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should handle nil templates", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template = nil
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should clear only the ports of port-only edits", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[1].Ports = []kubevirtiov1.Port{{Port: 80}}
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress = "02:00:00:00:00:02"
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Interfaces[1].Ports).To(BeNil())
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Ports).To(HaveLen(1))
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+				Expect(securityChecker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+		})
+
+		It("should leave port-only edits to network-security-admin as superset", func() {
+			newVM := oldVM.DeepCopy()
+			newVM.Spec.Template.Spec.Domain.Devices.Interfaces[1].Ports = []kubevirtiov1.Port{{Port: 80}}
+
+			Expect(securityChecker.HasChanged(oldVM, newVM)).To(BeTrue())
+		})
+	})
+
+	Describe("NetworkSecurityPermissionChecker", func() {
+		var (
+			checker        *NetworkSecurityPermissionChecker
+			networkChecker *NetworkPermissionChecker
+			oldVM          *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &NetworkSecurityPermissionChecker{}
+			networkChecker = &NetworkPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{
+									Interfaces: []kubevirtiov1.Interface{
+										{Name: "default", MacAddress: "02:00:00:00:00:01", ACPIIndex: 1},
+									},
+								},
+							},
+							Networks: []kubevirtiov1.Network{*kubevirtiov1.DefaultPodNetwork()},
+						},
+					},
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("network-security"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/network-security-admin"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect macAddress, ports and acpiIndex changes on existing interfaces", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress = "02:00:00:00:00:02"
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+
+				newVM = oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Ports = []kubevirtiov1.Port{{Port: 80}}
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+
+				newVM = oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].ACPIIndex = 2
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect a pciAddress change on an existing interface", func() {
+				oldVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].PciAddress = "0000:81:01.0"
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].PciAddress = "0000:81:02.0"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+				Expect(networkChecker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should leave an added interface with an explicit pciAddress to network-admin", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces,
+					kubevirtiov1.Interface{Name: "eth1", PciAddress: "0000:81:01.0", ACPIIndex: 2})
+				newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks,
+					kubevirtiov1.Network{Name: "eth1", NetworkSource: kubevirtiov1.NetworkSource{Multus: &kubevirtiov1.MultusNetwork{NetworkName: "br1"}}})
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+				Expect(networkChecker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect added or removed interfaces", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces,
+					kubevirtiov1.Interface{Name: "eth1", MacAddress: "02:00:00:00:00:03"})
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = nil
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect other interface edits", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Model = "e1000"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect SR-IOV interfaces", func() {
+				oldVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].SRIOV = &kubevirtiov1.InterfaceSRIOV{}
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress = "02:00:00:00:00:02"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should handle nil templates", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template = nil
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should clear only the security fields of edited interfaces", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress = "02:00:00:00:00:02"
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Model = "e1000"
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Interfaces).To(Equal([]kubevirtiov1.Interface{{Name: "default", Model: "e1000"}}))
+				Expect(oldVM.Spec.Template.Spec.Domain.Devices.Interfaces).To(Equal([]kubevirtiov1.Interface{{Name: "default"}}))
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		It("should keep security edits out of network-admin", func() {
+			newVM := oldVM.DeepCopy()
+			newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress = "02:00:00:00:00:02"
+
+			Expect(networkChecker.HasChanged(oldVM, newVM)).To(BeFalse())
+			networkChecker.Neutralize(oldVM, newVM)
+			Expect(newVM.Spec.Template.Spec.Domain.Devices.Interfaces).ToNot(Equal(oldVM.Spec.Template.Spec.Domain.Devices.Interfaces))
+		})
+	})
+
+	Describe("BridgePermissionChecker", func() {
+		var (
+			checker        *BridgePermissionChecker
+			networkChecker *NetworkPermissionChecker
+			oldVM          *kubevirtiov1.VirtualMachine
+		)
+
+		masquerade := kubevirtiov1.InterfaceBindingMethod{Masquerade: &kubevirtiov1.InterfaceMasquerade{}}
+		bridge := kubevirtiov1.InterfaceBindingMethod{Bridge: &kubevirtiov1.InterfaceBridge{}}
+
+		BeforeEach(func() {
+			checker = &BridgePermissionChecker{}
+			networkChecker = &NetworkPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{
+									Interfaces: []kubevirtiov1.Interface{
+										{Name: "default", InterfaceBindingMethod: masquerade},
+									},
+								},
+							},
+							Networks: []kubevirtiov1.Network{*kubevirtiov1.DefaultPodNetwork()},
+						},
+					},
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("bridge"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/bridge-admin"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect converting an interface to or from bridge binding", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].InterfaceBindingMethod = bridge
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+				Expect(checker.HasChanged(newVM, oldVM)).To(BeTrue())
+				Expect(networkChecker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should detect adding a bridged interface", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces,
+					kubevirtiov1.Interface{Name: "vlan100", InterfaceBindingMethod: bridge})
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect removing a bridged interface", func() {
+				oldVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].InterfaceBindingMethod = bridge
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = nil
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+				Expect(networkChecker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect other edits of a bridged interface", func() {
+				oldVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].InterfaceBindingMethod = bridge
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Model = "e1000"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect an interface converted to SR-IOV", func() {
+				oldVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].InterfaceBindingMethod = bridge
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].InterfaceBindingMethod = kubevirtiov1.InterfaceBindingMethod{
+					SRIOV: &kubevirtiov1.InterfaceSRIOV{},
+				}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should clear only the binding of the converted interfaces", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].InterfaceBindingMethod = bridge
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Model = "e1000"
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(oldVM.Spec.Template.Spec.Domain.Devices.Interfaces).To(Equal([]kubevirtiov1.Interface{{Name: "default"}}))
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Interfaces).To(Equal([]kubevirtiov1.Interface{{Name: "default", Model: "e1000"}}))
+				Expect(networkChecker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("SriovPermissionChecker", func() {
+		var (
+			checker        *SriovPermissionChecker
+			networkChecker *NetworkPermissionChecker
+			oldVM          *kubevirtiov1.VirtualMachine
+		)
+
+		sriovInterface := func(name string) kubevirtiov1.Interface {
+			return kubevirtiov1.Interface{
+				Name: name,
+				InterfaceBindingMethod: kubevirtiov1.InterfaceBindingMethod{
+					SRIOV: &kubevirtiov1.InterfaceSRIOV{},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			checker = &SriovPermissionChecker{}
+			networkChecker = &NetworkPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{
+									Interfaces: []kubevirtiov1.Interface{
+										{
+											Name: "default",
+											InterfaceBindingMethod: kubevirtiov1.InterfaceBindingMethod{
+												Masquerade: &kubevirtiov1.InterfaceMasquerade{},
+											},
+										},
+									},
+								},
+							},
+							Networks: []kubevirtiov1.Network{
+								{Name: "default", NetworkSource: kubevirtiov1.NetworkSource{Pod: &kubevirtiov1.PodNetwork{}}},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("sriov"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/sriov-admin"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect when an SR-IOV interface is added", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces, sriovInterface("vf"))
+				newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks, kubevirtiov1.Network{Name: "vf"})
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+				Expect(networkChecker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should detect when an interface switches to SR-IOV", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0] = sriovInterface("default")
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+				Expect(networkChecker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect masquerade interface changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces, kubevirtiov1.Interface{Name: "secondary"})
+				newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks, kubevirtiov1.Network{Name: "secondary"})
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+				Expect(networkChecker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should return false when the template is nil", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template = nil
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should remove only SR-IOV interfaces and their networks", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces, sriovInterface("vf"))
+				newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks, kubevirtiov1.Network{Name: "vf"})
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Interfaces).To(Equal(oldVM.Spec.Template.Spec.Domain.Devices.Interfaces))
+				Expect(newVM.Spec.Template.Spec.Networks).To(Equal(oldVM.Spec.Template.Spec.Networks))
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Interfaces).To(HaveLen(1))
+			})
+
+			It("should be left with SR-IOV interfaces after network neutralization", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces, sriovInterface("vf"))
+
+				networkChecker.Neutralize(oldVM, newVM)
+
+				Expect(oldVM.Spec.Template.Spec.Domain.Devices.Interfaces).To(BeNil())
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Interfaces).To(Equal([]kubevirtiov1.Interface{sriovInterface("vf")}))
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("ComputePermissionChecker", func() {
+		var checker *ComputePermissionChecker
+
+		BeforeEach(func() {
+			checker = &ComputePermissionChecker{}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("compute"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/compute-admin"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect CPU changes", func() {
+				oldVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{
+									CPU: &kubevirtiov1.CPU{Cores: 2},
+								},
+							},
+						},
+					},
+				}
+
+				newVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{
+									CPU: &kubevirtiov1.CPU{Cores: 4},
+								},
+							},
+						},
+					},
+				}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect resource changes", func() {
+				oldVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{
+									CPU: &kubevirtiov1.CPU{Cores: 2},
+								},
+							},
+						},
+					},
+				}
+
+				newVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{
+									CPU: &kubevirtiov1.CPU{Cores: 2},
+									Resources: kubevirtiov1.ResourceRequirements{
+										OvercommitGuestOverhead: true,
+									},
+								},
+							},
+						},
+					},
+				}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect hugepages changes", func() {
+				oldVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{
+									CPU: &kubevirtiov1.CPU{Cores: 2},
+								},
+							},
+						},
+					},
+				}
+
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Memory = &kubevirtiov1.Memory{
+					Hugepages: &kubevirtiov1.Hugepages{PageSize: "1Gi"},
+				}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect changes when compute is identical", func() {
+				oldVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{
+									CPU: &kubevirtiov1.CPU{Cores: 2},
+								},
+							},
+						},
+					},
+				}
+
+				newVM := oldVM.DeepCopy()
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should neutralize CPU and resources", func() {
+				oldVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{
+									CPU: &kubevirtiov1.CPU{Cores: 2},
+									Resources: kubevirtiov1.ResourceRequirements{
+										OvercommitGuestOverhead: true,
+									},
+								},
+							},
+						},
+					},
+				}
+
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(oldVM.Spec.Template.Spec.Domain.CPU).To(BeNil())
+				Expect(newVM.Spec.Template.Spec.Domain.CPU).To(BeNil())
+				Expect(oldVM.Spec.Template.Spec.Domain.Resources).To(Equal(kubevirtiov1.ResourceRequirements{}))
+				Expect(newVM.Spec.Template.Spec.Domain.Resources).To(Equal(kubevirtiov1.ResourceRequirements{}))
+			})
+		})
+	})
+
+	Describe("NewDecisionContext", func() {
+		It("should report a VM without a VMI as not running", func() {
+			Expect(NewDecisionContext(&kubevirtiov1.VirtualMachine{}).Running).To(BeFalse())
+		})
+
+		It("should report a VM with a VMI as running", func() {
+			vm := &kubevirtiov1.VirtualMachine{Status: kubevirtiov1.VirtualMachineStatus{Created: true}}
+			Expect(NewDecisionContext(vm).Running).To(BeTrue())
+		})
+	})
+
+	Describe("ComputePermissionChecker SubresourceFor", func() {
+		It("should require compute-admin regardless of running state by default", func() {
+			checker := &ComputePermissionChecker{}
+			Expect(checker.SubresourceFor(DecisionContext{Running: false})).To(Equal("virtualmachines/compute-admin"))
+			Expect(checker.SubresourceFor(DecisionContext{Running: true})).To(Equal("virtualmachines/compute-admin"))
+		})
+
+		It("should require compute-live-admin for running VMs when enabled", func() {
+			checker := &ComputePermissionChecker{RequireLiveAdminWhenRunning: true}
+			Expect(checker.SubresourceFor(DecisionContext{Running: false})).To(Equal("virtualmachines/compute-admin"))
+			Expect(checker.SubresourceFor(DecisionContext{Running: true})).To(Equal("virtualmachines/compute-live-admin"))
+		})
+
+		It("should fall back to Subresource for checkers that are not context aware", func() {
+			Expect(requiredSubresource(&StoragePermissionChecker{}, DecisionContext{Running: true})).
+				To(Equal("virtualmachines/storage-admin"))
+		})
+	})
+
+	Describe("MemoryResizePermissionChecker", func() {
+		var (
+			checker *MemoryResizePermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &MemoryResizePermissionChecker{}
+			guest := resource.MustParse("2Gi")
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Memory: &kubevirtiov1.Memory{Guest: &guest},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("memory-resize"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/memory-resize-user"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect guest memory changes", func() {
+				newVM := oldVM.DeepCopy()
+				guest := resource.MustParse("4Gi")
+				newVM.Spec.Template.Spec.Domain.Memory.Guest = &guest
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect guest memory being set on a VM without memory settings", func() {
+				oldVM.Spec.Template.Spec.Domain.Memory = nil
+				newVM := oldVM.DeepCopy()
+				guest := resource.MustParse("4Gi")
+				newVM.Spec.Template.Spec.Domain.Memory = &kubevirtiov1.Memory{Guest: &guest}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect hugepages changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Memory.Hugepages = &kubevirtiov1.Hugepages{PageSize: "1Gi"}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect a guest change combined with a maxGuest change", func() {
+				newVM := oldVM.DeepCopy()
+				guest := resource.MustParse("4Gi")
+				maxGuest := resource.MustParse("8Gi")
+				newVM.Spec.Template.Spec.Domain.Memory.Guest = &guest
+				newVM.Spec.Template.Spec.Domain.Memory.MaxGuest = &maxGuest
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should return false when the template is nil", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template = nil
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should clear guest memory and keep hugepages", func() {
+				oldVM.Spec.Template.Spec.Domain.Memory.Hugepages = &kubevirtiov1.Hugepages{PageSize: "1Gi"}
+				newVM := oldVM.DeepCopy()
+				guest := resource.MustParse("4Gi")
+				newVM.Spec.Template.Spec.Domain.Memory.Guest = &guest
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(newVM.Spec.Template.Spec.Domain.Memory).To(Equal(&kubevirtiov1.Memory{
+					Hugepages: &kubevirtiov1.Hugepages{PageSize: "1Gi"},
+				}))
+				Expect(equality.Semantic.DeepEqual(oldVM.Spec, newVM.Spec)).To(BeTrue())
+			})
+
+			It("should drop memory settings that only held the guest size", func() {
+				newVM := oldVM.DeepCopy()
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(oldVM.Spec.Template.Spec.Domain.Memory).To(BeNil())
+				Expect(newVM.Spec.Template.Spec.Domain.Memory).To(BeNil())
+			})
+		})
+	})
+
+	Describe("MemoryLimitPermissionChecker", func() {
+		var (
+			checker *MemoryLimitPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &MemoryLimitPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Resources: kubevirtiov1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceMemory: resource.MustParse("2Gi"),
+										corev1.ResourceCPU:    resource.MustParse("1"),
+									},
+									Limits: corev1.ResourceList{
+										corev1.ResourceMemory: resource.MustParse("2Gi"),
+										corev1.ResourceCPU:    resource.MustParse("2"),
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("memory-limit"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/memory-limit-user"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect a limit-only change", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Resources.Limits[corev1.ResourceMemory] = resource.MustParse("4Gi")
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect a memory limit being added", func() {
+				delete(oldVM.Spec.Template.Spec.Domain.Resources.Limits, corev1.ResourceMemory)
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Resources.Limits[corev1.ResourceMemory] = resource.MustParse("4Gi")
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect a memory limit being removed", func() {
+				newVM := oldVM.DeepCopy()
+				delete(newVM.Spec.Template.Spec.Domain.Resources.Limits, corev1.ResourceMemory)
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect a memory request change", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Resources.Requests[corev1.ResourceMemory] = resource.MustParse("4Gi")
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect a limit change combined with a request change", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Resources.Requests[corev1.ResourceMemory] = resource.MustParse("4Gi")
+				newVM.Spec.Template.Spec.Domain.Resources.Limits[corev1.ResourceMemory] = resource.MustParse("4Gi")
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect a CPU limit change", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Resources.Limits[corev1.ResourceCPU] = resource.MustParse("4")
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should return false when the template is nil", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template = nil
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should clear the memory limit and keep requests and other limits", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Resources.Limits[corev1.ResourceMemory] = resource.MustParse("4Gi")
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(newVM.Spec.Template.Spec.Domain.Resources.Limits).To(HaveLen(1))
+				Expect(newVM.Spec.Template.Spec.Domain.Resources.Limits).To(HaveKey(corev1.ResourceCPU))
+				Expect(newVM.Spec.Template.Spec.Domain.Resources.Requests).To(HaveLen(2))
+				Expect(equality.Semantic.DeepEqual(oldVM.Spec, newVM.Spec)).To(BeTrue())
+			})
+
+			It("should not modify the limits of the original object", func() {
+				limits := oldVM.Spec.Template.Spec.Domain.Resources.Limits
+				newVM := oldVM.DeepCopy()
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(limits).To(HaveKey(corev1.ResourceMemory))
+			})
+		})
+	})
+
+	Describe("CPUPinningPermissionChecker", func() {
+		var (
+			checker *CPUPinningPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &CPUPinningPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								CPU: &kubevirtiov1.CPU{Cores: 2, DedicatedCPUPlacement: true},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("cpu-pinning"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/cpu-pinning-admin"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect isolateEmulatorThread toggling", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.CPU.IsolateEmulatorThread = true
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+				Expect(checker.HasChanged(newVM, oldVM)).To(BeTrue())
+			})
+
+			It("should detect dedicated CPU placement being set on a VM without CPU settings", func() {
+				oldVM.Spec.Template.Spec.Domain.CPU = nil
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.CPU = &kubevirtiov1.CPU{DedicatedCPUPlacement: true}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect NUMA topology changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.CPU.NUMA = &kubevirtiov1.NUMA{GuestMappingPassthrough: &kubevirtiov1.NUMAGuestMappingPassthrough{}}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect IOThreads policy and count changes", func() {
+				newVM := oldVM.DeepCopy()
+				policy := kubevirtiov1.IOThreadsPolicySupplementalPool
+				newVM.Spec.Template.Spec.Domain.IOThreadsPolicy = &policy
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+
+				countVM := newVM.DeepCopy()
+				threads := uint32(4)
+				countVM.Spec.Template.Spec.Domain.IOThreads = &kubevirtiov1.DiskIOThreads{SupplementalPoolThreadCount: &threads}
+				Expect(checker.HasChanged(newVM, countVM)).To(BeTrue())
+			})
+
+			It("should not detect other CPU changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+				newVM.Spec.Template.Spec.Domain.CPU.Features = []kubevirtiov1.CPUFeature{{Name: "pcid"}}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should clear the placement but keep the rest of the CPU settings", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.CPU.IsolateEmulatorThread = true
+				policy := kubevirtiov1.IOThreadsPolicyAuto
+				newVM.Spec.Template.Spec.Domain.IOThreadsPolicy = &policy
+
+				checker.Neutralize(oldVM, newVM)
+
+				for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+					Expect(vm.Spec.Template.Spec.Domain.CPU).To(Equal(&kubevirtiov1.CPU{Cores: 2}))
+					Expect(vm.Spec.Template.Spec.Domain.IOThreadsPolicy).To(BeNil())
+				}
+			})
+
+			It("should clear CPU settings that only held placement", func() {
+				oldVM.Spec.Template.Spec.Domain.CPU = nil
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.CPU = &kubevirtiov1.CPU{IsolateEmulatorThread: true}
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(newVM.Spec.Template.Spec.Domain.CPU).To(BeNil())
+			})
+		})
+	})
+
+	Describe("CPUTopologyPermissionChecker", func() {
+		var (
+			checker *CPUTopologyPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &CPUTopologyPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								CPU: &kubevirtiov1.CPU{Sockets: 1, Cores: 2, Threads: 1},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("cpu-topology"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/cpu-topology-admin"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect socket and thread changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.CPU.Sockets = 2
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+
+				newVM = oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.CPU.Threads = 2
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect sockets being set on a VM without CPU settings", func() {
+				oldVM.Spec.Template.Spec.Domain.CPU = nil
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.CPU = &kubevirtiov1.CPU{Sockets: 2}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect core or other CPU changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+				newVM.Spec.Template.Spec.Domain.CPU.Model = "host-passthrough"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should clear the sockets and threads but keep the rest of the CPU settings", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.CPU.Sockets = 2
+
+				checker.Neutralize(oldVM, newVM)
+
+				for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+					Expect(vm.Spec.Template.Spec.Domain.CPU).To(Equal(&kubevirtiov1.CPU{Cores: 2}))
+				}
+			})
+
+			It("should clear CPU settings that only held the topology", func() {
+				oldVM.Spec.Template.Spec.Domain.CPU = nil
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.CPU = &kubevirtiov1.CPU{Sockets: 2, Threads: 2}
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(newVM.Spec.Template.Spec.Domain.CPU).To(BeNil())
+			})
+		})
+
+		It("should leave the sockets and threads to compute-admin's Neutralize for the denial", func() {
+			compute := &ComputePermissionChecker{}
+			newVM := oldVM.DeepCopy()
+			newVM.Spec.Template.Spec.Domain.CPU.Sockets = 2
+			newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+			Expect(compute.HasChanged(oldVM, newVM)).To(BeTrue())
+
+			compute.Neutralize(oldVM, newVM)
+
+			Expect(oldVM.Spec.Template.Spec.Domain.CPU).To(Equal(&kubevirtiov1.CPU{Sockets: 1, Threads: 1}))
+			Expect(newVM.Spec.Template.Spec.Domain.CPU).To(Equal(&kubevirtiov1.CPU{Sockets: 2, Threads: 1}))
+			Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+		})
+	})
+
+	Describe("HugepagesPermissionChecker", func() {
+		var (
+			checker *HugepagesPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &HugepagesPermissionChecker{}
+			guest := resource.MustParse("4Gi")
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Memory: &kubevirtiov1.Memory{Guest: &guest, Hugepages: &kubevirtiov1.Hugepages{PageSize: "2Mi"}},
+								Resources: kubevirtiov1.ResourceRequirements{
+									Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")},
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("hugepages"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/hugepages-admin"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect memory request increases", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Resources.Requests[corev1.ResourceMemory] = resource.MustParse("6Gi")
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+				Expect(checker.HasChanged(newVM, oldVM)).To(BeFalse())
+			})
+
+			It("should detect guest memory increases", func() {
+				newVM := oldVM.DeepCopy()
+				guest := resource.MustParse("6Gi")
+				newVM.Spec.Template.Spec.Domain.Memory.Guest = &guest
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+				Expect(checker.HasChanged(newVM, oldVM)).To(BeFalse())
+			})
+
+			It("should detect increases together with enabling hugepages", func() {
+				oldVM.Spec.Template.Spec.Domain.Memory.Hugepages = nil
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Memory.Hugepages = &kubevirtiov1.Hugepages{PageSize: "1Gi"}
+				newVM.Spec.Template.Spec.Domain.Resources.Requests[corev1.ResourceMemory] = resource.MustParse("6Gi")
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect increases of VMs without hugepages", func() {
+				oldVM.Spec.Template.Spec.Domain.Memory.Hugepages = nil
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Resources.Requests[corev1.ResourceMemory] = resource.MustParse("6Gi")
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect equal quantities in another format", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Resources.Requests[corev1.ResourceMemory] = resource.MustParse("4096Mi")
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should revert only the increases", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Resources.Requests[corev1.ResourceMemory] = resource.MustParse("6Gi")
+				newVM.Spec.Template.Spec.Domain.Resources.Limits = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("8Gi")}
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(newVM.Spec.Template.Spec.Domain.Resources.Requests).To(Equal(oldVM.Spec.Template.Spec.Domain.Resources.Requests))
+				Expect(newVM.Spec.Template.Spec.Domain.Resources.Limits).To(HaveKey(corev1.ResourceMemory))
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should remove a memory request the old VM did not have", func() {
+				oldVM.Spec.Template.Spec.Domain.Resources.Requests = nil
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Resources.Requests = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("6Gi")}
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(newVM.Spec.Template.Spec.Domain.Resources.Requests).To(BeNil())
+			})
+		})
+
+		It("should be left to hugepages-admin by compute-admin", func() {
+			compute := &ComputePermissionChecker{}
+			newVM := oldVM.DeepCopy()
+			newVM.Spec.Template.Spec.Domain.Resources.Requests[corev1.ResourceMemory] = resource.MustParse("6Gi")
+			Expect(compute.HasChanged(oldVM, newVM)).To(BeFalse())
+
+			newVM.Spec.Template.Spec.Domain.Memory.Hugepages.PageSize = "1Gi"
+			Expect(compute.HasChanged(oldVM, newVM)).To(BeTrue())
+			compute.Neutralize(oldVM, newVM)
+			Expect(compute.HasChanged(oldVM, newVM)).To(BeFalse())
+			Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+		})
+	})
+
+	Describe("CPUFeaturesPermissionChecker", func() {
+		var (
+			checker *CPUFeaturesPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &CPUFeaturesPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								CPU: &kubevirtiov1.CPU{Cores: 2},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("cpu-features"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/cpu-features-admin"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect added and changed features", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.CPU.Features = []kubevirtiov1.CPUFeature{{Name: "pcid", Policy: "require"}}
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+
+				changedVM := newVM.DeepCopy()
+				changedVM.Spec.Template.Spec.Domain.CPU.Features[0].Policy = "disable"
+				Expect(checker.HasChanged(newVM, changedVM)).To(BeTrue())
+			})
+
+			It("should detect features being set on a VM without CPU settings", func() {
+				oldVM.Spec.Template.Spec.Domain.CPU = nil
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.CPU = &kubevirtiov1.CPU{Features: []kubevirtiov1.CPUFeature{{Name: "pcid"}}}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect core count changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect a feature change combined with a core count change", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+				newVM.Spec.Template.Spec.Domain.CPU.Features = []kubevirtiov1.CPUFeature{{Name: "pcid"}}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should return false when the template is nil", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template = nil
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should clear features and keep the rest of the CPU settings", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.CPU.Features = []kubevirtiov1.CPUFeature{{Name: "pcid"}}
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(newVM.Spec.Template.Spec.Domain.CPU).To(Equal(&kubevirtiov1.CPU{Cores: 2}))
+				Expect(equality.Semantic.DeepEqual(oldVM.Spec, newVM.Spec)).To(BeTrue())
+			})
+
+			It("should drop CPU settings that only held features", func() {
+				oldVM.Spec.Template.Spec.Domain.CPU = &kubevirtiov1.CPU{Features: []kubevirtiov1.CPUFeature{{Name: "pcid"}}}
+				newVM := oldVM.DeepCopy()
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(oldVM.Spec.Template.Spec.Domain.CPU).To(BeNil())
+				Expect(newVM.Spec.Template.Spec.Domain.CPU).To(BeNil())
+			})
+		})
+	})
+
+	Describe("removedNames", func() {
+		It("should return the names missing from the new items", func() {
+			oldGPUs := []kubevirtiov1.GPU{{Name: "gpu1"}, {Name: "gpu2"}, {Name: "gpu3"}}
+			newGPUs := []kubevirtiov1.GPU{{Name: "gpu2", DeviceName: "changed"}, {Name: "gpu4"}}
+
+			Expect(removedNames(oldGPUs, newGPUs, gpuName)).To(Equal(map[string]bool{"gpu1": true, "gpu3": true}))
+			Expect(removedNames(newGPUs, newGPUs, gpuName)).To(BeEmpty())
+			Expect(removedNames(nil, newGPUs, gpuName)).To(BeEmpty())
+		})
+	})
+
+	Describe("InputPermissionChecker", func() {
+		var (
+			checker *InputPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &InputPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{
+									Inputs: []kubevirtiov1.Input{
+										{Name: "tablet", Type: kubevirtiov1.InputTypeTablet, Bus: kubevirtiov1.InputBusUSB},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("input"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/input-admin"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect bus and type changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Inputs[0].Bus = kubevirtiov1.InputBusVirtio
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+
+				newVM = oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Inputs[0].Type = kubevirtiov1.InputTypeKeyboard
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect an added non-tablet input", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Inputs = append(newVM.Spec.Template.Spec.Domain.Devices.Inputs,
+					kubevirtiov1.Input{Name: "keyboard", Type: kubevirtiov1.InputTypeKeyboard})
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect added or removed tablets", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Inputs = append(newVM.Spec.Template.Spec.Domain.Devices.Inputs,
+					kubevirtiov1.Input{Name: "tablet2", Type: kubevirtiov1.InputTypeTablet, Bus: kubevirtiov1.InputBusVirtio})
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+
+				newVM.Spec.Template.Spec.Domain.Devices.Inputs = nil
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should remove only the converted inputs", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Inputs[0].Bus = kubevirtiov1.InputBusVirtio
+				newVM.Spec.Template.Spec.Domain.Devices.Inputs = append(newVM.Spec.Template.Spec.Domain.Devices.Inputs,
+					kubevirtiov1.Input{Name: "tablet2", Type: kubevirtiov1.InputTypeTablet})
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(oldVM.Spec.Template.Spec.Domain.Devices.Inputs).To(BeEmpty())
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Inputs).To(Equal([]kubevirtiov1.Input{
+					{Name: "tablet2", Type: kubevirtiov1.InputTypeTablet},
+				}))
+			})
+		})
+	})
+
+	Describe("ConsolePermissionChecker", func() {
+		var (
+			checker *ConsolePermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &ConsolePermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{
+									GPUs: []kubevirtiov1.GPU{
+										{Name: "vgpu1", DeviceName: "nvidia.com/GRID_T4-1Q"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("console"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/console-admin"))
+			Expect(checker.IsSubsetOf("gpu")).To(BeTrue())
+			Expect(checker.IsSubsetOf("devices")).To(BeTrue())
+		})
+
+		Context("HasChanged", func() {
+			It("should detect a display option change alone", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs[0].VirtualGPUOptions = &kubevirtiov1.VGPUOptions{
+					Display: &kubevirtiov1.VGPUDisplayOptions{Enabled: boolPtr(false)},
+				}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect changes when GPUs are identical", func() {
+				newVM := oldVM.DeepCopy()
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not claim changes when the GPU device also changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs[0].VirtualGPUOptions = &kubevirtiov1.VGPUOptions{
+					Display: &kubevirtiov1.VGPUDisplayOptions{Enabled: boolPtr(false)},
+				}
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs[0].DeviceName = "nvidia.com/GRID_T4-2Q"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not claim changes when a GPU with display options is added", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = append(newVM.Spec.Template.Spec.Domain.Devices.GPUs, kubevirtiov1.GPU{
+					Name:              "vgpu2",
+					VirtualGPUOptions: &kubevirtiov1.VGPUOptions{Display: &kubevirtiov1.VGPUDisplayOptions{}},
+				})
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should handle nil templates", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template = nil
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should clear display options but keep the GPUs", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs[0].VirtualGPUOptions = &kubevirtiov1.VGPUOptions{
+					Display: &kubevirtiov1.VGPUDisplayOptions{RamFB: &kubevirtiov1.FeatureState{}},
+				}
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(equality.Semantic.DeepEqual(oldVM.Spec.Template.Spec.Domain.Devices.GPUs,
+					newVM.Spec.Template.Spec.Domain.Devices.GPUs)).To(BeTrue())
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.GPUs).To(HaveLen(1))
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.GPUs[0].VirtualGPUOptions).To(BeNil())
+			})
+		})
+	})
+
+	Describe("GPUPermissionChecker", func() {
+		var (
+			checker *GPUPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &GPUPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{
+									GPUs: []kubevirtiov1.GPU{
+										{Name: "gpu1", DeviceName: "nvidia.com/A100"},
+									},
+									HostDevices: []kubevirtiov1.HostDevice{
+										{Name: "nic1", DeviceName: "intel.com/qat"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("gpu"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/gpu-admin"))
+			Expect(checker.IsSubsetOf("devices")).To(BeTrue())
+			Expect(checker.IsSubsetOf("compute")).To(BeFalse())
+		})
+
+		Context("HasChanged", func() {
+			It("should detect a deviceName change", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs[0].DeviceName = "nvidia.com/H100"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect an added GPU", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = append(newVM.Spec.Template.Spec.Domain.Devices.GPUs,
+					kubevirtiov1.GPU{Name: "gpu2", DeviceName: "nvidia.com/A100"})
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect a removed GPU", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = nil
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect a removed GPU when removals require full-admin", func() {
+				checker.RequireFullAdminForRemovals = true
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = nil
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect host device changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.HostDevices[0].DeviceName = "intel.com/sriov"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should detect reordered GPUs, whose order decides their PCI slots", func() {
+				oldVM.Spec.Template.Spec.Domain.Devices.GPUs = append(oldVM.Spec.Template.Spec.Domain.Devices.GPUs,
+					kubevirtiov1.GPU{Name: "gpu2", DeviceName: "nvidia.com/H100"})
+				newVM := oldVM.DeepCopy()
+				gpus := newVM.Spec.Template.Spec.Domain.Devices.GPUs
+				gpus[0], gpus[1] = gpus[1], gpus[0]
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should handle nil templates", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template = nil
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should clear the GPUs but keep host devices", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs[0].DeviceName = "nvidia.com/H100"
+				newVM.Spec.Template.Spec.Domain.Devices.HostDevices[0].DeviceName = "intel.com/sriov"
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(oldVM.Spec.Template.Spec.Domain.Devices.GPUs).To(BeEmpty())
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.GPUs).To(BeEmpty())
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.HostDevices[0].DeviceName).To(Equal("intel.com/sriov"))
+			})
+
+			It("should keep removed GPUs when removals require full-admin", func() {
+				checker.RequireFullAdminForRemovals = true
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = []kubevirtiov1.GPU{{Name: "gpu2", DeviceName: "nvidia.com/A100"}}
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(oldVM.Spec.Template.Spec.Domain.Devices.GPUs).To(Equal([]kubevirtiov1.GPU{{Name: "gpu1", DeviceName: "nvidia.com/A100"}}))
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.GPUs).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("DevicesPermissionChecker", func() {
+		var checker *DevicesPermissionChecker
+
+		BeforeEach(func() {
+			checker = &DevicesPermissionChecker{}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("devices"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/devices-admin"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect GPU changes", func() {
+				oldVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{
+									Devices: kubevirtiov1.Devices{
+										GPUs: []kubevirtiov1.GPU{},
+									},
+								},
+							},
+						},
+					},
+				}
+
+				newVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{
+									Devices: kubevirtiov1.Devices{
+										GPUs: []kubevirtiov1.GPU{
+											{Name: "gpu1"},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect host device changes", func() {
+				oldVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{
+									Devices: kubevirtiov1.Devices{
+										HostDevices: []kubevirtiov1.HostDevice{},
+									},
+								},
+							},
+						},
+					},
+				}
+
+				newVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{
+									Devices: kubevirtiov1.Devices{
+										HostDevices: []kubevirtiov1.HostDevice{
+											{Name: "dev1"},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect RNG device addition and removal", func() {
+				oldVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{},
+							},
+						},
+					},
+				}
+
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Rng = &kubevirtiov1.Rng{}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+				Expect(checker.HasChanged(newVM, oldVM)).To(BeTrue())
+			})
+
+			It("should not detect changes when devices are identical", func() {
+				oldVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{
+									Devices: kubevirtiov1.Devices{
+										GPUs: []kubevirtiov1.GPU{
+											{Name: "gpu1"},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+
+				newVM := oldVM.DeepCopy()
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should neutralize all device fields", func() {
+				oldVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{
+									Devices: kubevirtiov1.Devices{
+										GPUs: []kubevirtiov1.GPU{
+											{Name: "gpu1"},
+										},
+										HostDevices: []kubevirtiov1.HostDevice{
+											{Name: "dev1"},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = append(newVM.Spec.Template.Spec.Domain.Devices.GPUs, kubevirtiov1.GPU{Name: "gpu2"})
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(oldVM.Spec.Template.Spec.Domain.Devices.GPUs).To(BeNil())
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.GPUs).To(BeNil())
+				Expect(oldVM.Spec.Template.Spec.Domain.Devices.HostDevices).To(BeNil())
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.HostDevices).To(BeNil())
+			})
+
+			It("should neutralize the RNG device", func() {
+				oldVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								Domain: kubevirtiov1.DomainSpec{
+									Devices: kubevirtiov1.Devices{
+										Rng: &kubevirtiov1.Rng{},
+									},
+								},
+							},
+						},
+					},
+				}
+
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Rng = nil
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(oldVM.Spec.Template.Spec.Domain.Devices.Rng).To(BeNil())
+				Expect(equality.Semantic.DeepEqual(oldVM.Spec, newVM.Spec)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("LifecyclePermissionChecker", func() {
+		var checker *LifecyclePermissionChecker
+
+		BeforeEach(func() {
+			checker = &LifecyclePermissionChecker{}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("lifecycle"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/lifecycle-admin"))
+		})
+
+		Context("HasChanged", func() {
+			DescribeTable("should correctly detect lifecycle field changes",
+				func(oldRunning *bool, oldStrategy *kubevirtiov1.VirtualMachineRunStrategy, newRunning *bool, newStrategy *kubevirtiov1.VirtualMachineRunStrategy, expectedChanged bool) {
+					oldVM := &kubevirtiov1.VirtualMachine{
+						Spec: kubevirtiov1.VirtualMachineSpec{
+							Running:     oldRunning,
+							RunStrategy: oldStrategy,
+						},
+					}
+
+					newVM := &kubevirtiov1.VirtualMachine{
+						Spec: kubevirtiov1.VirtualMachineSpec{
+							Running:     newRunning,
+							RunStrategy: newStrategy,
+						},
+					}
+
+					Expect(checker.HasChanged(oldVM, newVM)).To(Equal(expectedChanged))
+				},
+				Entry("when spec.running changes from false to true", boolPtr(false), nil, boolPtr(true), nil, true),
+				Entry("when spec.running changes from true to false", boolPtr(true), nil, boolPtr(false), nil, true),
+				Entry("when spec.running changes from nil to true", nil, nil, boolPtr(true), nil, true),
+				Entry("when spec.running changes from true to nil", boolPtr(true), nil, nil, nil, true),
+				Entry("when spec.runStrategy changes from Always to Halted", nil, strategyPtr("Always"), nil, strategyPtr("Halted"), true),
+				Entry("when spec.runStrategy changes from Always to Manual", nil, strategyPtr("Always"), nil, strategyPtr("Manual"), true),
+				Entry("when spec.runStrategy changes from RerunOnFailure to Once", nil, strategyPtr("RerunOnFailure"), nil, strategyPtr("Once"), true),
+				Entry("when spec.running is identical (true)", boolPtr(true), nil, boolPtr(true), nil, false),
+				Entry("when spec.running is identical (nil)", nil, nil, nil, nil, false),
+				Entry("when spec.runStrategy is identical", nil, strategyPtr("Always"), nil, strategyPtr("Always"), false),
+				Entry("when both running and runStrategy are identical", boolPtr(true), strategyPtr("Always"), boolPtr(true), strategyPtr("Always"), false),
+				Entry("when migrating from spec.running to spec.runStrategy", boolPtr(true), nil, nil, strategyPtr("Always"), true),
+				Entry("when migrating from spec.runStrategy to spec.running", nil, strategyPtr("Halted"), boolPtr(false), nil, true),
+				Entry("when spec.runStrategy is added next to spec.running", boolPtr(true), nil, boolPtr(true), strategyPtr("Always"), true),
+				Entry("when spec.running is removed from a VM with both set", boolPtr(true), strategyPtr("Always"), nil, strategyPtr("Always"), true),
+			)
+		})
+
+		Context("termination grace period", func() {
+			var oldVM *kubevirtiov1.VirtualMachine
+
+			BeforeEach(func() {
+				gracePeriod := int64(180)
+				oldVM = &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+								TerminationGracePeriodSeconds: &gracePeriod,
+							},
+						},
+					},
+				}
+			})
+
+			It("should detect terminationGracePeriodSeconds changes", func() {
+				newVM := oldVM.DeepCopy()
+				gracePeriod := int64(0)
+				newVM.Spec.Template.Spec.TerminationGracePeriodSeconds = &gracePeriod
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should neutralize terminationGracePeriodSeconds", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.TerminationGracePeriodSeconds = nil
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(oldVM.Spec.Template.Spec.TerminationGracePeriodSeconds).To(BeNil())
+				Expect(newVM.Spec.Template.Spec.TerminationGracePeriodSeconds).To(BeNil())
+			})
+
+			It("should not be detected by the compute checker", func() {
+				newVM := oldVM.DeepCopy()
+				gracePeriod := int64(0)
+				newVM.Spec.Template.Spec.TerminationGracePeriodSeconds = &gracePeriod
+
+				Expect((&ComputePermissionChecker{}).HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should neutralize spec.running changes", func() {
+				running := false
+				oldVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Running: &running,
+					},
+				}
+
+				runningNew := true
+				newVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Running: &runningNew,
+					},
+				}
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(oldVM.Spec.Running).To(BeNil())
+				Expect(newVM.Spec.Running).To(BeNil())
+			})
+
+			It("should neutralize spec.runStrategy changes", func() {
+				strategyAlways := kubevirtiov1.VirtualMachineRunStrategy("Always")
+				oldVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						RunStrategy: &strategyAlways,
+					},
+				}
+
+				strategyHalted := kubevirtiov1.VirtualMachineRunStrategy("Halted")
+				newVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						RunStrategy: &strategyHalted,
+					},
+				}
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(oldVM.Spec.RunStrategy).To(BeNil())
+				Expect(newVM.Spec.RunStrategy).To(BeNil())
+			})
+
+			It("should neutralize both spec.running and spec.runStrategy", func() {
+				running := false
+				strategyAlways := kubevirtiov1.VirtualMachineRunStrategy("Always")
+				oldVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Running:     &running,
+						RunStrategy: &strategyAlways,
+					},
+				}
+
+				runningNew := true
+				strategyHalted := kubevirtiov1.VirtualMachineRunStrategy("Halted")
+				newVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Running:     &runningNew,
+						RunStrategy: &strategyHalted,
+					},
+				}
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(oldVM.Spec.Running).To(BeNil())
+				Expect(newVM.Spec.Running).To(BeNil())
+				Expect(oldVM.Spec.RunStrategy).To(BeNil())
+				Expect(newVM.Spec.RunStrategy).To(BeNil())
+			})
+
+			It("should make objects equal after neutralization when only lifecycle fields differ", func() {
+				running := false
+				oldVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Running: &running,
+					},
+				}
+
+				runningNew := true
+				newVM := &kubevirtiov1.VirtualMachine{
+					Spec: kubevirtiov1.VirtualMachineSpec{
+						Running: &runningNew,
+					},
+				}
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(equality.Semantic.DeepEqual(oldVM.Spec, newVM.Spec)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("TemplateMetadataPermissionChecker", func() {
+		var (
+			checker *TemplateMetadataPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &TemplateMetadataPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels:      map[string]string{"kubevirt.io/domain": "test-vm"},
+							Annotations: map[string]string{"key": "value"},
+						},
+					},
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("template-metadata"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/template-metadata-admin"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect template label changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.ObjectMeta.Labels["zone"] = "east"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect template annotation changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.ObjectMeta.Annotations["key"] = "other"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect VM metadata changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Labels = map[string]string{"app": "test"}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect changes when template metadata is identical", func() {
+				newVM := oldVM.DeepCopy()
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should set template labels and annotations to nil in both VMs", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.ObjectMeta.Labels["zone"] = "east"
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(oldVM.Spec.Template.ObjectMeta.Labels).To(BeNil())
+				Expect(newVM.Spec.Template.ObjectMeta.Labels).To(BeNil())
+				Expect(oldVM.Spec.Template.ObjectMeta.Annotations).To(BeNil())
+				Expect(newVM.Spec.Template.ObjectMeta.Annotations).To(BeNil())
+			})
+		})
+	})
+
+	Describe("FilesystemPermissionChecker", func() {
+		var (
+			checker *FilesystemPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &FilesystemPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{
+									Disks: []kubevirtiov1.Disk{
+										{Name: "rootdisk"},
+									},
+									Filesystems: []kubevirtiov1.Filesystem{
+										{Name: "shared-fs", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}},
+									},
+								},
+							},
+							Volumes: []kubevirtiov1.Volume{
+								{Name: "rootdisk"},
+								{Name: "shared-fs"},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("filesystem"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/filesystem-admin"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect when filesystems are removed", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems = nil
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect changes to a filesystem's backing volume", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Volumes[1].VolumeSource = kubevirtiov1.VolumeSource{
+					PersistentVolumeClaim: &kubevirtiov1.PersistentVolumeClaimVolumeSource{},
+				}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect disk-backed volume changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Volumes[0].VolumeSource = kubevirtiov1.VolumeSource{
+					PersistentVolumeClaim: &kubevirtiov1.PersistentVolumeClaimVolumeSource{},
+				}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect changes when filesystems are identical", func() {
+				newVM := oldVM.DeepCopy()
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should remove filesystems and their volumes but keep disk volumes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems = nil
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(oldVM.Spec.Template.Spec.Domain.Devices.Filesystems).To(BeNil())
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Filesystems).To(BeNil())
+				Expect(oldVM.Spec.Template.Spec.Volumes).To(Equal([]kubevirtiov1.Volume{{Name: "rootdisk"}}))
+				Expect(newVM.Spec.Template.Spec.Volumes).To(Equal([]kubevirtiov1.Volume{{Name: "rootdisk"}}))
+			})
+		})
+	})
+
+	Describe("FilesystemUserPermissionChecker", func() {
+		var (
+			checker *FilesystemUserPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &FilesystemUserPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{
+									Disks: []kubevirtiov1.Disk{
+										{Name: "rootdisk"},
+									},
+									Filesystems: []kubevirtiov1.Filesystem{
+										{Name: "pvc-fs", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}},
+										{Name: "dv-fs", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}},
+										{Name: "config-fs", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}},
+									},
+								},
+							},
+							Volumes: []kubevirtiov1.Volume{
+								{Name: "rootdisk"},
+								{
+									Name: "pvc-fs",
+									VolumeSource: kubevirtiov1.VolumeSource{
+										PersistentVolumeClaim: &kubevirtiov1.PersistentVolumeClaimVolumeSource{},
+									},
+								},
+								{
+									Name: "dv-fs",
+									VolumeSource: kubevirtiov1.VolumeSource{
+										DataVolume: &kubevirtiov1.DataVolumeSource{Name: "dv"},
+									},
+								},
+								{
+									Name: "config-fs",
+									VolumeSource: kubevirtiov1.VolumeSource{
+										ConfigMap: &kubevirtiov1.ConfigMapVolumeSource{},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("filesystem-user"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/filesystem-user"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect a PVC-backed filesystem being removed", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems = newVM.Spec.Template.Spec.Domain.Devices.Filesystems[1:]
+				newVM.Spec.Template.Spec.Volumes = slices.Delete(newVM.Spec.Template.Spec.Volumes, 1, 2)
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect a DataVolume-backed filesystem switching to another DataVolume", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Volumes[2].DataVolume.Name = "other-dv"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not attribute a virtio-fs parameter change of a PVC-backed filesystem", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems[0].Virtiofs = nil
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+				Expect((&FilesystemPermissionChecker{}).HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should still detect a claim change alongside a virtio-fs parameter change of another filesystem", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems[0].Virtiofs = nil
+				newVM.Spec.Template.Spec.Volumes[2].DataVolume.Name = "other-dv"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not attribute configMap-backed filesystem changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Volumes[3].ConfigMap.Name = "other-config"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not attribute a filesystem switching from a PVC to another backing", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Volumes[1].VolumeSource = kubevirtiov1.VolumeSource{
+					Secret: &kubevirtiov1.SecretVolumeSource{SecretName: "credentials"},
+				}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not attribute a filesystem without a backing volume", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems = append(newVM.Spec.Template.Spec.Domain.Devices.Filesystems,
+					kubevirtiov1.Filesystem{Name: "unbacked-fs", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}})
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect disk-backed volume changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Volumes[0].VolumeSource = kubevirtiov1.VolumeSource{
+					PersistentVolumeClaim: &kubevirtiov1.PersistentVolumeClaimVolumeSource{},
+				}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
 
-				// After neutralization, non-network changes (CPU) should still be visible
-				Expect(equality.Semantic.DeepEqual(oldVM.Spec, newVM.Spec)).To(BeFalse())
+			It("should not detect changes when filesystems are identical", func() {
+				newVM := oldVM.DeepCopy()
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
 			})
 		})
-	})
 
-	Describe("ComputePermissionChecker", func() {
-		var checker *ComputePermissionChecker
+		Context("Neutralize", func() {
+			It("should remove only PVC- and DataVolume-backed filesystems and their volumes", func() {
+				newVM := oldVM.DeepCopy()
 
-		BeforeEach(func() {
-			checker = &ComputePermissionChecker{}
-		})
+				checker.Neutralize(oldVM, newVM)
 
-		It("should have correct name and subresource", func() {
-			Expect(checker.Name()).To(Equal("compute"))
-			Expect(checker.Subresource()).To(Equal("virtualmachines/compute-admin"))
+				for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+					Expect(vm.Spec.Template.Spec.Domain.Devices.Filesystems).To(Equal([]kubevirtiov1.Filesystem{
+						{Name: "config-fs", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}},
+					}))
+					Expect(vm.Spec.Template.Spec.Volumes).To(HaveLen(2))
+					Expect(vm.Spec.Template.Spec.Volumes[0].Name).To(Equal("rootdisk"))
+					Expect(vm.Spec.Template.Spec.Volumes[1].Name).To(Equal("config-fs"))
+				}
+			})
+
+			It("should keep a PVC-backed filesystem whose virtio-fs parameters change", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems[0].Virtiofs = nil
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(oldVM.Spec.Template.Spec.Domain.Devices.Filesystems).To(Equal([]kubevirtiov1.Filesystem{
+					{Name: "pvc-fs", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}},
+					{Name: "config-fs", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}},
+				}))
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Filesystems).To(Equal([]kubevirtiov1.Filesystem{
+					{Name: "pvc-fs"},
+					{Name: "config-fs", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}},
+				}))
+				Expect(volumeNames(newVM)).To(ConsistOf("rootdisk", "pvc-fs", "config-fs"))
+			})
 		})
 
-		Context("HasChanged", func() {
-			It("should detect CPU changes", func() {
-				oldVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
-							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
-								Domain: kubevirtiov1.DomainSpec{
-									CPU: &kubevirtiov1.CPU{Cores: 2},
-								},
-							},
-						},
-					},
-				}
+		Context("with RequireRemovalAdmin", func() {
+			BeforeEach(func() {
+				checker.RequireRemovalAdmin = true
+			})
 
-				newVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
-							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
-								Domain: kubevirtiov1.DomainSpec{
-									CPU: &kubevirtiov1.CPU{Cores: 4},
-								},
-							},
-						},
+			It("should not detect a PVC-backed filesystem being removed", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems = newVM.Spec.Template.Spec.Domain.Devices.Filesystems[1:]
+				newVM.Spec.Template.Spec.Volumes = slices.Delete(newVM.Spec.Template.Spec.Volumes, 1, 2)
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should still detect a PVC-backed filesystem being added", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems = append(newVM.Spec.Template.Spec.Domain.Devices.Filesystems,
+					kubevirtiov1.Filesystem{Name: "new-fs", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}})
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
+					Name: "new-fs",
+					VolumeSource: kubevirtiov1.VolumeSource{
+						PersistentVolumeClaim: &kubevirtiov1.PersistentVolumeClaimVolumeSource{},
 					},
-				}
+				})
 
 				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
 			})
 
-			It("should detect resource changes", func() {
-				oldVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
-							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
-								Domain: kubevirtiov1.DomainSpec{
-									CPU: &kubevirtiov1.CPU{Cores: 2},
+			It("should keep the removed filesystem and its volume in the old VM when neutralizing", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems = newVM.Spec.Template.Spec.Domain.Devices.Filesystems[1:]
+				newVM.Spec.Template.Spec.Volumes = slices.Delete(newVM.Spec.Template.Spec.Volumes, 1, 2)
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(oldVM.Spec.Template.Spec.Domain.Devices.Filesystems).To(ConsistOf(
+					kubevirtiov1.Filesystem{Name: "config-fs", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}},
+					kubevirtiov1.Filesystem{Name: "pvc-fs", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}},
+				))
+				Expect(volumeNames(oldVM)).To(ConsistOf("rootdisk", "config-fs", "pvc-fs"))
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Filesystems).To(Equal([]kubevirtiov1.Filesystem{
+					{Name: "config-fs", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}},
+				}))
+				Expect(volumeNames(newVM)).To(ConsistOf("rootdisk", "config-fs"))
+			})
+		})
+	})
+
+	Describe("FilesystemRemovalPermissionChecker", func() {
+		var (
+			checker *FilesystemRemovalPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &FilesystemRemovalPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{
+									Disks: []kubevirtiov1.Disk{
+										{Name: "rootdisk"},
+									},
+									Filesystems: []kubevirtiov1.Filesystem{
+										{Name: "data-fs", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}},
+										{Name: "config-fs", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}},
+									},
 								},
 							},
-						},
-					},
-				}
-
-				newVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
-							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
-								Domain: kubevirtiov1.DomainSpec{
-									CPU: &kubevirtiov1.CPU{Cores: 2},
-									Resources: kubevirtiov1.ResourceRequirements{
-										OvercommitGuestOverhead: true,
+							Volumes: []kubevirtiov1.Volume{
+								{Name: "rootdisk"},
+								{
+									Name: "data-fs",
+									VolumeSource: kubevirtiov1.VolumeSource{
+										PersistentVolumeClaim: &kubevirtiov1.PersistentVolumeClaimVolumeSource{},
+									},
+								},
+								{
+									Name: "config-fs",
+									VolumeSource: kubevirtiov1.VolumeSource{
+										ConfigMap: &kubevirtiov1.ConfigMapVolumeSource{},
 									},
 								},
 							},
 						},
 					},
-				}
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("filesystem-removal"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/filesystem-removal-admin"))
+		})
+
+		It("should be a subset of filesystem-admin and storage-admin", func() {
+			Expect(checker.IsSubsetOf("filesystem")).To(BeTrue())
+			Expect(checker.IsSubsetOf("storage")).To(BeTrue())
+			Expect(checker.IsSubsetOf("devices")).To(BeFalse())
+		})
+
+		Context("HasChanged", func() {
+			It("should detect a filesystem being removed with its volume", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems = newVM.Spec.Template.Spec.Domain.Devices.Filesystems[1:]
+				newVM.Spec.Template.Spec.Volumes = slices.Delete(newVM.Spec.Template.Spec.Volumes, 1, 2)
 
 				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
 			})
 
-			It("should not detect changes when compute is identical", func() {
-				oldVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
-							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
-								Domain: kubevirtiov1.DomainSpec{
-									CPU: &kubevirtiov1.CPU{Cores: 2},
-								},
-							},
-						},
-					},
-				}
+			It("should detect a filesystem being removed while its volume is kept", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems = nil
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect a filesystem being added", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems = append(newVM.Spec.Template.Spec.Domain.Devices.Filesystems,
+					kubevirtiov1.Filesystem{Name: "new-fs", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}})
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect a backing volume being switched", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Volumes[2].ConfigMap.Name = "other-config"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
 
+			It("should not detect a filesystem named like a disk being removed", func() {
+				oldVM.Spec.Template.Spec.Domain.Devices.Filesystems = append(oldVM.Spec.Template.Spec.Domain.Devices.Filesystems,
+					kubevirtiov1.Filesystem{Name: "rootdisk"})
 				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems = newVM.Spec.Template.Spec.Domain.Devices.Filesystems[:2]
+
 				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
 			})
 		})
 
 		Context("Neutralize", func() {
-			It("should neutralize CPU and resources", func() {
-				oldVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
-							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
-								Domain: kubevirtiov1.DomainSpec{
-									CPU: &kubevirtiov1.CPU{Cores: 2},
-									Resources: kubevirtiov1.ResourceRequirements{
-										OvercommitGuestOverhead: true,
-									},
-								},
-							},
-						},
-					},
-				}
+			It("should drop only the removed filesystem and its removed volume from the old VM", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems = newVM.Spec.Template.Spec.Domain.Devices.Filesystems[1:]
+				newVM.Spec.Template.Spec.Volumes = slices.Delete(newVM.Spec.Template.Spec.Volumes, 1, 2)
+				newVM.Spec.Template.Spec.Volumes[1].ConfigMap.Name = "other-config"
+
+				checker.Neutralize(oldVM, newVM)
+
+				Expect(oldVM.Spec.Template.Spec.Domain.Devices.Filesystems).To(Equal(newVM.Spec.Template.Spec.Domain.Devices.Filesystems))
+				Expect(volumeNames(oldVM)).To(Equal([]string{"rootdisk", "config-fs"}))
+				Expect(oldVM.Spec.Template.Spec.Volumes[1].ConfigMap.Name).To(BeEmpty())
+			})
 
+			It("should keep a volume the new VM still has", func() {
 				newVM := oldVM.DeepCopy()
-				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems = nil
 
 				checker.Neutralize(oldVM, newVM)
 
-				Expect(oldVM.Spec.Template.Spec.Domain.CPU).To(BeNil())
-				Expect(newVM.Spec.Template.Spec.Domain.CPU).To(BeNil())
-				Expect(oldVM.Spec.Template.Spec.Domain.Resources).To(Equal(kubevirtiov1.ResourceRequirements{}))
-				Expect(newVM.Spec.Template.Spec.Domain.Resources).To(Equal(kubevirtiov1.ResourceRequirements{}))
+				Expect(oldVM.Spec.Template.Spec.Domain.Devices.Filesystems).To(BeEmpty())
+				Expect(oldVM.Spec.Template.Spec.Volumes).To(Equal(newVM.Spec.Template.Spec.Volumes))
 			})
 		})
 	})
 
-	Describe("DevicesPermissionChecker", func() {
-		var checker *DevicesPermissionChecker
+	Describe("IdentityPermissionChecker", func() {
+		var (
+			checker *IdentityPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
 
 		BeforeEach(func() {
-			checker = &DevicesPermissionChecker{}
-		})
-
-		It("should have correct name and subresource", func() {
-			Expect(checker.Name()).To(Equal("devices"))
-			Expect(checker.Subresource()).To(Equal("virtualmachines/devices-admin"))
-		})
-
-		Context("HasChanged", func() {
-			It("should detect GPU changes", func() {
-				oldVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
-							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
-								Domain: kubevirtiov1.DomainSpec{
-									Devices: kubevirtiov1.Devices{
-										GPUs: []kubevirtiov1.GPU{},
+			checker = &IdentityPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{
+									Disks: []kubevirtiov1.Disk{
+										{Name: "rootdisk"},
+										{Name: "credentials"},
 									},
 								},
 							},
-						},
-					},
-				}
-
-				newVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
-							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
-								Domain: kubevirtiov1.DomainSpec{
-									Devices: kubevirtiov1.Devices{
-										GPUs: []kubevirtiov1.GPU{
-											{Name: "gpu1"},
-										},
+							Volumes: []kubevirtiov1.Volume{
+								{
+									Name: "rootdisk",
+									VolumeSource: kubevirtiov1.VolumeSource{
+										PersistentVolumeClaim: &kubevirtiov1.PersistentVolumeClaimVolumeSource{},
+									},
+								},
+								{
+									Name: "credentials",
+									VolumeSource: kubevirtiov1.VolumeSource{
+										Secret: &kubevirtiov1.SecretVolumeSource{SecretName: "app-credentials"},
 									},
 								},
 							},
 						},
 					},
-				}
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("identity"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/identity-admin"))
+		})
+
+		Context("HasChanged", func() {
+			It("should detect adding a serviceAccount volume and its disk", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks = append(newVM.Spec.Template.Spec.Domain.Devices.Disks,
+					kubevirtiov1.Disk{Name: "sa"})
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
+					Name: "sa",
+					VolumeSource: kubevirtiov1.VolumeSource{
+						ServiceAccount: &kubevirtiov1.ServiceAccountVolumeSource{ServiceAccountName: "privileged"},
+					},
+				})
 
 				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
 			})
 
-			It("should detect host device changes", func() {
-				oldVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
-							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
-								Domain: kubevirtiov1.DomainSpec{
-									Devices: kubevirtiov1.Devices{
-										HostDevices: []kubevirtiov1.HostDevice{},
-									},
-								},
-							},
-						},
+			It("should detect a secret volume pointing to another secret", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Volumes[1].Secret.SecretName = "admin-credentials"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect adding a downwardAPI volume", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
+					Name: "podinfo",
+					VolumeSource: kubevirtiov1.VolumeSource{
+						DownwardAPI: &kubevirtiov1.DownwardAPIVolumeSource{},
 					},
+				})
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not attribute a volume switching from a PVC to a secret", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Volumes[0].VolumeSource = kubevirtiov1.VolumeSource{
+					Secret: &kubevirtiov1.SecretVolumeSource{SecretName: "app-credentials"},
 				}
 
-				newVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
-							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
-								Domain: kubevirtiov1.DomainSpec{
-									Devices: kubevirtiov1.Devices{
-										HostDevices: []kubevirtiov1.HostDevice{
-											{Name: "dev1"},
-										},
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect regular storage changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName = "other-claim"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect changes when identity volumes are identical", func() {
+				newVM := oldVM.DeepCopy()
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should remove identity volumes and their disks but keep regular storage", func() {
+				newVM := oldVM.DeepCopy()
+
+				checker.Neutralize(oldVM, newVM)
+
+				for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+					Expect(vm.Spec.Template.Spec.Domain.Devices.Disks).To(Equal([]kubevirtiov1.Disk{{Name: "rootdisk"}}))
+					Expect(vm.Spec.Template.Spec.Volumes).To(HaveLen(1))
+					Expect(vm.Spec.Template.Spec.Volumes[0].Name).To(Equal("rootdisk"))
+				}
+			})
+		})
+	})
+
+	Describe("ConfigPermissionChecker", func() {
+		var (
+			checker *ConfigPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &ConfigPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{
+									Disks: []kubevirtiov1.Disk{
+										{Name: "rootdisk"},
+										{Name: "credentials"},
+									},
+								},
+							},
+							Volumes: []kubevirtiov1.Volume{
+								{
+									Name: "rootdisk",
+									VolumeSource: kubevirtiov1.VolumeSource{
+										PersistentVolumeClaim: &kubevirtiov1.PersistentVolumeClaimVolumeSource{},
+									},
+								},
+								{
+									Name: "credentials",
+									VolumeSource: kubevirtiov1.VolumeSource{
+										Secret: &kubevirtiov1.SecretVolumeSource{SecretName: "app-credentials"},
 									},
 								},
 							},
 						},
 					},
-				}
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("config"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/config-admin"))
+			Expect(checker.IsSubsetOf("storage")).To(BeTrue())
+		})
+
+		Context("HasChanged", func() {
+			It("should detect a secret volume pointing to another secret", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Volumes[1].Secret.SecretName = "admin-credentials"
 
 				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
 			})
 
-			It("should not detect changes when devices are identical", func() {
-				oldVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
-							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
-								Domain: kubevirtiov1.DomainSpec{
-									Devices: kubevirtiov1.Devices{
-										GPUs: []kubevirtiov1.GPU{
-											{Name: "gpu1"},
-										},
-									},
-								},
-							},
-						},
+			It("should detect adding a configMap volume and its disk", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks = append(newVM.Spec.Template.Spec.Domain.Devices.Disks,
+					kubevirtiov1.Disk{Name: "app-config"})
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
+					Name: "app-config",
+					VolumeSource: kubevirtiov1.VolumeSource{
+						ConfigMap: &kubevirtiov1.ConfigMapVolumeSource{},
+					},
+				})
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect adding a downwardAPI volume", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
+					Name: "podinfo",
+					VolumeSource: kubevirtiov1.VolumeSource{
+						DownwardAPI: &kubevirtiov1.DownwardAPIVolumeSource{},
+					},
+				})
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect adding a serviceAccount volume", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
+					Name: "sa",
+					VolumeSource: kubevirtiov1.VolumeSource{
+						ServiceAccount: &kubevirtiov1.ServiceAccountVolumeSource{ServiceAccountName: "privileged"},
 					},
+				})
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not attribute a volume switching from a PVC to a configMap", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Volumes[0].VolumeSource = kubevirtiov1.VolumeSource{
+					ConfigMap: &kubevirtiov1.ConfigMapVolumeSource{},
 				}
 
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect changes when config volumes are identical", func() {
 				newVM := oldVM.DeepCopy()
+
 				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
 			})
 		})
 
 		Context("Neutralize", func() {
-			It("should neutralize all device fields", func() {
-				oldVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
-							Spec: kubevirtiov1.VirtualMachineInstanceSpec{
-								Domain: kubevirtiov1.DomainSpec{
-									Devices: kubevirtiov1.Devices{
-										GPUs: []kubevirtiov1.GPU{
-											{Name: "gpu1"},
-										},
-										HostDevices: []kubevirtiov1.HostDevice{
-											{Name: "dev1"},
-										},
+			It("should remove config volumes and their disks but keep regular storage", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Volumes[1].Secret.SecretName = "admin-credentials"
+
+				checker.Neutralize(oldVM, newVM)
+
+				for _, vm := range []*kubevirtiov1.VirtualMachine{oldVM, newVM} {
+					Expect(vm.Spec.Template.Spec.Domain.Devices.Disks).To(Equal([]kubevirtiov1.Disk{{Name: "rootdisk"}}))
+					Expect(vm.Spec.Template.Spec.Volumes).To(HaveLen(1))
+					Expect(vm.Spec.Template.Spec.Volumes[0].Name).To(Equal("rootdisk"))
+				}
+			})
+		})
+	})
+
+	Describe("AutoattachPermissionChecker", func() {
+		var (
+			checker *AutoattachPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			checker = &AutoattachPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{
+									AutoattachGraphicsDevice: boolPtr(true),
+									Disks: []kubevirtiov1.Disk{
+										{Name: "disk1"},
 									},
 								},
 							},
 						},
 					},
-				}
+				},
+			}
+		})
+
+		It("should have correct name and subresource", func() {
+			Expect(checker.Name()).To(Equal("autoattach"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/devices-admin"))
+		})
 
+		Context("HasChanged", func() {
+			It("should detect toggling autoattachGraphicsDevice", func() {
 				newVM := oldVM.DeepCopy()
-				newVM.Spec.Template.Spec.Domain.Devices.GPUs = append(newVM.Spec.Template.Spec.Domain.Devices.GPUs, kubevirtiov1.GPU{Name: "gpu2"})
+				newVM.Spec.Template.Spec.Domain.Devices.AutoattachGraphicsDevice = boolPtr(false)
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect setting autoattachPodInterface", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.AutoattachPodInterface = boolPtr(false)
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect other device changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.Disks = nil
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+		})
+
+		Context("Neutralize", func() {
+			It("should clear autoattach toggles but keep other devices", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.AutoattachGraphicsDevice = boolPtr(false)
+				newVM.Spec.Template.Spec.Domain.Devices.AutoattachSerialConsole = boolPtr(false)
 
 				checker.Neutralize(oldVM, newVM)
 
-				Expect(oldVM.Spec.Template.Spec.Domain.Devices.GPUs).To(BeNil())
-				Expect(newVM.Spec.Template.Spec.Domain.Devices.GPUs).To(BeNil())
-				Expect(oldVM.Spec.Template.Spec.Domain.Devices.HostDevices).To(BeNil())
-				Expect(newVM.Spec.Template.Spec.Domain.Devices.HostDevices).To(BeNil())
+				Expect(oldVM.Spec.Template.Spec.Domain.Devices.AutoattachGraphicsDevice).To(BeNil())
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.AutoattachGraphicsDevice).To(BeNil())
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.AutoattachSerialConsole).To(BeNil())
+				Expect(newVM.Spec.Template.Spec.Domain.Devices.Disks).To(HaveLen(1))
 			})
 		})
 	})
 
-	Describe("LifecyclePermissionChecker", func() {
-		var checker *LifecyclePermissionChecker
+	Describe("InstancetypeRevisionPermissionChecker", func() {
+		var (
+			checker *InstancetypeRevisionPermissionChecker
+			oldVM   *kubevirtiov1.VirtualMachine
+		)
 
 		BeforeEach(func() {
-			checker = &LifecyclePermissionChecker{}
+			checker = &InstancetypeRevisionPermissionChecker{}
+			oldVM = &kubevirtiov1.VirtualMachine{
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Instancetype: &kubevirtiov1.InstancetypeMatcher{
+						Name:         "u1.medium",
+						Kind:         "virtualmachineclusterinstancetype",
+						RevisionName: "vm-u1.medium-v1",
+					},
+					Preference: &kubevirtiov1.PreferenceMatcher{
+						Name:         "fedora",
+						Kind:         "virtualmachineclusterpreference",
+						RevisionName: "vm-fedora-v1",
+					},
+				},
+			}
 		})
 
 		It("should have correct name and subresource", func() {
-			Expect(checker.Name()).To(Equal("lifecycle"))
-			Expect(checker.Subresource()).To(Equal("virtualmachines/lifecycle-admin"))
+			Expect(checker.Name()).To(Equal("instancetype"))
+			Expect(checker.Subresource()).To(Equal("virtualmachines/instancetype-admin"))
 		})
 
 		Context("HasChanged", func() {
-			DescribeTable("should correctly detect lifecycle field changes",
-				func(oldRunning *bool, oldStrategy *kubevirtiov1.VirtualMachineRunStrategy, newRunning *bool, newStrategy *kubevirtiov1.VirtualMachineRunStrategy, expectedChanged bool) {
-					oldVM := &kubevirtiov1.VirtualMachine{
-						Spec: kubevirtiov1.VirtualMachineSpec{
-							Running:     oldRunning,
-							RunStrategy: oldStrategy,
-						},
-					}
+			It("should detect an instancetype revision change", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Instancetype.RevisionName = "vm-u1.medium-v2"
 
-					newVM := &kubevirtiov1.VirtualMachine{
-						Spec: kubevirtiov1.VirtualMachineSpec{
-							Running:     newRunning,
-							RunStrategy: newStrategy,
-						},
-					}
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
 
-					Expect(checker.HasChanged(oldVM, newVM)).To(Equal(expectedChanged))
-				},
-				Entry("when spec.running changes from false to true", boolPtr(false), nil, boolPtr(true), nil, true),
-				Entry("when spec.running changes from true to false", boolPtr(true), nil, boolPtr(false), nil, true),
-				Entry("when spec.running changes from nil to true", nil, nil, boolPtr(true), nil, true),
-				Entry("when spec.running changes from true to nil", boolPtr(true), nil, nil, nil, true),
-				Entry("when spec.runStrategy changes from Always to Halted", nil, strategyPtr("Always"), nil, strategyPtr("Halted"), true),
-				Entry("when spec.runStrategy changes from Always to Manual", nil, strategyPtr("Always"), nil, strategyPtr("Manual"), true),
-				Entry("when spec.runStrategy changes from RerunOnFailure to Once", nil, strategyPtr("RerunOnFailure"), nil, strategyPtr("Once"), true),
-				Entry("when spec.running is identical (true)", boolPtr(true), nil, boolPtr(true), nil, false),
-				Entry("when spec.running is identical (nil)", nil, nil, nil, nil, false),
-				Entry("when spec.runStrategy is identical", nil, strategyPtr("Always"), nil, strategyPtr("Always"), false),
-				Entry("when both running and runStrategy are identical", boolPtr(true), strategyPtr("Always"), boolPtr(true), strategyPtr("Always"), false),
-			)
+			It("should detect a preference revision change", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Preference.RevisionName = "vm-fedora-v2"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should detect a revision being unpinned", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Instancetype.RevisionName = ""
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
+			})
+
+			It("should not detect an instancetype name change", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Instancetype.Name = "u1.large"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect a name change combined with a revision change", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Instancetype.Name = "u1.large"
+				newVM.Spec.Instancetype.RevisionName = "vm-u1.large-v1"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
+
+			It("should not detect an instancetype being added", func() {
+				oldVM.Spec.Instancetype = nil
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Instancetype = &kubevirtiov1.InstancetypeMatcher{Name: "u1.medium", RevisionName: "vm-u1.medium-v1"}
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
 		})
 
 		Context("Neutralize", func() {
-			It("should neutralize spec.running changes", func() {
-				running := false
-				oldVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Running: &running,
-					},
-				}
-
-				runningNew := true
-				newVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Running: &runningNew,
-					},
-				}
+			It("should clear revision-only changes", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Instancetype.RevisionName = "vm-u1.medium-v2"
+				newVM.Spec.Preference.RevisionName = "vm-fedora-v2"
 
 				checker.Neutralize(oldVM, newVM)
 
-				Expect(oldVM.Spec.Running).To(BeNil())
-				Expect(newVM.Spec.Running).To(BeNil())
+				Expect(newVM.Spec.Instancetype.RevisionName).To(BeEmpty())
+				Expect(newVM.Spec.Preference.RevisionName).To(BeEmpty())
+				Expect(equality.Semantic.DeepEqual(oldVM.Spec, newVM.Spec)).To(BeTrue())
 			})
 
-			It("should neutralize spec.runStrategy changes", func() {
-				strategyAlways := kubevirtiov1.VirtualMachineRunStrategy("Always")
-				oldVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						RunStrategy: &strategyAlways,
-					},
-				}
-
-				strategyHalted := kubevirtiov1.VirtualMachineRunStrategy("Halted")
-				newVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						RunStrategy: &strategyHalted,
-					},
-				}
+			It("should keep the revision when the name changed too", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Instancetype.Name = "u1.large"
+				newVM.Spec.Instancetype.RevisionName = "vm-u1.large-v1"
 
 				checker.Neutralize(oldVM, newVM)
 
-				Expect(oldVM.Spec.RunStrategy).To(BeNil())
-				Expect(newVM.Spec.RunStrategy).To(BeNil())
+				Expect(newVM.Spec.Instancetype.RevisionName).To(Equal("vm-u1.large-v1"))
+				Expect(equality.Semantic.DeepEqual(oldVM.Spec, newVM.Spec)).To(BeFalse())
 			})
+		})
 
-			It("should neutralize both spec.running and spec.runStrategy", func() {
-				running := false
-				strategyAlways := kubevirtiov1.VirtualMachineRunStrategy("Always")
-				oldVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Running:     &running,
-						RunStrategy: &strategyAlways,
-					},
-				}
+		Context("with IncludeInstancetypeChanges", func() {
+			BeforeEach(func() {
+				checker.IncludeInstancetypeChanges = true
+			})
 
-				runningNew := true
-				strategyHalted := kubevirtiov1.VirtualMachineRunStrategy("Halted")
-				newVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Running:     &runningNew,
-						RunStrategy: &strategyHalted,
-					},
-				}
+			It("should detect and clear an instancetype switch", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Instancetype.Name = "u1.large"
+				newVM.Spec.Instancetype.RevisionName = ""
 
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
 				checker.Neutralize(oldVM, newVM)
+				Expect(equality.Semantic.DeepEqual(oldVM.Spec, newVM.Spec)).To(BeTrue())
+			})
 
-				Expect(oldVM.Spec.Running).To(BeNil())
-				Expect(newVM.Spec.Running).To(BeNil())
-				Expect(oldVM.Spec.RunStrategy).To(BeNil())
-				Expect(newVM.Spec.RunStrategy).To(BeNil())
+			It("should detect a kind change", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Instancetype.Kind = "virtualmachineinstancetype"
+
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeTrue())
 			})
 
-			It("should make objects equal after neutralization when only lifecycle fields differ", func() {
-				running := false
-				oldVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Running: &running,
-					},
-				}
+			It("should not detect an instancetype being added", func() {
+				oldVM.Spec.Instancetype = nil
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Instancetype = &kubevirtiov1.InstancetypeMatcher{Name: "u1.medium"}
 
-				runningNew := true
-				newVM := &kubevirtiov1.VirtualMachine{
-					Spec: kubevirtiov1.VirtualMachineSpec{
-						Running: &runningNew,
-					},
-				}
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
+			})
 
-				checker.Neutralize(oldVM, newVM)
+			It("should not detect a switch to inferring the instancetype from a volume", func() {
+				newVM := oldVM.DeepCopy()
+				newVM.Spec.Instancetype = &kubevirtiov1.InstancetypeMatcher{InferFromVolume: "rootdisk"}
 
-				Expect(equality.Semantic.DeepEqual(oldVM.Spec, newVM.Spec)).To(BeTrue())
+				Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse())
 			})
 		})
 	})
@@ -0,0 +1,60 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// FrozenAnnotation freezes a VM, or every VM of a namespace, for maintenance when set to "true":
+// only full-admin may update it
+const FrozenAnnotation = "kubevirt-rbac-webhook/frozen"
+
+// checkFrozen denies the update of a user without full-admin when the VM or its namespace is
+// frozen, or when the update changes the frozen annotation of the VM. The stored VM decides,
+// so a user cannot unfreeze the VM in the same update that changes it.
+func (v *VirtualMachineCustomValidator) checkFrozen(ctx context.Context, oldVM, newVM *kubevirtiov1.VirtualMachine, vmRef string) error {
+	if oldVM.Annotations[FrozenAnnotation] == "true" {
+		return denyFullAdminf(ReasonCodeFrozen, "VirtualMachine %s is frozen for maintenance (%s annotation), only virtualmachines/full-admin may update it",
+			vmRef, FrozenAnnotation)
+	}
+
+	oldValue, inOld := oldVM.Annotations[FrozenAnnotation]
+	newValue, inNew := newVM.Annotations[FrozenAnnotation]
+	if inOld != inNew || oldValue != newValue {
+		return denyFullAdminf(ReasonCodeControlAnnotationForbidden, "user does not have permission to modify the %s annotation of VirtualMachine %s (requires virtualmachines/full-admin)",
+			FrozenAnnotation, vmRef)
+	}
+
+	if v.NamespaceReader == nil {
+		return nil
+	}
+	namespace := &corev1.Namespace{}
+	if err := v.NamespaceReader.Get(ctx, client.ObjectKey{Name: newVM.Namespace}, namespace); err != nil {
+		return fmt.Errorf("failed to get namespace %s to check for a freeze: %w", newVM.Namespace, err)
+	}
+	if namespace.Annotations[FrozenAnnotation] == "true" {
+		return denyFullAdminf(ReasonCodeFrozen, "VirtualMachine %s is frozen for maintenance (%s annotation on namespace %s), only virtualmachines/full-admin may update it",
+			vmRef, FrozenAnnotation, newVM.Namespace)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("Maintenance freeze", func() {
+	const frozenMessage = "VirtualMachine default/test-vm is frozen for maintenance (kubevirt-rbac-webhook/frozen annotation), " +
+		"only virtualmachines/full-admin may update it"
+
+	var (
+		validator *VirtualMachineCustomValidator
+		mockPerm  *MockPermissionChecker
+		ctx       context.Context
+		oldVM     *kubevirtiov1.VirtualMachine
+		newVM     *kubevirtiov1.VirtualMachine
+	)
+
+	BeforeEach(func() {
+		mockPerm = &MockPermissionChecker{permissions: make(map[string]bool)}
+		validator = &VirtualMachineCustomValidator{
+			FieldCheckers:     DefaultFieldCheckers(),
+			PermissionChecker: mockPerm,
+		}
+		ctx = admission.NewContextWithRequest(context.Background(), admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				UserInfo: authenticationv1.UserInfo{Username: "test-user"},
+			},
+		})
+		oldVM = &kubevirtiov1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "test-vm",
+				Namespace:   "default",
+				Annotations: map[string]string{FrozenAnnotation: "true"},
+			},
+			Spec: kubevirtiov1.VirtualMachineSpec{
+				Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{},
+			},
+		}
+		newVM = oldVM.DeepCopy()
+		newVM.Spec.Template.Spec.Volumes = []kubevirtiov1.Volume{{Name: "volume1"}}
+	})
+
+	It("should deny a storage-admin change to a frozen VM", func() {
+		mockPerm.permissions["virtualmachines/storage-admin"] = true
+
+		warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).To(MatchError(frozenMessage))
+		Expect(warnings).To(BeNil())
+	})
+
+	It("should allow a full-admin change to a frozen VM", func() {
+		mockPerm.permissions["virtualmachines/full-admin"] = true
+
+		warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(warnings).To(BeNil())
+	})
+
+	It("should deny users without any subresource permission", func() {
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).To(MatchError(frozenMessage))
+	})
+
+	It("should deny unfreezing the VM in the same update as a change", func() {
+		mockPerm.permissions["virtualmachines/storage-admin"] = true
+		delete(newVM.Annotations, FrozenAnnotation)
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).To(MatchError(frozenMessage))
+	})
+
+	It("should allow full-admin to unfreeze the VM", func() {
+		mockPerm.permissions["virtualmachines/full-admin"] = true
+		newVM = oldVM.DeepCopy()
+		delete(newVM.Annotations, FrozenAnnotation)
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should deny freezing the VM without full-admin", func() {
+		delete(oldVM.Annotations, FrozenAnnotation)
+		newVM = oldVM.DeepCopy()
+		newVM.Annotations = map[string]string{FrozenAnnotation: "true"}
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).To(MatchError("user does not have permission to modify the kubevirt-rbac-webhook/frozen annotation " +
+			"of VirtualMachine default/test-vm (requires virtualmachines/full-admin)"))
+	})
+
+	It("should allow no-op updates of a frozen VM", func() {
+		_, err := validator.ValidateUpdate(ctx, oldVM, oldVM.DeepCopy())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Context("with namespace freeze", func() {
+		var namespace *corev1.Namespace
+
+		BeforeEach(func() {
+			delete(oldVM.Annotations, FrozenAnnotation)
+			delete(newVM.Annotations, FrozenAnnotation)
+			mockPerm.permissions["virtualmachines/storage-admin"] = true
+			namespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+		})
+
+		JustBeforeEach(func() {
+			validator.NamespaceReader = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(namespace).Build()
+		})
+
+		It("should allow changes in a namespace that is not frozen", func() {
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when the namespace is frozen", func() {
+			BeforeEach(func() {
+				namespace.Annotations = map[string]string{FrozenAnnotation: "true"}
+			})
+
+			It("should deny a storage-admin change", func() {
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError("VirtualMachine default/test-vm is frozen for maintenance " +
+					"(kubevirt-rbac-webhook/frozen annotation on namespace default), only virtualmachines/full-admin may update it"))
+			})
+
+			It("should allow a full-admin change", func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = true
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,57 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"strings"
+
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+)
+
+// ImmutableFieldsChecker denies changes to fields declared immutable by policy, independent of
+// the role-based FieldPermissionCheckers: no granular role can change them, and full-admin only
+// when AllowFullAdmin is set. Users outside the opt-in model (no subresource permissions) are
+// denied too. Members of allow groups bypass it like every other check.
+type ImmutableFieldsChecker struct {
+	// Paths are dot-separated JSON field paths (e.g. spec.template.spec.domain.firmware.uuid);
+	// they cannot index into lists
+	Paths []string
+
+	// AllowFullAdmin lets users with virtualmachines/full-admin change the fields
+	AllowFullAdmin bool
+}
+
+// Check returns an error naming the immutable fields changed by the update, if any
+func (c *ImmutableFieldsChecker) Check(oldVM, newVM *kubevirtiov1.VirtualMachine, hasFullAdmin bool, vmRef string) error {
+	if c == nil || len(c.Paths) == 0 || (hasFullAdmin && c.AllowFullAdmin) {
+		return nil
+	}
+
+	changed, err := changedFieldPaths(oldVM, newVM, c.Paths)
+	if err != nil {
+		return err
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	if c.AllowFullAdmin {
+		return denyFullAdminf(ReasonCodeImmutableField, "fields %s of VirtualMachine %s are immutable by policy (only virtualmachines/full-admin may change them)",
+			strings.Join(changed, ", "), vmRef)
+	}
+	return denyf(ReasonCodeImmutableField, "fields %s of VirtualMachine %s are immutable by policy", strings.Join(changed, ", "), vmRef)
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const firmwareUUIDPath = "spec.template.spec.domain.firmware.uuid"
+
+var _ = Describe("Immutable fields", func() {
+	var (
+		oldVM *kubevirtiov1.VirtualMachine
+		newVM *kubevirtiov1.VirtualMachine
+	)
+
+	BeforeEach(func() {
+		oldVM = &kubevirtiov1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-vm", Namespace: "default"},
+			Spec: kubevirtiov1.VirtualMachineSpec{
+				Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+					Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+						Domain: kubevirtiov1.DomainSpec{
+							CPU:      &kubevirtiov1.CPU{Cores: 2},
+							Firmware: &kubevirtiov1.Firmware{UUID: types.UID("11111111-1111-1111-1111-111111111111")},
+						},
+					},
+				},
+			},
+		}
+		newVM = oldVM.DeepCopy()
+	})
+
+	Describe("ImmutableFieldsChecker", func() {
+		It("should deny a change to a configured path", func() {
+			checker := &ImmutableFieldsChecker{Paths: []string{firmwareUUIDPath}}
+			newVM.Spec.Template.Spec.Domain.Firmware.UUID = "22222222-2222-2222-2222-222222222222"
+
+			Expect(checker.Check(oldVM, newVM, false, "default/test-vm")).To(MatchError(
+				"fields spec.template.spec.domain.firmware.uuid of VirtualMachine default/test-vm are immutable by policy"))
+		})
+
+		It("should deny setting a configured path that was unset", func() {
+			checker := &ImmutableFieldsChecker{Paths: []string{firmwareUUIDPath}}
+			oldVM.Spec.Template.Spec.Domain.Firmware = nil
+
+			Expect(checker.Check(oldVM, newVM, false, "default/test-vm")).To(HaveOccurred())
+		})
+
+		It("should ignore changes to other paths", func() {
+			checker := &ImmutableFieldsChecker{Paths: []string{firmwareUUIDPath}}
+			newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+			Expect(checker.Check(oldVM, newVM, false, "default/test-vm")).To(Succeed())
+		})
+
+		It("should allow full-admin only with AllowFullAdmin", func() {
+			newVM.Spec.Template.Spec.Domain.Firmware.UUID = "22222222-2222-2222-2222-222222222222"
+
+			allowed := &ImmutableFieldsChecker{Paths: []string{firmwareUUIDPath}, AllowFullAdmin: true}
+			Expect(allowed.Check(oldVM, newVM, true, "default/test-vm")).To(Succeed())
+			Expect(allowed.Check(oldVM, newVM, false, "default/test-vm")).To(MatchError(
+				ContainSubstring("only virtualmachines/full-admin may change them")))
+
+			locked := &ImmutableFieldsChecker{Paths: []string{firmwareUUIDPath}}
+			Expect(locked.Check(oldVM, newVM, true, "default/test-vm")).To(HaveOccurred())
+		})
+
+		It("should do nothing when nil", func() {
+			var checker *ImmutableFieldsChecker
+			newVM.Spec.Template.Spec.Domain.Firmware.UUID = "22222222-2222-2222-2222-222222222222"
+
+			Expect(checker.Check(oldVM, newVM, false, "default/test-vm")).To(Succeed())
+		})
+	})
+
+	Describe("VirtualMachineCustomValidator", func() {
+		var (
+			validator *VirtualMachineCustomValidator
+			mockPerm  *MockPermissionChecker
+			ctx       context.Context
+		)
+
+		BeforeEach(func() {
+			mockPerm = &MockPermissionChecker{permissions: make(map[string]bool)}
+			validator = &VirtualMachineCustomValidator{
+				FieldCheckers:     DefaultFieldCheckers(),
+				PermissionChecker: mockPerm,
+				ImmutableFields:   &ImmutableFieldsChecker{Paths: []string{firmwareUUIDPath}, AllowFullAdmin: true},
+			}
+			ctx = admission.NewContextWithRequest(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: "test-user"},
+				},
+			})
+			newVM.Spec.Template.Spec.Domain.Firmware.UUID = "22222222-2222-2222-2222-222222222222"
+		})
+
+		It("should deny a granular user changing an immutable field", func() {
+			mockPerm.permissions["virtualmachines/compute-admin"] = true
+
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).To(MatchError(ContainSubstring("are immutable by policy")))
+		})
+
+		It("should deny a user without any subresource permission", func() {
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).To(MatchError(ContainSubstring("are immutable by policy")))
+		})
+
+		It("should allow a full-admin", func() {
+			mockPerm.permissions["virtualmachines/full-admin"] = true
+
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should deny a full-admin when full-admin is not allowed", func() {
+			validator.ImmutableFields.AllowFullAdmin = false
+			mockPerm.permissions["virtualmachines/full-admin"] = true
+
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).To(MatchError(ContainSubstring("are immutable by policy")))
+		})
+
+		It("should still allow other changes the user has permission for", func() {
+			mockPerm.permissions["virtualmachines/compute-admin"] = true
+			newVM = oldVM.DeepCopy()
+			newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,135 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+	instancetypeapi "kubevirt.io/api/instancetype"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// expandInstancetype returns copies of the VMs with the CPU and memory settings provided by the
+// old VM's instancetype filled in wherever a spec leaves them unset, so the checkers compare the
+// effective specs: overriding an instancetype-provided value inline is a compute change only if
+// it differs from the value the instancetype provides. Both VMs are expanded from the old
+// instancetype, so switching the instancetype does not show up as a compute change (see
+// InstancetypeRevisionPermissionChecker.IncludeInstancetypeChanges). The VMs are returned
+// unchanged when the old VM has no instancetype or it no longer exists.
+func (v *VirtualMachineCustomValidator) expandInstancetype(ctx context.Context, oldVM, newVM *kubevirtiov1.VirtualMachine) (*kubevirtiov1.VirtualMachine, *kubevirtiov1.VirtualMachine, error) {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return oldVM, newVM, nil
+	}
+
+	spec, err := v.getInstancetypeSpec(ctx, oldVM)
+	if err != nil || spec == nil {
+		return oldVM, newVM, err
+	}
+
+	oldVM, newVM = oldVM.DeepCopy(), newVM.DeepCopy()
+	applyInstancetype(&oldVM.Spec.Template.Spec.Domain, spec)
+	applyInstancetype(&newVM.Spec.Template.Spec.Domain, spec)
+	return oldVM, newVM, nil
+}
+
+// getInstancetypeSpec reads the instancetype the VM references, or returns nil if it references
+// none, it is not resolved yet (e.g. inferred from a volume) or it no longer exists
+func (v *VirtualMachineCustomValidator) getInstancetypeSpec(ctx context.Context, vm *kubevirtiov1.VirtualMachine) (*instancetypev1beta1.VirtualMachineInstancetypeSpec, error) {
+	matcher := vm.Spec.Instancetype
+	if matcher == nil || matcher.Name == "" {
+		return nil, nil
+	}
+
+	var (
+		object client.Object
+		spec   *instancetypev1beta1.VirtualMachineInstancetypeSpec
+		key    = client.ObjectKey{Name: matcher.Name}
+	)
+	switch strings.ToLower(matcher.Kind) {
+	case "", instancetypeapi.ClusterSingularResourceName:
+		instancetype := &instancetypev1beta1.VirtualMachineClusterInstancetype{}
+		object, spec = instancetype, &instancetype.Spec
+	case instancetypeapi.SingularResourceName:
+		instancetype := &instancetypev1beta1.VirtualMachineInstancetype{}
+		object, spec = instancetype, &instancetype.Spec
+		key.Namespace = vm.Namespace
+	default:
+		return nil, nil
+	}
+
+	if err := v.InstancetypeReader.Get(ctx, key, object); err != nil {
+		if apierrors.IsNotFound(err) {
+			virtualmachinelog.Info("Instancetype not found, comparing the specs as stored", "name", vm.GetName(),
+				"namespace", vm.GetNamespace(), "instancetype", matcher.Name, "kind", matcher.Kind)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get instancetype %s of VirtualMachine %s: %w",
+			matcher.Name, client.ObjectKeyFromObject(vm), err)
+	}
+	return spec, nil
+}
+
+// applyInstancetype fills the CPU and memory settings the instancetype provides into the domain
+// where it leaves them unset. The guest vCPUs become sockets, KubeVirt's default topology.
+func applyInstancetype(domain *kubevirtiov1.DomainSpec, spec *instancetypev1beta1.VirtualMachineInstancetypeSpec) {
+	if domain.CPU == nil {
+		domain.CPU = &kubevirtiov1.CPU{}
+	}
+	cpu := domain.CPU
+	if cpu.Sockets == 0 && cpu.Cores == 0 && cpu.Threads == 0 {
+		cpu.Sockets = spec.CPU.Guest
+	}
+	if cpu.Model == "" && spec.CPU.Model != nil {
+		cpu.Model = *spec.CPU.Model
+	}
+	if !cpu.DedicatedCPUPlacement && spec.CPU.DedicatedCPUPlacement != nil {
+		cpu.DedicatedCPUPlacement = *spec.CPU.DedicatedCPUPlacement
+	}
+	if !cpu.IsolateEmulatorThread && spec.CPU.IsolateEmulatorThread != nil {
+		cpu.IsolateEmulatorThread = *spec.CPU.IsolateEmulatorThread
+	}
+	if cpu.NUMA == nil && spec.CPU.NUMA != nil {
+		cpu.NUMA = spec.CPU.NUMA.DeepCopy()
+	}
+	if cpu.Realtime == nil && spec.CPU.Realtime != nil {
+		cpu.Realtime = spec.CPU.Realtime.DeepCopy()
+	}
+	if cpu.MaxSockets == 0 && spec.CPU.MaxSockets != nil {
+		cpu.MaxSockets = *spec.CPU.MaxSockets
+	}
+
+	if domain.Memory == nil {
+		domain.Memory = &kubevirtiov1.Memory{}
+	}
+	memory := domain.Memory
+	if memory.Guest == nil {
+		guest := spec.Memory.Guest.DeepCopy()
+		memory.Guest = &guest
+	}
+	if memory.Hugepages == nil && spec.Memory.Hugepages != nil {
+		memory.Hugepages = spec.Memory.Hugepages.DeepCopy()
+	}
+	if memory.MaxGuest == nil && spec.Memory.MaxGuest != nil {
+		maxGuest := spec.Memory.MaxGuest.DeepCopy()
+		memory.MaxGuest = &maxGuest
+	}
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("Instancetype-aware validation", func() {
+	const deniedMessage = "user does not have permission to modify one or more spec fields of VirtualMachine default/test-vm"
+
+	var (
+		validator     *VirtualMachineCustomValidator
+		mockPerm      *MockPermissionChecker
+		ctx           context.Context
+		oldVM         *kubevirtiov1.VirtualMachine
+		newVM         *kubevirtiov1.VirtualMachine
+		instancetypes []client.Object
+	)
+
+	BeforeEach(func() {
+		mockPerm = &MockPermissionChecker{permissions: make(map[string]bool)}
+		fieldCheckers := DefaultFieldCheckers()
+		for _, checker := range fieldCheckers {
+			if checker, ok := checker.(*InstancetypeRevisionPermissionChecker); ok {
+				checker.IncludeInstancetypeChanges = true
+			}
+		}
+		validator = &VirtualMachineCustomValidator{
+			FieldCheckers:     fieldCheckers,
+			PermissionChecker: mockPerm,
+		}
+		ctx = admission.NewContextWithRequest(context.Background(), admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				UserInfo: authenticationv1.UserInfo{Username: "test-user"},
+			},
+		})
+		instancetypes = []client.Object{
+			&instancetypev1beta1.VirtualMachineClusterInstancetype{
+				ObjectMeta: metav1.ObjectMeta{Name: "u1.medium"},
+				Spec: instancetypev1beta1.VirtualMachineInstancetypeSpec{
+					CPU:    instancetypev1beta1.CPUInstancetype{Guest: 2},
+					Memory: instancetypev1beta1.MemoryInstancetype{Guest: resource.MustParse("4Gi")},
+				},
+			},
+			&instancetypev1beta1.VirtualMachineClusterInstancetype{
+				ObjectMeta: metav1.ObjectMeta{Name: "u1.large"},
+				Spec: instancetypev1beta1.VirtualMachineInstancetypeSpec{
+					CPU:    instancetypev1beta1.CPUInstancetype{Guest: 4},
+					Memory: instancetypev1beta1.MemoryInstancetype{Guest: resource.MustParse("8Gi")},
+				},
+			},
+		}
+		oldVM = &kubevirtiov1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-vm", Namespace: "default"},
+			Spec: kubevirtiov1.VirtualMachineSpec{
+				Instancetype: &kubevirtiov1.InstancetypeMatcher{
+					Name: "u1.medium",
+					Kind: "virtualmachineclusterinstancetype",
+				},
+				Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{},
+			},
+		}
+		newVM = oldVM.DeepCopy()
+	})
+
+	JustBeforeEach(func() {
+		instancetypeScheme := runtime.NewScheme()
+		Expect(kubevirtiov1.AddToScheme(instancetypeScheme)).To(Succeed())
+		Expect(instancetypev1beta1.AddToScheme(instancetypeScheme)).To(Succeed())
+		validator.InstancetypeReader = fakeclient.NewClientBuilder().WithScheme(instancetypeScheme).
+			WithObjects(instancetypes...).Build()
+	})
+	Context("with an inline CPU override", func() {
+		BeforeEach(func() {
+			newVM.Spec.Template.Spec.Domain.CPU = &kubevirtiov1.CPU{Sockets: 4}
+		})
+
+		It("should deny a user without cpu-topology-admin", func() {
+			mockPerm.permissions["virtualmachines/compute-admin"] = true
+
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).To(MatchError(deniedMessage))
+		})
+
+		It("should allow a cpu-topology-admin", func() {
+			mockPerm.permissions["virtualmachines/cpu-topology-admin"] = true
+
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should treat restating the instancetype's vCPUs as no change", func() {
+			mockPerm.permissions["virtualmachines/storage-admin"] = true
+			newVM.Spec.Template.Spec.Domain.CPU.Sockets = 2
+
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mockPerm.calls).To(BeZero())
+		})
+
+		Context("when the instancetype no longer exists", func() {
+			BeforeEach(func() {
+				instancetypes = nil
+			})
+
+			It("should compare the specs as stored", func() {
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+				newVM.Spec.Template.Spec.Domain.CPU.Sockets = 2
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError(deniedMessage))
+			})
+		})
+
+		It("should leave the specs as stored without an instancetype reader", func() {
+			mockPerm.permissions["virtualmachines/storage-admin"] = true
+			newVM.Spec.Template.Spec.Domain.CPU.Sockets = 2
+			validator.FieldCheckers = DefaultFieldCheckers()
+
+			validator.InstancetypeReader = nil
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).To(MatchError(deniedMessage))
+		})
+	})
+
+	Context("when switching the instancetype", func() {
+		BeforeEach(func() {
+			newVM.Spec.Instancetype.Name = "u1.large"
+		})
+
+		It("should allow an instancetype-admin without compute-admin", func() {
+			mockPerm.permissions["virtualmachines/instancetype-admin"] = true
+
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should deny a compute-admin without instancetype-admin", func() {
+			mockPerm.permissions["virtualmachines/compute-admin"] = true
+
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).To(MatchError(deniedMessage))
+		})
+	})
+
+	Context("with a namespaced instancetype", func() {
+		BeforeEach(func() {
+			instancetypes = append(instancetypes, &instancetypev1beta1.VirtualMachineInstancetype{
+				ObjectMeta: metav1.ObjectMeta{Name: "small", Namespace: "default"},
+				Spec: instancetypev1beta1.VirtualMachineInstancetypeSpec{
+					CPU:    instancetypev1beta1.CPUInstancetype{Guest: 1},
+					Memory: instancetypev1beta1.MemoryInstancetype{Guest: resource.MustParse("2Gi")},
+				},
+			})
+			oldVM.Spec.Instancetype = &kubevirtiov1.InstancetypeMatcher{Name: "small", Kind: "VirtualMachineInstancetype"}
+			newVM = oldVM.DeepCopy()
+		})
+
+		It("should read it from the VM's namespace", func() {
+			mockPerm.permissions["virtualmachines/storage-admin"] = true
+			guest := resource.MustParse("2Gi")
+			newVM.Spec.Template.Spec.Domain.Memory = &kubevirtiov1.Memory{Guest: &guest}
+
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mockPerm.calls).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,171 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+)
+
+// verifyNeutralizationIsolation enables the isolation invariant check after neutralization.
+// It walks every object by reflection on each update, so it is only enabled in tests and in
+// debug builds (-tags rbacdebug).
+var verifyNeutralizationIsolation = false
+
+// checkNeutralizationIsolation verifies that the neutralized copies share no memory with each
+// other or with the admission objects, and contain no reference cycles. A checker that assigns
+// a slice, map or pointer of one VM to another would otherwise let later neutralizations of one
+// copy silently change the other, hiding or inventing a diff.
+func checkNeutralizationIsolation(oldCopy, newCopy, oldVM, newVM *kubevirtiov1.VirtualMachine) error {
+	objects := []struct {
+		name string
+		vm   *kubevirtiov1.VirtualMachine
+	}{
+		{"old copy", oldCopy},
+		{"new copy", newCopy},
+		{"old object", oldVM},
+		{"new object", newVM},
+	}
+
+	var violations []string
+	for i, copied := range objects[:2] {
+		for _, other := range objects[i+1:] {
+			for _, path := range sharedReferences(copied.vm, other.vm) {
+				violations = append(violations, fmt.Sprintf("%s shares %s with the %s", copied.name, path, other.name))
+			}
+		}
+		for _, path := range referenceCycles(copied.vm) {
+			violations = append(violations, fmt.Sprintf("%s has a reference cycle at %s", copied.name, path))
+		}
+	}
+	if len(violations) > 0 {
+		return fmt.Errorf("neutralization isolation violated: %s", strings.Join(violations, "; "))
+	}
+	return nil
+}
+
+// sharedReferences returns the paths in b of memory also reachable from a: pointer targets,
+// maps and slice elements. Strings are immutable and never reported.
+func sharedReferences(a, b any) []string {
+	addresses := make(map[uintptr]string)
+	walkReferences(reflect.ValueOf(a), "", func(address uintptr, path string) {
+		if _, seen := addresses[address]; !seen {
+			addresses[address] = path
+		}
+	}, nil)
+
+	var shared []string
+	walkReferences(reflect.ValueOf(b), "", func(address uintptr, path string) {
+		if _, found := addresses[address]; found {
+			shared = append(shared, path)
+		}
+	}, nil)
+	return shared
+}
+
+// referenceCycles returns the paths at which a pointer leads back to one of its ancestors
+func referenceCycles(a any) []string {
+	var cycles []string
+	walkReferences(reflect.ValueOf(a), "", func(uintptr, string) {}, func(path string) {
+		cycles = append(cycles, path)
+	})
+	return cycles
+}
+
+// walkReferences calls visit with the address and path of every pointer target, map and slice
+// element reachable from v, descending into each of them once. onCycle, if set, is called for
+// pointers back to an ancestor.
+func walkReferences(v reflect.Value, path string, visit func(address uintptr, path string), onCycle func(path string)) {
+	walker := &referenceWalker{
+		visit:     visit,
+		onCycle:   onCycle,
+		visited:   make(map[uintptr]bool),
+		ancestors: make(map[uintptr]bool),
+	}
+	walker.walk(v, path)
+}
+
+type referenceWalker struct {
+	visit     func(address uintptr, path string)
+	onCycle   func(path string)
+	visited   map[uintptr]bool
+	ancestors map[uintptr]bool
+}
+
+func (w *referenceWalker) walk(v reflect.Value, path string) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		// Zero-sized values may share an address without sharing memory
+		if v.IsNil() || v.Type().Elem().Size() == 0 {
+			return
+		}
+		w.descend(v.Pointer(), path, func() { w.walk(v.Elem(), path) })
+	case reflect.Map:
+		if v.IsNil() {
+			return
+		}
+		w.descend(v.Pointer(), path, func() {
+			iter := v.MapRange()
+			for iter.Next() {
+				w.walk(iter.Value(), fmt.Sprintf("%s[%v]", path, iter.Key()))
+			}
+		})
+	case reflect.Slice:
+		if v.Type().Elem().Size() == 0 {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			element := v.Index(i)
+			elementPath := fmt.Sprintf("%s[%d]", path, i)
+			w.descend(element.UnsafeAddr(), elementPath, func() { w.walk(element, elementPath) })
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			w.walk(v.Field(i), path+"."+v.Type().Field(i).Name)
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			w.walk(v.Index(i), fmt.Sprintf("%s[%d]", path, i))
+		}
+	case reflect.Interface:
+		if !v.IsNil() {
+			w.walk(v.Elem(), path)
+		}
+	}
+}
+
+// descend reports the address and walks into it, unless it was already walked
+func (w *referenceWalker) descend(address uintptr, path string, walkInto func()) {
+	if w.ancestors[address] {
+		if w.onCycle != nil {
+			w.onCycle(path)
+		}
+		return
+	}
+	w.visit(address, path)
+	if w.visited[address] {
+		return
+	}
+	w.visited[address] = true
+
+	w.ancestors[address] = true
+	walkInto()
+	delete(w.ancestors, address)
+}
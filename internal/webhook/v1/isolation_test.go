@@ -0,0 +1,176 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Every test validator checks the isolation invariant
+func init() {
+	verifyNeutralizationIsolation = true
+}
+
+// aliasingPermissionChecker is a buggy checker whose Neutralize makes the new VM share the
+// old VM's volumes instead of clearing them
+type aliasingPermissionChecker struct {
+	StoragePermissionChecker
+}
+
+func (a *aliasingPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	newVM.Spec.Template.Spec.Volumes = oldVM.Spec.Template.Spec.Volumes
+}
+
+// cyclicNode is a self-referential type for exercising cycle detection
+type cyclicNode struct {
+	Next *cyclicNode
+}
+
+var _ = Describe("Neutralization isolation", func() {
+	var oldVM *kubevirtiov1.VirtualMachine
+
+	BeforeEach(func() {
+		guest := resource.MustParse("2Gi")
+		oldVM = &kubevirtiov1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "test-vm",
+				Namespace:   "default",
+				Labels:      map[string]string{"app": "test"},
+				Annotations: map[string]string{"example.com/owner": "team-a"},
+			},
+			Spec: kubevirtiov1.VirtualMachineSpec{
+				RunStrategy: strategyPtr("Always"),
+				Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "test"}},
+					Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+						Domain: kubevirtiov1.DomainSpec{
+							CPU:    &kubevirtiov1.CPU{Cores: 2, Features: []kubevirtiov1.CPUFeature{{Name: "pcid"}}},
+							Memory: &kubevirtiov1.Memory{Guest: &guest},
+							Devices: kubevirtiov1.Devices{
+								Disks:      []kubevirtiov1.Disk{{Name: "rootdisk"}, {Name: "cloudinit"}},
+								Interfaces: []kubevirtiov1.Interface{{Name: "default", MacAddress: "02:00:00:00:00:01"}},
+								GPUs:       []kubevirtiov1.GPU{{Name: "gpu1", DeviceName: "nvidia.com/gpu"}},
+							},
+						},
+						Networks: []kubevirtiov1.Network{{Name: "default", NetworkSource: kubevirtiov1.NetworkSource{Pod: &kubevirtiov1.PodNetwork{}}}},
+						Volumes: []kubevirtiov1.Volume{
+							{Name: "rootdisk", VolumeSource: kubevirtiov1.VolumeSource{DataVolume: &kubevirtiov1.DataVolumeSource{Name: "root"}}},
+							{Name: "cloudinit", VolumeSource: kubevirtiov1.VolumeSource{CloudInitNoCloud: &kubevirtiov1.CloudInitNoCloudSource{UserData: "#cloud-config"}}},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("sharedReferences", func() {
+		It("should find nothing shared between deep copies", func() {
+			Expect(sharedReferences(oldVM, oldVM.DeepCopy())).To(BeEmpty())
+		})
+
+		It("should find a shared slice", func() {
+			newVM := oldVM.DeepCopy()
+			newVM.Spec.Template.Spec.Volumes = oldVM.Spec.Template.Spec.Volumes
+
+			Expect(sharedReferences(oldVM, newVM)).To(ContainElement(".Spec.Template.Spec.Volumes[0]"))
+		})
+
+		It("should find a slice sharing the backing array of a longer one", func() {
+			newVM := oldVM.DeepCopy()
+			newVM.Spec.Template.Spec.Domain.Devices.Disks = oldVM.Spec.Template.Spec.Domain.Devices.Disks[1:]
+
+			Expect(sharedReferences(oldVM, newVM)).To(ContainElement(".Spec.Template.Spec.Domain.Devices.Disks[0]"))
+		})
+
+		It("should find a shared map", func() {
+			newVM := oldVM.DeepCopy()
+			newVM.Labels = oldVM.Labels
+
+			Expect(sharedReferences(oldVM, newVM)).To(ContainElement(".ObjectMeta.Labels"))
+		})
+
+		It("should find a shared pointer", func() {
+			newVM := oldVM.DeepCopy()
+			newVM.Spec.Template.Spec.Domain.CPU = oldVM.Spec.Template.Spec.Domain.CPU
+
+			Expect(sharedReferences(oldVM, newVM)).To(ContainElement(".Spec.Template.Spec.Domain.CPU"))
+		})
+	})
+
+	Describe("referenceCycles", func() {
+		It("should find a pointer back to an ancestor", func() {
+			node := &cyclicNode{Next: &cyclicNode{}}
+			node.Next.Next = node
+
+			Expect(referenceCycles(node)).To(Equal([]string{".Next.Next"}))
+		})
+
+		It("should find no cycle in a VM", func() {
+			Expect(referenceCycles(oldVM)).To(BeEmpty())
+		})
+	})
+
+	It("should keep the copies isolated after every default checker neutralized them", func() {
+		newVM := oldVM.DeepCopy()
+		newVM.Labels["app"] = "changed"
+		newVM.Spec.RunStrategy = strategyPtr("Halted")
+		newVM.Spec.Template.ObjectMeta.Labels["app"] = "changed"
+		newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+		newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Serial = "serial"
+		newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress = "02:00:00:00:00:02"
+		newVM.Spec.Template.Spec.Domain.Devices.GPUs = nil
+		newVM.Spec.Template.Spec.Volumes[0].DataVolume.Name = "other-root"
+
+		oldCopy := oldVM.DeepCopy()
+		newCopy := newVM.DeepCopy()
+		for _, checker := range DefaultFieldCheckers() {
+			if checker.HasChanged(oldCopy, newCopy) {
+				checker.Neutralize(oldCopy, newCopy)
+			}
+		}
+
+		Expect(checkNeutralizationIsolation(oldCopy, newCopy, oldVM, newVM)).To(Succeed())
+	})
+
+	It("should deny an update when a checker aliases the copies", func() {
+		mockPerm := &MockPermissionChecker{permissions: map[string]bool{"virtualmachines/storage-admin": true}}
+		validator := &VirtualMachineCustomValidator{
+			FieldCheckers:     []FieldPermissionChecker{&aliasingPermissionChecker{}},
+			PermissionChecker: mockPerm,
+		}
+		ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				UserInfo: authenticationv1.UserInfo{Username: "test-user"},
+			},
+		})
+		newVM := oldVM.DeepCopy()
+		newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "data"})
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).To(MatchError(ContainSubstring("old copy shares .Spec.Template.Spec.Volumes[0] with the new copy")))
+	})
+})
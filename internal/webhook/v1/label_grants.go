@@ -0,0 +1,107 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// LabelGrantsKey is the ConfigMap data key holding the label grants
+const LabelGrantsKey = "grants.yaml"
+
+// LabelGrant grants subresources to members of Groups on every VM whose labels match Selector,
+// in addition to what RBAC grants through SubjectAccessReviews. RBAC can only select VMs by
+// name, so this lets teams express grants such as "group X may change compute on tier=dev VMs".
+type LabelGrant struct {
+	// Selector selects the VMs the grant applies to (must not be empty)
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// Groups lists the groups whose members receive the grant
+	Groups []string `json:"groups"`
+
+	// Subresources lists the granted subresources, e.g. virtualmachines/compute-admin
+	Subresources []string `json:"subresources"`
+}
+
+// ParseLabelGrants parses and validates a YAML (or JSON) list of label grants
+func ParseLabelGrants(data []byte) ([]LabelGrant, error) {
+	var grants []LabelGrant
+	if err := yaml.UnmarshalStrict(data, &grants); err != nil {
+		return nil, fmt.Errorf("failed to parse label grants: %w", err)
+	}
+
+	for i := range grants {
+		grant := &grants[i]
+		if len(grant.Selector.MatchLabels) == 0 && len(grant.Selector.MatchExpressions) == 0 {
+			// An empty selector matches every VM, which is almost certainly a mistake
+			return nil, fmt.Errorf("label grant %d: selector must not be empty", i)
+		}
+		if len(grant.Groups) == 0 {
+			return nil, fmt.Errorf("label grant %d: groups must not be empty", i)
+		}
+		if len(grant.Subresources) == 0 {
+			return nil, fmt.Errorf("label grant %d: subresources must not be empty", i)
+		}
+
+		if _, err := metav1.LabelSelectorAsSelector(&grant.Selector); err != nil {
+			return nil, fmt.Errorf("label grant %d: invalid selector: %w", i, err)
+		}
+	}
+	return grants, nil
+}
+
+// LoadLabelGrants reads and parses the label grants stored in a ConfigMap
+func LoadLabelGrants(ctx context.Context, reader client.Reader, key types.NamespacedName) ([]LabelGrant, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := reader.Get(ctx, key, configMap); err != nil {
+		return nil, fmt.Errorf("failed to get label grants ConfigMap %s: %w", key, err)
+	}
+
+	data, ok := configMap.Data[LabelGrantsKey]
+	if !ok {
+		return nil, fmt.Errorf("label grants ConfigMap %s has no %q key", key, LabelGrantsKey)
+	}
+	return ParseLabelGrants([]byte(data))
+}
+
+// grantedByLabels reports whether any grant gives the user the subresource on a VM with these labels
+func grantedByLabels(grants []LabelGrant, userInfo authenticationv1.UserInfo, vmLabels map[string]string, subresource string) bool {
+	for _, grant := range grants {
+		if !slices.Contains(grant.Subresources, subresource) {
+			continue
+		}
+		if _, found := findGroup(userInfo.Groups, grant.Groups); !found {
+			continue
+		}
+		// Invalid selectors are rejected when parsing, skip them if set up in code
+		selector, err := metav1.LabelSelectorAsSelector(&grant.Selector)
+		if err == nil && !selector.Empty() && selector.Matches(labels.Set(vmLabels)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const devComputeGrants = `
+- selector:
+    matchLabels:
+      tier: dev
+  groups: ["team-x"]
+  subresources: ["virtualmachines/compute-admin"]
+`
+
+var _ = Describe("Label grants", func() {
+	Describe("ParseLabelGrants", func() {
+		It("should parse grants with label selectors", func() {
+			grants, err := ParseLabelGrants([]byte(devComputeGrants))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(grants).To(Equal([]LabelGrant{{
+				Selector:     metav1.LabelSelector{MatchLabels: map[string]string{"tier": "dev"}},
+				Groups:       []string{"team-x"},
+				Subresources: []string{"virtualmachines/compute-admin"},
+			}}))
+		})
+
+		It("should reject grants with an empty selector", func() {
+			_, err := ParseLabelGrants([]byte(`[{"groups": ["team-x"], "subresources": ["virtualmachines/compute-admin"]}]`))
+			Expect(err).To(MatchError(ContainSubstring("selector must not be empty")))
+		})
+
+		It("should reject grants without groups", func() {
+			_, err := ParseLabelGrants([]byte(`[{"selector": {"matchLabels": {"tier": "dev"}}, "subresources": ["virtualmachines/compute-admin"]}]`))
+			Expect(err).To(MatchError(ContainSubstring("groups must not be empty")))
+		})
+
+		It("should reject invalid selectors", func() {
+			_, err := ParseLabelGrants([]byte(`[{"selector": {"matchExpressions": [{"key": "tier", "operator": "Near"}]}, ` +
+				`"groups": ["team-x"], "subresources": ["virtualmachines/compute-admin"]}]`))
+			Expect(err).To(MatchError(ContainSubstring("invalid selector")))
+		})
+
+		It("should reject unknown fields", func() {
+			_, err := ParseLabelGrants([]byte(`[{"selectr": {"matchLabels": {"tier": "dev"}}}]`))
+			Expect(err).To(MatchError(ContainSubstring("failed to parse label grants")))
+		})
+	})
+
+	Describe("LoadLabelGrants", func() {
+		key := types.NamespacedName{Namespace: "kubevirt-rbac-webhook-system", Name: "label-grants"}
+
+		It("should load grants from the ConfigMap", func() {
+			reader := fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+				Data:       map[string]string{LabelGrantsKey: devComputeGrants},
+			}).Build()
+
+			grants, err := LoadLabelGrants(context.Background(), reader, key)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(grants).To(HaveLen(1))
+		})
+
+		It("should fail when the ConfigMap is missing", func() {
+			reader := fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+
+			_, err := LoadLabelGrants(context.Background(), reader, key)
+			Expect(err).To(MatchError(ContainSubstring("failed to get label grants ConfigMap")))
+		})
+
+		It("should fail when the ConfigMap has no grants key", func() {
+			reader := fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+			}).Build()
+
+			_, err := LoadLabelGrants(context.Background(), reader, key)
+			Expect(err).To(MatchError(ContainSubstring(`has no "grants.yaml" key`)))
+		})
+	})
+
+	Describe("validating with label grants", func() {
+		var (
+			validator *VirtualMachineCustomValidator
+			mockPerm  *MockPermissionChecker
+			ctx       context.Context
+			oldVM     *kubevirtiov1.VirtualMachine
+			newVM     *kubevirtiov1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			grants, err := ParseLabelGrants([]byte(devComputeGrants))
+			Expect(err).ToNot(HaveOccurred())
+
+			mockPerm = &MockPermissionChecker{permissions: map[string]bool{
+				"virtualmachines/storage-admin": true,
+			}}
+			validator = &VirtualMachineCustomValidator{
+				FieldCheckers:     DefaultFieldCheckers(),
+				PermissionChecker: mockPerm,
+				LabelGrants:       grants,
+			}
+
+			ctx = admission.NewContextWithRequest(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{
+						Username: "test-user",
+						Groups:   []string{"team-x"},
+					},
+				},
+			})
+
+			oldVM = &kubevirtiov1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-vm",
+					Namespace: "default",
+					Labels:    map[string]string{"tier": "dev"},
+				},
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								CPU: &kubevirtiov1.CPU{Cores: 2},
+							},
+						},
+					},
+				},
+			}
+			newVM = oldVM.DeepCopy()
+			newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+		})
+
+		It("should allow compute changes on VMs matching the grant", func() {
+			warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(BeNil())
+		})
+
+		It("should deny compute changes on VMs not matching the grant", func() {
+			oldVM.Labels = map[string]string{"tier": "prod"}
+			newVM.Labels = map[string]string{"tier": "prod"}
+
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("does not have permission"))
+		})
+
+		It("should deny users outside the granted groups", func() {
+			ctx = admission.NewContextWithRequest(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{
+						Username: "other-user",
+						Groups:   []string{"team-y"},
+					},
+				},
+			})
+
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("does not have permission"))
+		})
+
+		It("should match the stored labels, not the submitted ones", func() {
+			oldVM.Labels = map[string]string{"tier": "prod"}
+
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should not grant subresources outside the grant", func() {
+			newVM.Spec.Template.Spec.Domain.Devices.Rng = &kubevirtiov1.Rng{}
+
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("does not have permission"))
+		})
+	})
+})
@@ -0,0 +1,145 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+)
+
+// ParseLabelSubresources parses prefix=subresource entries (e.g.
+// topology.kubernetes.io/=virtualmachines/scheduling-admin) into a map from label key prefix
+// to the subresource required to change labels with that prefix
+func ParseLabelSubresources(entries []string) (map[string]string, error) {
+	labelSubresources := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		prefix, subresource, found := strings.Cut(entry, "=")
+		prefix, subresource = strings.TrimSpace(prefix), strings.TrimSpace(subresource)
+		if !found || prefix == "" || subresource == "" {
+			return nil, fmt.Errorf("invalid label subresource %q, must be <label key prefix>=<subresource>", entry)
+		}
+		if !strings.HasPrefix(subresource, "virtualmachines/") {
+			return nil, fmt.Errorf("invalid label subresource %q, the subresource must start with virtualmachines/", entry)
+		}
+		if _, duplicate := labelSubresources[prefix]; duplicate {
+			return nil, fmt.Errorf("duplicate label subresource prefix %q", prefix)
+		}
+		labelSubresources[prefix] = subresource
+	}
+	return labelSubresources, nil
+}
+
+// labelSubresource returns the subresource required to change the label key, from the longest
+// LabelSubresources prefix it starts with
+func (v *VirtualMachineCustomValidator) labelSubresource(key string) (string, bool) {
+	var longest string
+	for prefix := range v.LabelSubresources {
+		if strings.HasPrefix(key, prefix) && len(prefix) > len(longest) {
+			longest = prefix
+		}
+	}
+	if longest == "" {
+		return "", false
+	}
+	return v.LabelSubresources[longest], true
+}
+
+// labelSubresourceTargets returns the distinct subresources of LabelSubresources, sorted
+func (v *VirtualMachineCustomValidator) labelSubresourceTargets() []string {
+	subresources := slices.Sorted(maps.Values(v.LabelSubresources))
+	return slices.Compact(subresources)
+}
+
+// checkLabelSubresources requires the mapped subresource for every label with a LabelSubresources
+// prefix that the update adds, removes or modifies, on the VM or its template, instead of the role
+// covering the rest of the metadata (e.g. template-metadata-admin). Permitted label changes are
+// neutralized on the copies, so the metadata comparison and the checkers no longer see them.
+func (v *VirtualMachineCustomValidator) checkLabelSubresources(oldCopy, newCopy *kubevirtiov1.VirtualMachine, vmRef string, checkPermission func(subresource string) (bool, error)) error {
+	if len(v.LabelSubresources) == 0 {
+		return nil
+	}
+
+	labelSets := [][2]*map[string]string{{&oldCopy.Labels, &newCopy.Labels}}
+	if oldCopy.Spec.Template != nil && newCopy.Spec.Template != nil {
+		labelSets = append(labelSets, [2]*map[string]string{&oldCopy.Spec.Template.ObjectMeta.Labels, &newCopy.Spec.Template.ObjectMeta.Labels})
+	}
+
+	var deniedKeys, missing []string
+	for _, labels := range labelSets {
+		oldLabels, newLabels := labels[0], labels[1]
+		for _, key := range changedKeys(*oldLabels, *newLabels) {
+			subresource, mapped := v.labelSubresource(key)
+			if !mapped {
+				continue
+			}
+			granted, err := checkPermission(subresource)
+			if err != nil {
+				return fmt.Errorf("failed to check %s permission: %w", subresource, err)
+			}
+			if !granted {
+				deniedKeys = append(deniedKeys, key)
+				missing = append(missing, subresource)
+				continue
+			}
+			neutralizeLabel(oldLabels, *newLabels, key)
+		}
+	}
+
+	if len(deniedKeys) > 0 {
+		slices.Sort(deniedKeys)
+		slices.Sort(missing)
+		missing = slices.Compact(missing)
+		return denyNeedingf(ReasonCodeLabelForbidden, missing, "user does not have permission to modify labels %s of VirtualMachine %s (requires %s)",
+			strings.Join(slices.Compact(deniedKeys), ", "), vmRef, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// changedKeys returns the sorted keys added, removed or modified between the two maps
+func changedKeys(oldMap, newMap map[string]string) []string {
+	var changed []string
+	for key, oldValue := range oldMap {
+		if newValue, ok := newMap[key]; !ok || newValue != oldValue {
+			changed = append(changed, key)
+		}
+	}
+	for key := range newMap {
+		if _, ok := oldMap[key]; !ok {
+			changed = append(changed, key)
+		}
+	}
+	slices.Sort(changed)
+	return changed
+}
+
+// neutralizeLabel sets the label key of the old labels to its value in the new labels, or
+// removes it if the new labels do not have it
+func neutralizeLabel(oldLabels *map[string]string, newLabels map[string]string, key string) {
+	value, ok := newLabels[key]
+	if !ok {
+		delete(*oldLabels, key)
+		return
+	}
+	if *oldLabels == nil {
+		*oldLabels = make(map[string]string)
+	}
+	(*oldLabels)[key] = value
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("Label subresources", func() {
+	const topologyLabel = "topology.kubernetes.io/zone"
+
+	var (
+		validator *VirtualMachineCustomValidator
+		mockPerm  *MockPermissionChecker
+		ctx       context.Context
+		oldVM     *kubevirtiov1.VirtualMachine
+		newVM     *kubevirtiov1.VirtualMachine
+	)
+
+	BeforeEach(func() {
+		mockPerm = &MockPermissionChecker{permissions: make(map[string]bool)}
+		validator = &VirtualMachineCustomValidator{
+			FieldCheckers:     DefaultFieldCheckers(),
+			PermissionChecker: mockPerm,
+			LabelSubresources: map[string]string{
+				"topology.kubernetes.io/": "virtualmachines/scheduling-admin",
+			},
+		}
+		ctx = admission.NewContextWithRequest(context.Background(), admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				UserInfo: authenticationv1.UserInfo{Username: "test-user"},
+			},
+		})
+		oldVM = &kubevirtiov1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-vm", Namespace: "default"},
+			Spec: kubevirtiov1.VirtualMachineSpec{
+				Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{topologyLabel: "zone-a", "team/name": "a"},
+					},
+				},
+			},
+		}
+		newVM = oldVM.DeepCopy()
+	})
+
+	Context("with template-metadata-admin", func() {
+		BeforeEach(func() {
+			mockPerm.permissions["virtualmachines/template-metadata-admin"] = true
+		})
+
+		It("should allow changing a team/ template label", func() {
+			newVM.Spec.Template.ObjectMeta.Labels["team/name"] = "b"
+
+			warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(BeNil())
+		})
+
+		It("should deny changing a topology.kubernetes.io/ template label without scheduling-admin", func() {
+			newVM.Spec.Template.ObjectMeta.Labels[topologyLabel] = "zone-b"
+
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).To(MatchError("user does not have permission to modify labels topology.kubernetes.io/zone " +
+				"of VirtualMachine default/test-vm (requires virtualmachines/scheduling-admin)"))
+			Expect(ReasonCodeOf(err)).To(Equal(ReasonCodeLabelForbidden))
+		})
+
+		It("should deny removing a topology.kubernetes.io/ template label without scheduling-admin", func() {
+			delete(newVM.Spec.Template.ObjectMeta.Labels, topologyLabel)
+
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).To(MatchError(ContainSubstring("requires virtualmachines/scheduling-admin")))
+		})
+
+		It("should allow changing both labels with scheduling-admin", func() {
+			mockPerm.permissions["virtualmachines/scheduling-admin"] = true
+			newVM.Spec.Template.ObjectMeta.Labels[topologyLabel] = "zone-b"
+			newVM.Spec.Template.ObjectMeta.Labels["team/name"] = "b"
+
+			warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(BeNil())
+		})
+	})
+
+	Context("with scheduling-admin only", func() {
+		BeforeEach(func() {
+			mockPerm.permissions["virtualmachines/scheduling-admin"] = true
+		})
+
+		It("should allow changing a topology.kubernetes.io/ template label", func() {
+			newVM.Spec.Template.ObjectMeta.Labels[topologyLabel] = "zone-b"
+
+			warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(BeNil())
+		})
+
+		It("should allow adding a topology.kubernetes.io/ label to the VM", func() {
+			newVM.Labels = map[string]string{topologyLabel: "zone-b"}
+
+			warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(BeNil())
+		})
+
+		It("should deny changing a team/ template label", func() {
+			newVM.Spec.Template.ObjectMeta.Labels["team/name"] = "b"
+
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).To(HaveOccurred())
+			Expect(ReasonCodeOf(err)).To(Equal(ReasonCodeTemplateMetadataForbidden))
+		})
+
+		It("should deny changing a team/ label of the VM", func() {
+			newVM.Labels = map[string]string{"team/name": "b"}
+
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).To(MatchError("user does not have permission to modify VirtualMachine default/test-vm metadata"))
+		})
+
+		It("should deny changing fields outside the label subresources", func() {
+			newVM.Spec.Template.Spec.Domain.CPU = &kubevirtiov1.CPU{Cores: 4}
+
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).To(HaveOccurred())
+			Expect(ReasonCodeOf(err)).To(Equal(CategoryReasonCode("compute")))
+		})
+
+		It("should opt the user in, so strict mode allows changing the mapped labels", func() {
+			validator.StrictMode = true
+			newVM.Spec.Template.ObjectMeta.Labels[topologyLabel] = "zone-b"
+
+			_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	It("should not apply to users without granular roles", func() {
+		newVM.Spec.Template.ObjectMeta.Labels[topologyLabel] = "zone-b"
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should not apply to full-admin", func() {
+		mockPerm.permissions["virtualmachines/full-admin"] = true
+		newVM.Spec.Template.ObjectMeta.Labels[topologyLabel] = "zone-b"
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should apply the subresource of the longest matching prefix", func() {
+		validator.LabelSubresources["topology.kubernetes.io/zone"] = "virtualmachines/zone-admin"
+
+		subresource, mapped := validator.labelSubresource(topologyLabel)
+		Expect(mapped).To(BeTrue())
+		Expect(subresource).To(Equal("virtualmachines/zone-admin"))
+
+		subresource, mapped = validator.labelSubresource("topology.kubernetes.io/region")
+		Expect(mapped).To(BeTrue())
+		Expect(subresource).To(Equal("virtualmachines/scheduling-admin"))
+
+		_, mapped = validator.labelSubresource("team/name")
+		Expect(mapped).To(BeFalse())
+	})
+
+	Context("ParseLabelSubresources", func() {
+		It("should parse prefix=subresource entries", func() {
+			labelSubresources, err := ParseLabelSubresources([]string{
+				"topology.kubernetes.io/=virtualmachines/scheduling-admin",
+				"node-role.kubernetes.io/ = virtualmachines/full-admin",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(labelSubresources).To(Equal(map[string]string{
+				"topology.kubernetes.io/":  "virtualmachines/scheduling-admin",
+				"node-role.kubernetes.io/": "virtualmachines/full-admin",
+			}))
+		})
+
+		It("should reject an entry without a subresource", func() {
+			_, err := ParseLabelSubresources([]string{"topology.kubernetes.io/"})
+			Expect(err).To(MatchError(`invalid label subresource "topology.kubernetes.io/", must be <label key prefix>=<subresource>`))
+		})
+
+		It("should reject a subresource of another resource", func() {
+			_, err := ParseLabelSubresources([]string{"topology.kubernetes.io/=scheduling-admin"})
+			Expect(err).To(MatchError(ContainSubstring("the subresource must start with virtualmachines/")))
+		})
+
+		It("should reject a duplicate prefix", func() {
+			_, err := ParseLabelSubresources([]string{
+				"topology.kubernetes.io/=virtualmachines/scheduling-admin",
+				"topology.kubernetes.io/=virtualmachines/full-admin",
+			})
+			Expect(err).To(MatchError(`duplicate label subresource prefix "topology.kubernetes.io/"`))
+		})
+	})
+})
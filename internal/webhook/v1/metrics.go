@@ -0,0 +1,67 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Values of the decision label of the decisions metric
+const (
+	MetricDecisionAllowed = "allowed"
+	MetricDecisionDenied  = "denied"
+
+	// MetricDecisionAllowedNoGranularRoles counts updates allowed only because the user holds no
+	// granular subresource role (the backwards-compatible path), so granular RBAC did not apply
+	MetricDecisionAllowedNoGranularRoles = "allowed-no-granular-roles"
+)
+
+// decisionsTotal counts the admission decisions of the webhook, served on the manager's
+// metrics endpoint
+var decisionsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "kubevirt_rbac_webhook_decisions_total",
+	Help: "Number of admission decisions by decision: allowed, denied, or allowed-no-granular-roles " +
+		"for updates allowed because the user holds no granular subresource role.",
+}, []string{"decision"})
+
+// denialsTotal counts the denials of the webhook by reason code
+var denialsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "kubevirt_rbac_webhook_denials_total",
+	Help: "Number of denied admission requests by reason code, e.g. COMPUTE_FORBIDDEN or FROZEN.",
+}, []string{"reason_code"})
+
+func init() {
+	metrics.Registry.MustRegister(decisionsTotal, denialsTotal)
+}
+
+// recordDecisionMetric counts the decision of an admission request
+func recordDecisionMetric(allowed, noGranularRoles bool) {
+	decision := MetricDecisionDenied
+	switch {
+	case allowed && noGranularRoles:
+		decision = MetricDecisionAllowedNoGranularRoles
+	case allowed:
+		decision = MetricDecisionAllowed
+	}
+	decisionsTotal.WithLabelValues(decision).Inc()
+}
+
+// recordDenialMetric counts a denial with its reason code
+func recordDenialMetric(reasonCode ReasonCode) {
+	denialsTotal.WithLabelValues(string(reasonCode)).Inc()
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+)
+
+// configuredFieldCheckers returns every checker twice: as registered by default and with
+// every option enabled, since the options change what Neutralize clears
+func configuredFieldCheckers() []FieldPermissionChecker {
+	checkers := DefaultFieldCheckers()
+	for _, checker := range DefaultFieldCheckers() {
+		switch checker := checker.(type) {
+		case *ComputePermissionChecker:
+			checker.RequireLiveAdminWhenRunning = true
+		case *DevicesPermissionChecker:
+			checker.RequireInputAdminForTypeChanges = true
+			checker.RequireFullAdminForRemovals = true
+		case *GPUPermissionChecker:
+			checker.RequireFullAdminForRemovals = true
+		case *FilesystemPermissionChecker:
+			checker.RequireRemovalAdmin = true
+		case *FilesystemUserPermissionChecker:
+			checker.RequireRemovalAdmin = true
+		case *InstancetypeRevisionPermissionChecker:
+			checker.IncludeInstancetypeChanges = true
+		default:
+			continue
+		}
+		checkers = append(checkers, checker)
+	}
+	return checkers
+}
+
+// neutralizeIdempotenceVM returns a VM touching every category, so a change of it reaches
+// the fields of every checker
+func neutralizeIdempotenceVM() *kubevirtiov1.VirtualMachine {
+	guest := resource.MustParse("2Gi")
+	return &kubevirtiov1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-vm",
+			Namespace:   "default",
+			Labels:      map[string]string{"app": "test"},
+			Annotations: map[string]string{"example.com/owner": "team-a"},
+		},
+		Spec: kubevirtiov1.VirtualMachineSpec{
+			RunStrategy: strategyPtr("Always"),
+			Instancetype: &kubevirtiov1.InstancetypeMatcher{
+				Name:         "u1.medium",
+				RevisionName: "u1.medium-1",
+			},
+			Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "test"}},
+				Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+					Domain: kubevirtiov1.DomainSpec{
+						CPU: &kubevirtiov1.CPU{
+							Cores:    2,
+							Sockets:  1,
+							Threads:  1,
+							Features: []kubevirtiov1.CPUFeature{{Name: "pcid"}},
+						},
+						Memory: &kubevirtiov1.Memory{Guest: &guest},
+						Devices: kubevirtiov1.Devices{
+							Disks: []kubevirtiov1.Disk{
+								{Name: "rootdisk", BootOrder: bootOrderPtr(1), Cache: kubevirtiov1.CacheNone},
+								{Name: "cdrom", DiskDevice: kubevirtiov1.DiskDevice{CDRom: &kubevirtiov1.CDRomTarget{}}},
+								{Name: "config", Tag: "config"},
+								{Name: "sa"},
+							},
+							Interfaces: []kubevirtiov1.Interface{
+								{
+									Name:                   "default",
+									MacAddress:             "02:00:00:00:00:01",
+									InterfaceBindingMethod: kubevirtiov1.InterfaceBindingMethod{Masquerade: &kubevirtiov1.InterfaceMasquerade{}},
+									Ports:                  []kubevirtiov1.Port{{Port: 80}},
+								},
+								{Name: "secondary", InterfaceBindingMethod: kubevirtiov1.InterfaceBindingMethod{Bridge: &kubevirtiov1.InterfaceBridge{}}},
+								{Name: "vf", InterfaceBindingMethod: kubevirtiov1.InterfaceBindingMethod{SRIOV: &kubevirtiov1.InterfaceSRIOV{}}},
+							},
+							GPUs:        []kubevirtiov1.GPU{{Name: "gpu1", DeviceName: "nvidia.com/gpu"}},
+							Inputs:      []kubevirtiov1.Input{{Name: "tablet", Type: "tablet", Bus: "usb"}},
+							Filesystems: []kubevirtiov1.Filesystem{{Name: "share", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}}},
+						},
+					},
+					Networks: []kubevirtiov1.Network{
+						{Name: "default", NetworkSource: kubevirtiov1.NetworkSource{Pod: &kubevirtiov1.PodNetwork{}}},
+						{Name: "secondary", NetworkSource: kubevirtiov1.NetworkSource{Multus: &kubevirtiov1.MultusNetwork{NetworkName: "net-a"}}},
+						{Name: "vf", NetworkSource: kubevirtiov1.NetworkSource{Multus: &kubevirtiov1.MultusNetwork{NetworkName: "sriov-a"}}},
+					},
+					Volumes: []kubevirtiov1.Volume{
+						{Name: "rootdisk", VolumeSource: kubevirtiov1.VolumeSource{DataVolume: &kubevirtiov1.DataVolumeSource{Name: "root"}}},
+						{Name: "cdrom", VolumeSource: kubevirtiov1.VolumeSource{DataVolume: &kubevirtiov1.DataVolumeSource{Name: "iso-a", Hotpluggable: true}}},
+						{Name: "config", VolumeSource: kubevirtiov1.VolumeSource{ConfigMap: &kubevirtiov1.ConfigMapVolumeSource{}}},
+						{Name: "sa", VolumeSource: kubevirtiov1.VolumeSource{ServiceAccount: &kubevirtiov1.ServiceAccountVolumeSource{ServiceAccountName: "sa-a"}}},
+						{Name: "share", VolumeSource: kubevirtiov1.VolumeSource{PersistentVolumeClaim: &kubevirtiov1.PersistentVolumeClaimVolumeSource{}}},
+					},
+				},
+			},
+		},
+	}
+}
+
+// changeEveryCategory changes the VM in every category at once
+func changeEveryCategory(vm *kubevirtiov1.VirtualMachine) {
+	vm.Labels["app"] = "changed"
+	vm.Annotations["example.com/owner"] = "team-b"
+	vm.Spec.RunStrategy = strategyPtr("Halted")
+	vm.Spec.Instancetype.RevisionName = "u1.medium-2"
+
+	template := vm.Spec.Template
+	template.ObjectMeta.Labels["app"] = "changed"
+
+	domain := &template.Spec.Domain
+	domain.CPU.Cores = 4
+	domain.CPU.Sockets = 2
+	domain.CPU.Threads = 2
+	domain.CPU.DedicatedCPUPlacement = true
+	domain.CPU.IsolateEmulatorThread = true
+	domain.CPU.Features = append(domain.CPU.Features, kubevirtiov1.CPUFeature{Name: "ssbd", Policy: "require"})
+	guest := resource.MustParse("4Gi")
+	domain.Memory.Guest = &guest
+	domain.Memory.Hugepages = &kubevirtiov1.Hugepages{PageSize: "2Mi"}
+	domain.Resources.Limits = corev1.ResourceList{"memory": resource.MustParse("8Gi")}
+	domain.Firmware = &kubevirtiov1.Firmware{UUID: "new-uuid"}
+
+	devices := &domain.Devices
+	devices.AutoattachGraphicsDevice = boolPtr(false)
+	devices.Disks[0].BootOrder = bootOrderPtr(2)
+	devices.Disks[0].Cache = kubevirtiov1.CacheWriteBack
+	devices.Disks[0].DedicatedIOThread = boolPtr(true)
+	devices.Disks[0].Serial = "serial"
+	devices.Disks[0].Shareable = boolPtr(true)
+	devices.Disks[2].Tag = "changed"
+	devices.Disks = append(devices.Disks, kubevirtiov1.Disk{Name: "data"})
+	devices.Interfaces[0].MacAddress = "02:00:00:00:00:02"
+	devices.Interfaces[0].Ports = []kubevirtiov1.Port{{Port: 443}}
+	devices.Interfaces[0].State = kubevirtiov1.InterfaceStateLinkDown
+	devices.Interfaces[1].InterfaceBindingMethod = kubevirtiov1.InterfaceBindingMethod{Masquerade: &kubevirtiov1.InterfaceMasquerade{}}
+	devices.Interfaces[2].PciAddress = "0000:81:00.1"
+	devices.Interfaces = append(devices.Interfaces, kubevirtiov1.Interface{
+		Name:                   "bridged",
+		InterfaceBindingMethod: kubevirtiov1.InterfaceBindingMethod{Bridge: &kubevirtiov1.InterfaceBridge{}},
+	})
+	devices.GPUs[0].VirtualGPUOptions = &kubevirtiov1.VGPUOptions{Display: &kubevirtiov1.VGPUDisplayOptions{Enabled: boolPtr(true)}}
+	devices.GPUs = append(devices.GPUs, kubevirtiov1.GPU{Name: "gpu2", DeviceName: "nvidia.com/gpu"})
+	devices.Inputs[0].Bus = "virtio"
+	devices.Filesystems = nil
+
+	template.Spec.Networks[1].Multus.NetworkName = "net-b"
+	template.Spec.Networks = append(template.Spec.Networks, kubevirtiov1.Network{
+		Name:          "bridged",
+		NetworkSource: kubevirtiov1.NetworkSource{Multus: &kubevirtiov1.MultusNetwork{NetworkName: "net-c"}},
+	})
+
+	volumes := template.Spec.Volumes
+	volumes[0].DataVolume.Name = "other-root"
+	volumes[1].DataVolume.Name = "iso-b"
+	volumes[2].ConfigMap.Name = "other-config"
+	volumes[3].ServiceAccount.ServiceAccountName = "sa-b"
+	template.Spec.Volumes = append(volumes[:4], kubevirtiov1.Volume{Name: "data"})
+}
+
+var _ = Describe("Neutralize idempotence", func() {
+	type vmPair struct {
+		name         string
+		oldVM, newVM *kubevirtiov1.VirtualMachine
+	}
+
+	// pairs returns old/new VMs changing every category, in both directions, and the
+	// shapes of the fuzz test against each other
+	pairs := func() []vmPair {
+		oldVM := neutralizeIdempotenceVM()
+		newVM := oldVM.DeepCopy()
+		changeEveryCategory(newVM)
+
+		pairs := []vmPair{
+			{"every category changed", oldVM, newVM},
+			{"every category changed back", newVM.DeepCopy(), oldVM.DeepCopy()},
+		}
+		shapes := []uint32{
+			0, shapeNilTemplate, shapeNilCPU, shapeCdromDisks | shapeHotpluggable, shapeFilesystems,
+			shapeInterfaces | shapeGPUs, shapeRunning | shapeLabels, shapeRunStrategy | shapeTemplateLabels,
+			shapeDiskTuning | shapeAutoattach, shapeEmptySlices,
+		}
+		for _, oldShape := range shapes {
+			for _, newShape := range shapes {
+				pairs = append(pairs, vmPair{
+					fmt.Sprintf("shape %#x with 1 element to shape %#x with 2", oldShape, newShape),
+					fuzzVM(oldShape, 1), fuzzVM(newShape, 2),
+				})
+			}
+		}
+		return pairs
+	}
+
+	It("should leave the VMs as a single Neutralize did when every checker neutralizes twice", func() {
+		for _, checker := range configuredFieldCheckers() {
+			for _, pair := range pairs() {
+				Expect(checkNeutralizeIdempotent(checker, pair.oldVM, pair.newVM)).To(Succeed(), pair.name)
+			}
+		}
+	})
+
+	It("should detect a checker whose second Neutralize changes the VMs again", func() {
+		oldVM := neutralizeIdempotenceVM()
+		newVM := oldVM.DeepCopy()
+		changeEveryCategory(newVM)
+
+		Expect(checkNeutralizeIdempotent(&appendingPermissionChecker{}, oldVM, newVM)).To(
+			MatchError("storage neutralized the new VM differently when called twice"))
+	})
+})
+
+// appendingPermissionChecker is a buggy checker whose Neutralize adds a volume to the new VM
+// on every call instead of making it match the old VM
+type appendingPermissionChecker struct {
+	StoragePermissionChecker
+}
+
+func (a *appendingPermissionChecker) Neutralize(oldVM, newVM *kubevirtiov1.VirtualMachine) {
+	newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "neutralized"})
+}
+
+// checkNeutralizeIdempotent verifies that calling the checker's Neutralize twice on copies of the
+// VMs leaves them as a single call does
+func checkNeutralizeIdempotent(checker FieldPermissionChecker, oldVM, newVM *kubevirtiov1.VirtualMachine) error {
+	onceOld, onceNew := oldVM.DeepCopy(), newVM.DeepCopy()
+	checker.Neutralize(onceOld, onceNew)
+
+	twiceOld, twiceNew := oldVM.DeepCopy(), newVM.DeepCopy()
+	checker.Neutralize(twiceOld, twiceNew)
+	checker.Neutralize(twiceOld, twiceNew)
+
+	if !equality.Semantic.DeepEqual(onceOld, twiceOld) {
+		return fmt.Errorf("%s neutralized the old VM differently when called twice", checker.Name())
+	}
+	if !equality.Semantic.DeepEqual(onceNew, twiceNew) {
+		return fmt.Errorf("%s neutralized the new VM differently when called twice", checker.Name())
+	}
+	return nil
+}
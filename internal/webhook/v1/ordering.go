@@ -0,0 +1,109 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"cmp"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+)
+
+// sameAsSet reports whether a and b hold the same elements regardless of order, matching
+// elements by key. Clients and controllers may reserialize named lists in another order, which
+// is not a change of any category for lists whose order has no meaning to the guest (volumes,
+// networks and CPU features). Devices are compared in order instead, since the first disk is the
+// default boot device and the order decides PCI slots and NIC naming. Duplicate keys make the
+// matching ambiguous, so such slices are compared in order.
+func sameAsSet[T any](a, b []T, key func(T) string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	byKey := make(map[string]T, len(a))
+	for _, item := range a {
+		if _, duplicate := byKey[key(item)]; duplicate {
+			return equality.Semantic.DeepEqual(a, b)
+		}
+		byKey[key(item)] = item
+	}
+
+	matched := make(map[string]bool, len(b))
+	for _, item := range b {
+		other, found := byKey[key(item)]
+		if !found || matched[key(item)] || !equality.Semantic.DeepEqual(item, other) {
+			return false
+		}
+		matched[key(item)] = true
+	}
+	return true
+}
+
+// sortByIdentity sorts the named lists of the VM whose order has no meaning by their key, so a
+// DeepEqual of two sorted VMs ignores reordering like sameAsSet. Only use it on copies: the
+// order is part of the object.
+func sortByIdentity(vm *kubevirtiov1.VirtualMachine) {
+	if vm.Spec.Template == nil {
+		return
+	}
+
+	spec := &vm.Spec.Template.Spec
+	sortByKey(spec.Volumes, volumeName)
+	sortByKey(spec.Networks, networkName)
+	if spec.Domain.CPU != nil {
+		sortByKey(spec.Domain.CPU.Features, cpuFeatureName)
+	}
+}
+
+// sortByKey sorts the items in place by key, keeping the order of items with the same key
+func sortByKey[T any](items []T, key func(T) string) {
+	slices.SortStableFunc(items, func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	})
+}
+
+func networkName(network kubevirtiov1.Network) string {
+	return network.Name
+}
+
+func interfaceName(iface kubevirtiov1.Interface) string {
+	return iface.Name
+}
+
+func filesystemName(filesystem kubevirtiov1.Filesystem) string {
+	return filesystem.Name
+}
+
+func inputName(input kubevirtiov1.Input) string {
+	return input.Name
+}
+
+func cpuFeatureName(feature kubevirtiov1.CPUFeature) string {
+	return feature.Name
+}
+
+// sameCPU reports whether the CPU settings are equal, comparing the feature flags as a set
+func sameCPU(a, b *kubevirtiov1.CPU) bool {
+	if a == nil || b == nil {
+		return equality.Semantic.DeepEqual(a, b)
+	}
+
+	strippedA, strippedB := *a, *b
+	strippedA.Features, strippedB.Features = nil, nil
+	return sameAsSet(a.Features, b.Features, cpuFeatureName) && equality.Semantic.DeepEqual(strippedA, strippedB)
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"slices"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("List ordering", func() {
+	Describe("sameAsSet", func() {
+		key := func(s string) string { return s[:1] }
+
+		It("should ignore the order of the elements", func() {
+			Expect(sameAsSet([]string{"a1", "b1"}, []string{"b1", "a1"}, key)).To(BeTrue())
+		})
+
+		It("should treat nil and empty slices as equal", func() {
+			Expect(sameAsSet(nil, []string{}, key)).To(BeTrue())
+		})
+
+		It("should detect a changed element", func() {
+			Expect(sameAsSet([]string{"a1", "b1"}, []string{"b1", "a2"}, key)).To(BeFalse())
+		})
+
+		It("should detect added, removed and renamed elements", func() {
+			Expect(sameAsSet([]string{"a1"}, []string{"a1", "b1"}, key)).To(BeFalse())
+			Expect(sameAsSet([]string{"a1", "b1"}, []string{"a1"}, key)).To(BeFalse())
+			Expect(sameAsSet([]string{"a1", "b1"}, []string{"a1", "c1"}, key)).To(BeFalse())
+		})
+
+		It("should compare slices with duplicate keys in order", func() {
+			Expect(sameAsSet([]string{"a1", "a2"}, []string{"a1", "a2"}, key)).To(BeTrue())
+			Expect(sameAsSet([]string{"a1", "a2"}, []string{"a2", "a1"}, key)).To(BeFalse())
+			Expect(sameAsSet([]string{"a1", "b1"}, []string{"a1", "a1"}, key)).To(BeFalse())
+		})
+	})
+
+	Context("reordering a named list", func() {
+		var oldVM *kubevirtiov1.VirtualMachine
+
+		BeforeEach(func() {
+			oldVM = &kubevirtiov1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-vm", Namespace: "default"},
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								CPU: &kubevirtiov1.CPU{Cores: 2, Features: []kubevirtiov1.CPUFeature{{Name: "pcid"}, {Name: "ssbd", Policy: "require"}}},
+								Devices: kubevirtiov1.Devices{
+									Disks: []kubevirtiov1.Disk{
+										{Name: "rootdisk", Serial: "root"},
+										{Name: "cdrom", DiskDevice: kubevirtiov1.DiskDevice{CDRom: &kubevirtiov1.CDRomTarget{}}},
+										{Name: "config"},
+									},
+									Interfaces: []kubevirtiov1.Interface{
+										{Name: "default", MacAddress: "02:00:00:00:00:01"},
+										{Name: "secondary", Ports: []kubevirtiov1.Port{{Port: 80}}},
+										{Name: "vf", InterfaceBindingMethod: kubevirtiov1.InterfaceBindingMethod{SRIOV: &kubevirtiov1.InterfaceSRIOV{}}},
+									},
+									GPUs: []kubevirtiov1.GPU{
+										{Name: "gpu1", DeviceName: "nvidia.com/gpu"},
+										{Name: "gpu2", DeviceName: "nvidia.com/gpu"},
+									},
+									HostDevices: []kubevirtiov1.HostDevice{
+										{Name: "dev1", DeviceName: "example.com/dev"},
+										{Name: "dev2", DeviceName: "example.com/dev"},
+									},
+									Inputs: []kubevirtiov1.Input{
+										{Name: "tablet", Type: "tablet", Bus: "usb"},
+										{Name: "keyboard", Type: "keyboard", Bus: "usb"},
+									},
+									Filesystems: []kubevirtiov1.Filesystem{
+										{Name: "share1", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}},
+										{Name: "share2", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}},
+									},
+								},
+							},
+							Networks: []kubevirtiov1.Network{
+								{Name: "default", NetworkSource: kubevirtiov1.NetworkSource{Pod: &kubevirtiov1.PodNetwork{}}},
+								{Name: "secondary", NetworkSource: kubevirtiov1.NetworkSource{Multus: &kubevirtiov1.MultusNetwork{NetworkName: "vlan"}}},
+								{Name: "vf", NetworkSource: kubevirtiov1.NetworkSource{Multus: &kubevirtiov1.MultusNetwork{NetworkName: "sriov"}}},
+							},
+							Volumes: []kubevirtiov1.Volume{
+								{Name: "rootdisk", VolumeSource: kubevirtiov1.VolumeSource{DataVolume: &kubevirtiov1.DataVolumeSource{Name: "root"}}},
+								{Name: "cdrom", VolumeSource: kubevirtiov1.VolumeSource{DataVolume: &kubevirtiov1.DataVolumeSource{Name: "iso", Hotpluggable: true}}},
+								{Name: "config", VolumeSource: kubevirtiov1.VolumeSource{ConfigMap: &kubevirtiov1.ConfigMapVolumeSource{}}},
+								{Name: "share1", VolumeSource: kubevirtiov1.VolumeSource{PersistentVolumeClaim: &kubevirtiov1.PersistentVolumeClaimVolumeSource{}}},
+								{Name: "share2", VolumeSource: kubevirtiov1.VolumeSource{ConfigMap: &kubevirtiov1.ConfigMapVolumeSource{}}},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		DescribeTable("should not be detected as a change",
+			func(reorder func(spec *kubevirtiov1.VirtualMachineInstanceSpec)) {
+				newVM := oldVM.DeepCopy()
+				reorder(&newVM.Spec.Template.Spec)
+				Expect(newVM.Spec).ToNot(Equal(oldVM.Spec))
+
+				for _, checker := range DefaultFieldCheckers() {
+					Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse(), "checker %s", checker.Name())
+				}
+				for _, checker := range []FieldPermissionChecker{
+					&GPUPermissionChecker{RequireFullAdminForRemovals: true},
+					&DevicesPermissionChecker{RequireInputAdminForTypeChanges: true, RequireFullAdminForRemovals: true},
+				} {
+					Expect(checker.HasChanged(oldVM, newVM)).To(BeFalse(), "checker %s", checker.Name())
+				}
+
+				mockPerm := &MockPermissionChecker{permissions: map[string]bool{"virtualmachines/lifecycle-admin": true}}
+				validator := &VirtualMachineCustomValidator{FieldCheckers: DefaultFieldCheckers(), PermissionChecker: mockPerm}
+				ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+					AdmissionRequest: admissionv1.AdmissionRequest{
+						UserInfo: authenticationv1.UserInfo{Username: "test-user"},
+					},
+				})
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(mockPerm.calls).To(BeZero())
+			},
+			Entry("volumes", func(spec *kubevirtiov1.VirtualMachineInstanceSpec) { slices.Reverse(spec.Volumes) }),
+			Entry("networks", func(spec *kubevirtiov1.VirtualMachineInstanceSpec) { slices.Reverse(spec.Networks) }),
+			Entry("cpu features", func(spec *kubevirtiov1.VirtualMachineInstanceSpec) { slices.Reverse(spec.Domain.CPU.Features) }),
+		)
+
+		// The first disk is the default boot device, and device order decides PCI slots and NIC naming
+		DescribeTable("should be detected as a change of the category owning the devices",
+			func(category string, reorder func(spec *kubevirtiov1.VirtualMachineInstanceSpec)) {
+				newVM := oldVM.DeepCopy()
+				reorder(&newVM.Spec.Template.Spec)
+
+				Expect(CategorizeChanges(oldVM, newVM, DefaultFieldCheckers())).To(HaveKeyWithValue(category, true))
+
+				mockPerm := &MockPermissionChecker{permissions: map[string]bool{"virtualmachines/lifecycle-admin": true}}
+				validator := &VirtualMachineCustomValidator{FieldCheckers: DefaultFieldCheckers(), PermissionChecker: mockPerm}
+				ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+					AdmissionRequest: admissionv1.AdmissionRequest{
+						UserInfo: authenticationv1.UserInfo{Username: "test-user"},
+					},
+				})
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("disks", "storage", func(spec *kubevirtiov1.VirtualMachineInstanceSpec) { slices.Reverse(spec.Domain.Devices.Disks) }),
+			Entry("interfaces", "network", func(spec *kubevirtiov1.VirtualMachineInstanceSpec) { slices.Reverse(spec.Domain.Devices.Interfaces) }),
+			Entry("gpus", "gpu", func(spec *kubevirtiov1.VirtualMachineInstanceSpec) { slices.Reverse(spec.Domain.Devices.GPUs) }),
+			Entry("host devices", "devices", func(spec *kubevirtiov1.VirtualMachineInstanceSpec) { slices.Reverse(spec.Domain.Devices.HostDevices) }),
+			Entry("inputs", "devices", func(spec *kubevirtiov1.VirtualMachineInstanceSpec) { slices.Reverse(spec.Domain.Devices.Inputs) }),
+			Entry("filesystems", "filesystem", func(spec *kubevirtiov1.VirtualMachineInstanceSpec) { slices.Reverse(spec.Domain.Devices.Filesystems) }),
+		)
+
+		It("should still detect a change hidden in a reordering", func() {
+			newVM := oldVM.DeepCopy()
+			volumes := newVM.Spec.Template.Spec.Volumes
+			slices.Reverse(volumes)
+			volumes[0].ConfigMap.Name = "changed"
+
+			Expect((&StoragePermissionChecker{}).HasChanged(oldVM, newVM)).To(BeTrue())
+			Expect((&ConfigPermissionChecker{}).HasChanged(oldVM, newVM)).To(BeTrue())
+		})
+
+		It("should not attribute a disk reordering to a per-disk subset", func() {
+			newVM := oldVM.DeepCopy()
+			disks := newVM.Spec.Template.Spec.Domain.Devices.Disks
+			slices.Reverse(disks)
+			disks[0].Serial = "changed"
+
+			Expect((&StoragePermissionChecker{}).HasChanged(oldVM, newVM)).To(BeTrue())
+			Expect((&DiskIdentityPermissionChecker{}).HasChanged(oldVM, newVM)).To(BeFalse())
+			Expect((&BootPermissionChecker{}).HasChanged(oldVM, newVM)).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,106 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+)
+
+// ownerGrant returns a function reporting whether the user may update the controller owner of
+// the VM (e.g. a VirtualMachinePool), which grants every category subresource under
+// OwnerDelegation. The owner is only reviewed once, on first use, counting once against the
+// request's budget whatever the number of SARVerbs.
+func (v *VirtualMachineCustomValidator) ownerGrant(ctx context.Context, userInfo authenticationv1.UserInfo, vm *kubevirtiov1.VirtualMachine, sars *sarBudget) func() (bool, error) {
+	var (
+		checked bool
+		allowed bool
+	)
+	return func() (bool, error) {
+		if !v.OwnerDelegation || checked {
+			return allowed, nil
+		}
+
+		var err error
+		allowed, err = v.canUpdateOwner(ctx, userInfo, vm, sars)
+		if err != nil {
+			return false, err
+		}
+		checked = true
+		return allowed, nil
+	}
+}
+
+// canUpdateOwner sends SubjectAccessReviews asking whether the user may update the VM's
+// controller owner with any of SARVerbs. VMs without a controller owner, or whose owner kind is
+// not served by the API server, are not delegated.
+func (v *VirtualMachineCustomValidator) canUpdateOwner(ctx context.Context, userInfo authenticationv1.UserInfo, vm *kubevirtiov1.VirtualMachine, sars *sarBudget) (bool, error) {
+	owner := metav1.GetControllerOf(vm)
+	if owner == nil {
+		return false, nil
+	}
+
+	gv, err := schema.ParseGroupVersion(owner.APIVersion)
+	if err != nil {
+		virtualmachinelog.Error(err, "Ignoring owner with an invalid apiVersion", "name", vm.GetName(), "owner", owner.Name)
+		return false, nil
+	}
+	mapping, err := v.Client.RESTMapper().RESTMapping(gv.WithKind(owner.Kind).GroupKind(), gv.Version)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			virtualmachinelog.V(1).Info("Ignoring owner of an unknown kind", "name", vm.GetName(), "owner", owner.Name, "kind", owner.Kind)
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to map owner kind %s: %w", owner.Kind, err)
+	}
+
+	if err := sars.spend(1); err != nil {
+		return false, err
+	}
+	return v.tracedCheckPermission(ctx, mapping.Resource.GroupResource().String(), func(ctx context.Context) (bool, error) {
+		return anyAllowed([]string{mapping.Resource.Group}, v.SARVerbs, func(group, verb string) (bool, error) {
+			sar := &authv1.SubjectAccessReview{
+				Spec: authv1.SubjectAccessReviewSpec{
+					User:   userInfo.Username,
+					Groups: userInfo.Groups,
+					UID:    userInfo.UID,
+					ResourceAttributes: &authv1.ResourceAttributes{
+						Namespace: vm.Namespace,
+						Verb:      verb,
+						Group:     group,
+						Version:   mapping.Resource.Version,
+						Resource:  mapping.Resource.Resource,
+						Name:      owner.Name,
+					},
+				},
+			}
+			if err := createRateLimited(ctx, v.SARRetry, v.SARLimiter, func() error {
+				return v.Client.Create(ctx, sar)
+			}); err != nil {
+				return false, fmt.Errorf("failed to create SubjectAccessReview for owner %s %s: %w", owner.Kind, owner.Name, err)
+			}
+			return sar.Status.Allowed, nil
+		})
+	})
+}
@@ -0,0 +1,238 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/time/rate"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("Owner delegation", func() {
+	var (
+		validator *VirtualMachineCustomValidator
+		mockPerm  *MockPermissionChecker
+		ctx       context.Context
+		oldVM     *kubevirtiov1.VirtualMachine
+		newVM     *kubevirtiov1.VirtualMachine
+		reviews   []*authv1.SubjectAccessReview
+		reviewErr error
+		transient int
+	)
+
+	poolGVK := schema.GroupVersionKind{Group: "pool.kubevirt.io", Version: "v1alpha1", Kind: "VirtualMachinePool"}
+
+	BeforeEach(func() {
+		reviews = nil
+		reviewErr = nil
+		transient = 0
+
+		restMapper := meta.NewDefaultRESTMapper(nil)
+		restMapper.Add(poolGVK, meta.RESTScopeNamespace)
+
+		// The fake client grants update on the owning pool "my-pool" only
+		c := fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithRESTMapper(restMapper).WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				sar := obj.(*authv1.SubjectAccessReview)
+				reviews = append(reviews, sar)
+				if reviewErr != nil {
+					return reviewErr
+				}
+				if transient > 0 {
+					transient--
+					return apierrors.NewTooManyRequests("throttled", 0)
+				}
+				attributes := sar.Spec.ResourceAttributes
+				sar.Status.Allowed = attributes.Group == "pool.kubevirt.io" && attributes.Resource == "virtualmachinepools" &&
+					attributes.Name == "my-pool" && attributes.Verb == "update" && sar.Spec.User == "pool-editor"
+				return nil
+			},
+		}).Build()
+
+		mockPerm = &MockPermissionChecker{permissions: map[string]bool{
+			"virtualmachines/storage-admin": true,
+		}}
+		validator = &VirtualMachineCustomValidator{
+			Client:            c,
+			FieldCheckers:     DefaultFieldCheckers(),
+			PermissionChecker: mockPerm,
+			OwnerDelegation:   true,
+		}
+
+		ctx = admission.NewContextWithRequest(context.Background(), admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				UserInfo: authenticationv1.UserInfo{Username: "pool-editor"},
+			},
+		})
+
+		oldVM = &kubevirtiov1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-pool-0",
+				Namespace: "default",
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: poolGVK.GroupVersion().String(),
+					Kind:       poolGVK.Kind,
+					Name:       "my-pool",
+					UID:        "pool-uid",
+					Controller: boolPtr(true),
+				}},
+			},
+			Spec: kubevirtiov1.VirtualMachineSpec{
+				Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+					Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+						Domain: kubevirtiov1.DomainSpec{
+							CPU: &kubevirtiov1.CPU{Cores: 2},
+						},
+					},
+				},
+			},
+		}
+		newVM = oldVM.DeepCopy()
+		newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+	})
+
+	It("should allow compute changes to users that may update the owning pool", func() {
+		warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(warnings).To(BeNil())
+
+		Expect(reviews).To(HaveLen(1))
+		Expect(reviews[0].Spec.ResourceAttributes.Namespace).To(Equal("default"))
+		Expect(reviews[0].Spec.ResourceAttributes.Version).To(Equal("v1alpha1"))
+	})
+
+	It("should deny users that may not update the owning pool", func() {
+		ctx = admission.NewContextWithRequest(context.Background(), admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				UserInfo: authenticationv1.UserInfo{Username: "other-user"},
+			},
+		})
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("does not have permission"))
+	})
+
+	It("should not grant full-admin", func() {
+		newVM.Labels = map[string]string{"team": "x"}
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("metadata"))
+	})
+
+	It("should use the stored owner, not the submitted one", func() {
+		oldVM.OwnerReferences[0].Name = "other-pool"
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("does not have permission"))
+	})
+
+	It("should ignore owners that are not the controller", func() {
+		oldVM.OwnerReferences[0].Controller = nil
+		newVM.OwnerReferences[0].Controller = nil
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).To(HaveOccurred())
+		Expect(reviews).To(BeEmpty())
+	})
+
+	It("should ignore owners of an unknown kind", func() {
+		oldVM.OwnerReferences[0].Kind = "FleetDeployment"
+		newVM.OwnerReferences[0].Kind = "FleetDeployment"
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("does not have permission"))
+		Expect(reviews).To(BeEmpty())
+	})
+
+	It("should not review the owner when disabled", func() {
+		validator.OwnerDelegation = false
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).To(HaveOccurred())
+		Expect(reviews).To(BeEmpty())
+	})
+
+	It("should review the owner with the configured verbs", func() {
+		validator.SARVerbs = []string{"patch", "update"}
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reviews).To(HaveLen(2))
+		Expect(reviews[0].Spec.ResourceAttributes.Verb).To(Equal("patch"))
+		Expect(reviews[1].Spec.ResourceAttributes.Verb).To(Equal("update"))
+	})
+
+	It("should retry a transient owner review failure", func() {
+		validator.SARRetry = RetryPolicy{Retries: 1, Backoff: time.Millisecond}
+		transient = 1
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reviews).To(HaveLen(2))
+	})
+
+	It("should take a rate limiter token for the owner review", func() {
+		validator.SARLimiter = rate.NewLimiter(rate.Every(time.Hour), 1)
+		Expect(validator.SARLimiter.Allow()).To(BeTrue())
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).To(MatchError(ContainSubstring("SubjectAccessReview rate limit exceeded")))
+		Expect(reviews).To(BeEmpty())
+	})
+
+	It("should count the owner review against MaxSARsPerRequest", func() {
+		validator.MaxSARsPerRequest = 1
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(ReasonCodeOf(err)).To(Equal(ReasonCodeSARBudgetExceeded))
+		Expect(reviews).To(BeEmpty())
+
+		validator.MaxSARsPerRequest = 2
+		_, err = validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should fail when the owner review fails", func() {
+		reviewErr = apierrors.NewForbidden(authv1.Resource("subjectaccessreviews"), "", nil)
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).To(MatchError(ContainSubstring("failed to create SubjectAccessReview for owner VirtualMachinePool my-pool")))
+	})
+})
@@ -0,0 +1,78 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// pendingEnforcementWarnings returns one warning per pending-enforcement field path changed by
+// the update. Paths are dot-separated JSON field names (e.g. spec.template.spec.domain.devices.tpm)
+// and cannot index into lists; a path that is absent from both VMs is unchanged.
+func (v *VirtualMachineCustomValidator) pendingEnforcementWarnings(oldVM, newVM *kubevirtiov1.VirtualMachine) admission.Warnings {
+	if len(v.PendingEnforcementFields) == 0 {
+		return nil
+	}
+
+	changed, err := changedFieldPaths(oldVM, newVM, v.PendingEnforcementFields)
+	if err != nil {
+		// Warnings are a migration aid, never a reason to fail the update
+		virtualmachinelog.Error(err, "Failed to compare VirtualMachine fields for pending enforcement warnings", "name", newVM.GetName())
+		return nil
+	}
+
+	var warnings admission.Warnings
+	for _, path := range changed {
+		warnings = append(warnings, fmt.Sprintf("%s of VirtualMachine %s changed: changes to this field "+
+			"are not enforced yet, but will require a VM subresource permission in a future release",
+			path, client.ObjectKeyFromObject(newVM)))
+	}
+	return warnings
+}
+
+// changedFieldPaths returns the dot-separated JSON field paths (e.g. spec.template.spec.domain.devices.tpm)
+// whose values differ between the VMs, in the order given. Paths cannot index into lists;
+// a path that is absent from both VMs is unchanged.
+func changedFieldPaths(oldVM, newVM *kubevirtiov1.VirtualMachine, paths []string) ([]string, error) {
+	oldObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(oldVM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert VirtualMachine %s: %w", client.ObjectKeyFromObject(oldVM), err)
+	}
+	newObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(newVM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert VirtualMachine %s: %w", client.ObjectKeyFromObject(newVM), err)
+	}
+
+	var changed []string
+	for _, path := range paths {
+		fields := strings.Split(path, ".")
+		oldValue, _, _ := unstructured.NestedFieldNoCopy(oldObj, fields...)
+		newValue, _, _ := unstructured.NestedFieldNoCopy(newObj, fields...)
+		if !equality.Semantic.DeepEqual(oldValue, newValue) {
+			changed = append(changed, path)
+		}
+	}
+	return changed, nil
+}
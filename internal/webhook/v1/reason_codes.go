@@ -0,0 +1,180 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReasonCode is a stable, machine-readable classification of a denial, e.g. for grouping
+// denials on a dashboard. Denial messages may change between releases, reason codes do not.
+type ReasonCode string
+
+// Reason codes of denials that are not about a single category. A denial of a category uses
+// the code of the category instead (see CategoryReasonCode).
+const (
+	// ReasonCodeUnknownUser: the admission request is missing, so the user cannot be identified
+	ReasonCodeUnknownUser ReasonCode = "UNKNOWN_USER"
+
+	// ReasonCodeUnauthenticated: the admission request has no username
+	ReasonCodeUnauthenticated ReasonCode = "UNAUTHENTICATED"
+
+	// ReasonCodeDeniedGroup: the user is a member of a deny group
+	ReasonCodeDeniedGroup ReasonCode = "DENIED_GROUP"
+
+	// ReasonCodeObjectTooLarge: the serialized object exceeds the maximum size
+	ReasonCodeObjectTooLarge ReasonCode = "OBJECT_TOO_LARGE"
+
+	// ReasonCodeSARBudgetExceeded: the update needs more SubjectAccessReviews than allowed
+	ReasonCodeSARBudgetExceeded ReasonCode = "SAR_BUDGET_EXCEEDED"
+
+	// ReasonCodeImmutableField: the update changes fields that are immutable by policy
+	ReasonCodeImmutableField ReasonCode = "IMMUTABLE_FIELD"
+
+	// ReasonCodeFrozen: the VM or its namespace is frozen for maintenance
+	ReasonCodeFrozen ReasonCode = "FROZEN"
+
+	// ReasonCodeControlAnnotationForbidden: the update changes a control annotation (including
+	// the freeze annotation), which requires full-admin
+	ReasonCodeControlAnnotationForbidden ReasonCode = "CONTROL_ANNOTATION_FORBIDDEN"
+
+	// ReasonCodeStrictNoRole: in strict mode, the user holds no granular role
+	ReasonCodeStrictNoRole ReasonCode = "STRICT_NO_ROLE"
+
+	// ReasonCodeLabelForbidden: the update changes labels mapped to a subresource the user lacks
+	ReasonCodeLabelForbidden ReasonCode = "LABEL_FORBIDDEN"
+
+	// ReasonCodeMetadataForbidden: the update changes VM metadata no granular role covers
+	ReasonCodeMetadataForbidden ReasonCode = "METADATA_FORBIDDEN"
+
+	// ReasonCodeTemplateMetadataForbidden: the update changes spec.template.metadata, which is
+	// also the code of the template-metadata category
+	ReasonCodeTemplateMetadataForbidden ReasonCode = "TEMPLATE_METADATA_FORBIDDEN"
+
+	// ReasonCodeFullAdminRequired: the changed spec fields are covered by no granular role
+	ReasonCodeFullAdminRequired ReasonCode = "FULL_ADMIN_REQUIRED"
+
+	// ReasonCodeTooManyCategories: the update changes more categories than allowed without full-admin
+	ReasonCodeTooManyCategories ReasonCode = "TOO_MANY_CATEGORIES"
+
+	// ReasonCodeCategoriesForbidden: the user lacks the roles of several changed categories
+	ReasonCodeCategoriesForbidden ReasonCode = "CATEGORIES_FORBIDDEN"
+
+	// ReasonCodeInternalError: the update could not be validated, e.g. a SubjectAccessReview failed
+	ReasonCodeInternalError ReasonCode = "INTERNAL_ERROR"
+)
+
+// CategoryReasonCode returns the reason code of a denial of the named category: the upper-cased
+// name with dashes replaced by underscores and a _FORBIDDEN suffix, e.g. COMPUTE_FORBIDDEN for
+// compute or CPU_PINNING_FORBIDDEN for cpu-pinning. Category names are stable, so are these codes.
+func CategoryReasonCode(category string) ReasonCode {
+	return ReasonCode(strings.ToUpper(strings.ReplaceAll(category, "-", "_")) + "_FORBIDDEN")
+}
+
+// missingReasonCode returns the reason code of a denial for the missing categories: the code
+// of the category if only one is missing, CATEGORIES_FORBIDDEN otherwise
+func missingReasonCode(missing []string) ReasonCode {
+	if len(missing) == 1 {
+		return CategoryReasonCode(missing[0])
+	}
+	return ReasonCodeCategoriesForbidden
+}
+
+// denialError is a denial with its reason code. It implements apierrors.APIStatus, so the
+// admission response is a Forbidden status whose reason is the code.
+type denialError struct {
+	code ReasonCode
+	err  error
+
+	// needed lists the subresources that would have permitted the update, in checker order;
+	// empty when no role permits it (e.g. denied groups, policy-immutable fields)
+	needed []string
+}
+
+var _ apierrors.APIStatus = &denialError{}
+
+// denyf returns a denial with the given reason code and formatted message
+func denyf(code ReasonCode, format string, args ...interface{}) error {
+	return &denialError{code: code, err: fmt.Errorf(format, args...)}
+}
+
+// denyNeedingf returns a denial like denyf, that the given subresources would have permitted
+func denyNeedingf(code ReasonCode, needed []string, format string, args ...interface{}) error {
+	return &denialError{code: code, err: fmt.Errorf(format, args...), needed: needed}
+}
+
+func (e *denialError) Error() string {
+	return e.err.Error()
+}
+
+func (e *denialError) Unwrap() error {
+	return e.err
+}
+
+// Status returns the status of the admission response
+func (e *denialError) Status() metav1.Status {
+	return metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    http.StatusForbidden,
+		Reason:  metav1.StatusReason(e.code),
+		Message: e.Error(),
+	}
+}
+
+// ReasonCodeOf returns the reason code of the error a validation returned: empty if it
+// allowed the update, INTERNAL_ERROR if the error is not a denial
+func ReasonCodeOf(err error) ReasonCode {
+	if err == nil {
+		return ""
+	}
+	var denial *denialError
+	if errors.As(err, &denial) {
+		return denial.code
+	}
+	return ReasonCodeInternalError
+}
+
+// withReasonCode returns an error wrapping a denial (e.g. "failed to check ... permission: <denial>")
+// as a denial itself, so the admission response has the code and the complete message
+func withReasonCode(err error) error {
+	var denial *denialError
+	if !errors.As(err, &denial) || denial == err {
+		return err
+	}
+	return &denialError{code: denial.code, err: err, needed: denial.needed}
+}
+
+// denyFullAdminf returns a denial like denyf, that only virtualmachines/full-admin would have permitted
+func denyFullAdminf(code ReasonCode, format string, args ...interface{}) error {
+	return denyNeedingf(code, []string{"virtualmachines/full-admin"}, format, args...)
+}
+
+// neededSubresourcesOf returns the subresources that would have permitted the update the
+// error denied, nil if it is not a denial or no role permits the update
+func neededSubresourcesOf(err error) []string {
+	var denial *denialError
+	if errors.As(err, &denial) {
+		return denial.needed
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("Reason codes", func() {
+	var (
+		validator *VirtualMachineCustomValidator
+		mockPerm  *MockPermissionChecker
+		request   admission.Request
+		oldVM     *kubevirtiov1.VirtualMachine
+		newVM     *kubevirtiov1.VirtualMachine
+	)
+
+	BeforeEach(func() {
+		mockPerm = &MockPermissionChecker{permissions: make(map[string]bool)}
+		validator = &VirtualMachineCustomValidator{
+			FieldCheckers:     DefaultFieldCheckers(),
+			PermissionChecker: mockPerm,
+		}
+		request = admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				UserInfo: authenticationv1.UserInfo{Username: "test-user"},
+			},
+		}
+		oldVM = &kubevirtiov1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-vm", Namespace: "default"},
+			Spec: kubevirtiov1.VirtualMachineSpec{
+				Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+					Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+						Domain: kubevirtiov1.DomainSpec{
+							CPU: &kubevirtiov1.CPU{Cores: 2},
+						},
+					},
+				},
+			},
+		}
+		newVM = oldVM.DeepCopy()
+	})
+
+	validate := func() error {
+		_, err := validator.ValidateUpdate(admission.NewContextWithRequest(context.Background(), request), oldVM, newVM)
+		return err
+	}
+
+	Context("of denials", func() {
+		It("should classify an unidentified user", func() {
+			validator.MissingRequestPolicy = MissingRequestDeny
+			newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+			_, err := validator.ValidateUpdate(context.Background(), oldVM, newVM)
+			Expect(ReasonCodeOf(err)).To(Equal(ReasonCodeUnknownUser))
+		})
+
+		It("should classify an unauthenticated user", func() {
+			request.UserInfo.Username = ""
+			newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+			Expect(ReasonCodeOf(validate())).To(Equal(ReasonCodeUnauthenticated))
+		})
+
+		It("should classify a member of a deny group", func() {
+			validator.DenyGroups = []string{"quarantined"}
+			request.UserInfo.Groups = []string{"quarantined"}
+			newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+			Expect(ReasonCodeOf(validate())).To(Equal(ReasonCodeDeniedGroup))
+		})
+
+		It("should classify an oversized object", func() {
+			validator.MaxObjectBytes = 16
+			request.Object = runtime.RawExtension{Raw: make([]byte, 32)}
+			newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+			Expect(ReasonCodeOf(validate())).To(Equal(ReasonCodeObjectTooLarge))
+		})
+
+		It("should classify an exceeded SubjectAccessReview budget wrapped by the permission check", func() {
+			validator.MaxSARsPerRequest = 1
+			newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+			err := validate()
+			Expect(err).To(MatchError(ContainSubstring("failed to check compute permission: update of VirtualMachine default/test-vm needs more than 1 SubjectAccessReviews")))
+			Expect(ReasonCodeOf(err)).To(Equal(ReasonCodeSARBudgetExceeded))
+		})
+
+		It("should classify a change to an immutable field", func() {
+			validator.ImmutableFields = &ImmutableFieldsChecker{Paths: []string{firmwareUUIDPath}}
+			newVM.Spec.Template.Spec.Domain.Firmware = &kubevirtiov1.Firmware{UUID: "new-uuid"}
+
+			Expect(ReasonCodeOf(validate())).To(Equal(ReasonCodeImmutableField))
+		})
+
+		It("should classify a change to a frozen VM", func() {
+			oldVM.Annotations = map[string]string{FrozenAnnotation: "true"}
+			newVM = oldVM.DeepCopy()
+			newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+			Expect(ReasonCodeOf(validate())).To(Equal(ReasonCodeFrozen))
+		})
+
+		It("should classify freezing the VM without full-admin as a control annotation change", func() {
+			newVM.Annotations = map[string]string{FrozenAnnotation: "true"}
+
+			Expect(ReasonCodeOf(validate())).To(Equal(ReasonCodeControlAnnotationForbidden))
+		})
+
+		It("should classify a control annotation change", func() {
+			validator.ControlAnnotationPrefixes = []string{"descheduler.alpha.kubernetes.io/"}
+			mockPerm.permissions["virtualmachines/compute-admin"] = true
+			newVM.Annotations = map[string]string{"descheduler.alpha.kubernetes.io/evict": "true"}
+
+			Expect(ReasonCodeOf(validate())).To(Equal(ReasonCodeControlAnnotationForbidden))
+		})
+
+		It("should classify a user without granular roles in strict mode", func() {
+			validator.StrictMode = true
+			newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+			Expect(ReasonCodeOf(validate())).To(Equal(ReasonCodeStrictNoRole))
+		})
+
+		It("should classify a metadata change", func() {
+			mockPerm.permissions["virtualmachines/compute-admin"] = true
+			newVM.Labels = map[string]string{"team": "a"}
+
+			Expect(ReasonCodeOf(validate())).To(Equal(ReasonCodeMetadataForbidden))
+		})
+
+		It("should classify a template metadata change", func() {
+			mockPerm.permissions["virtualmachines/compute-admin"] = true
+			newVM.Spec.Template.ObjectMeta.Labels = map[string]string{"team": "a"}
+
+			Expect(ReasonCodeOf(validate())).To(Equal(ReasonCodeTemplateMetadataForbidden))
+		})
+
+		It("should classify a change no granular role covers", func() {
+			mockPerm.permissions["virtualmachines/compute-admin"] = true
+			newVM.Spec.Template.Spec.Domain.Chassis = &kubevirtiov1.Chassis{Asset: "asset-1"}
+
+			Expect(ReasonCodeOf(validate())).To(Equal(ReasonCodeFullAdminRequired))
+		})
+
+		It("should classify a change of a single missing category by the category", func() {
+			mockPerm.permissions["virtualmachines/storage-admin"] = true
+			newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+			Expect(ReasonCodeOf(validate())).To(Equal(ReasonCode("COMPUTE_FORBIDDEN")))
+		})
+
+		It("should classify a partially permitted change by the missing category", func() {
+			mockPerm.permissions["virtualmachines/storage-admin"] = true
+			newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+			newVM.Spec.Template.Spec.Volumes = []kubevirtiov1.Volume{{Name: "volume1"}}
+
+			err := validate()
+			Expect(err).To(MatchError(ContainSubstring("permitted storage, missing compute")))
+			Expect(ReasonCodeOf(err)).To(Equal(ReasonCode("COMPUTE_FORBIDDEN")))
+		})
+
+		It("should classify several missing categories", func() {
+			validator.ReportAllMissingPermissions = true
+			mockPerm.permissions["virtualmachines/storage-admin"] = true
+			newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+			newVM.Spec.Template.Spec.Networks = []kubevirtiov1.Network{{Name: "default"}}
+
+			err := validate()
+			Expect(err).To(MatchError("missing permissions for VirtualMachine default/test-vm: network, compute"))
+			Expect(ReasonCodeOf(err)).To(Equal(ReasonCodeCategoriesForbidden))
+		})
+
+		It("should classify a failed permission check as an internal error", func() {
+			mockPerm.shouldError = true
+			newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+			Expect(ReasonCodeOf(validate())).To(Equal(ReasonCodeInternalError))
+		})
+	})
+
+	It("should not classify an allowed update", func() {
+		mockPerm.permissions["virtualmachines/compute-admin"] = true
+		newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+		err := validate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ReasonCodeOf(err)).To(BeEmpty())
+	})
+
+	It("should return denials as Forbidden statuses with the code as the reason and the complete message", func() {
+		validator.MaxSARsPerRequest = 1
+		newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+		err := validate()
+		var status apierrors.APIStatus
+		Expect(errors.As(err, &status)).To(BeTrue())
+		Expect(status.Status().Code).To(BeEquivalentTo(403))
+		Expect(status.Status().Reason).To(Equal(metav1.StatusReason(ReasonCodeSARBudgetExceeded)))
+		Expect(status.Status().Message).To(Equal(err.Error()))
+	})
+
+	It("should derive category codes from the category names", func() {
+		Expect(CategoryReasonCode("compute")).To(Equal(ReasonCode("COMPUTE_FORBIDDEN")))
+		Expect(CategoryReasonCode("cpu-pinning")).To(Equal(ReasonCode("CPU_PINNING_FORBIDDEN")))
+		Expect(CategoryReasonCode("template-metadata")).To(Equal(ReasonCodeTemplateMetadataForbidden))
+	})
+
+	It("should keep the code of a denial wrapped twice", func() {
+		err := fmt.Errorf("outer: %w", fmt.Errorf("inner: %w", denyf(ReasonCodeFrozen, "frozen")))
+		Expect(ReasonCodeOf(withReasonCode(err))).To(Equal(ReasonCodeFrozen))
+		Expect(withReasonCode(err)).To(MatchError("outer: inner: frozen"))
+	})
+})
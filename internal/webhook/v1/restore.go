@@ -0,0 +1,43 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"slices"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+)
+
+// RestoreUIDAnnotation is set on a VM by KubeVirt's VirtualMachineRestore controller to the UID
+// of the restore it applies, in the same update that rewrites the spec from the snapshot
+const RestoreUIDAnnotation = "restore.kubevirt.io/lastRestoreUID"
+
+// isRestoreUpdate reports whether the update applies a VirtualMachineRestore, i.e. sets the
+// restore UID annotation to a new value
+func isRestoreUpdate(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	restoreUID := newVM.Annotations[RestoreUIDAnnotation]
+	return restoreUID != "" && restoreUID != oldVM.Annotations[RestoreUIDAnnotation]
+}
+
+// isAuthorizedRestore reports whether the update is a restore applied by one of the
+// RestoreControllerUsers. Creating the VirtualMachineRestore is authorized separately, so
+// the controller's rewrite of the spec needs no granular permissions. The annotation alone
+// is not trusted: any other user setting it goes through the granular checks.
+func (v *VirtualMachineCustomValidator) isAuthorizedRestore(userInfo authenticationv1.UserInfo, oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	return slices.Contains(v.RestoreControllerUsers, userInfo.Username) && isRestoreUpdate(oldVM, newVM)
+}
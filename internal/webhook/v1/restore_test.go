@@ -0,0 +1,146 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const restoreController = "system:serviceaccount:kubevirt:kubevirt-controller"
+
+var _ = Describe("Restore updates", func() {
+	var (
+		validator *VirtualMachineCustomValidator
+		mockPerm  *MockPermissionChecker
+		oldVM     *kubevirtiov1.VirtualMachine
+		newVM     *kubevirtiov1.VirtualMachine
+	)
+
+	contextFor := func(username string) context.Context {
+		return admission.NewContextWithRequest(context.Background(), admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				UserInfo: authenticationv1.UserInfo{Username: username},
+			},
+		})
+	}
+
+	BeforeEach(func() {
+		mockPerm = &MockPermissionChecker{permissions: make(map[string]bool)}
+		validator = &VirtualMachineCustomValidator{
+			FieldCheckers:          DefaultFieldCheckers(),
+			PermissionChecker:      mockPerm,
+			StrictMode:             true,
+			RestoreControllerUsers: []string{restoreController},
+		}
+		oldVM = &kubevirtiov1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-vm", Namespace: "default"},
+			Spec: kubevirtiov1.VirtualMachineSpec{
+				Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+					Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+						Domain: kubevirtiov1.DomainSpec{CPU: &kubevirtiov1.CPU{Cores: 2}},
+					},
+				},
+			},
+		}
+
+		// A restore rewrites the spec from the snapshot and records the restore
+		newVM = oldVM.DeepCopy()
+		newVM.Annotations = map[string]string{RestoreUIDAnnotation: "restore-uid-1"}
+		newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+		newVM.Spec.Template.Spec.Volumes = []kubevirtiov1.Volume{{
+			Name:         "rootdisk",
+			VolumeSource: kubevirtiov1.VolumeSource{DataVolume: &kubevirtiov1.DataVolumeSource{Name: "restore-rootdisk"}},
+		}}
+	})
+
+	Describe("isRestoreUpdate", func() {
+		It("should detect a new restore UID", func() {
+			Expect(isRestoreUpdate(oldVM, newVM)).To(BeTrue())
+		})
+
+		It("should detect a restore replacing an earlier one", func() {
+			oldVM.Annotations = map[string]string{RestoreUIDAnnotation: "restore-uid-0"}
+			Expect(isRestoreUpdate(oldVM, newVM)).To(BeTrue())
+		})
+
+		It("should ignore an unchanged restore UID", func() {
+			oldVM.Annotations = map[string]string{RestoreUIDAnnotation: "restore-uid-1"}
+			Expect(isRestoreUpdate(oldVM, newVM)).To(BeFalse())
+		})
+
+		It("should ignore updates without a restore UID", func() {
+			delete(newVM.Annotations, RestoreUIDAnnotation)
+			Expect(isRestoreUpdate(oldVM, newVM)).To(BeFalse())
+		})
+	})
+
+	It("should allow a restore by the controller without any permission check", func() {
+		_, err := validator.ValidateUpdate(contextFor(restoreController), oldVM, newVM)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(mockPerm.calls).To(BeZero())
+	})
+
+	It("should allow a restore by the controller holding full-admin", func() {
+		mockPerm.permissions["virtualmachines/full-admin"] = true
+
+		_, err := validator.ValidateUpdate(contextFor(restoreController), oldVM, newVM)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should check a user setting the restore annotation like any other update", func() {
+		mockPerm.permissions["virtualmachines/storage-admin"] = true
+
+		_, err := validator.ValidateUpdate(contextFor("test-user"), oldVM, newVM)
+		Expect(err).To(HaveOccurred())
+		Expect(mockPerm.calls).ToNot(BeZero())
+	})
+
+	It("should check updates of the controller that are not restores", func() {
+		delete(newVM.Annotations, RestoreUIDAnnotation)
+
+		_, err := validator.ValidateUpdate(contextFor(restoreController), oldVM, newVM)
+		Expect(err).To(MatchError(ContainSubstring("no applicable VM subresource permission granted")))
+	})
+
+	It("should check restores when the bypass is disabled", func() {
+		validator.RestoreControllerUsers = nil
+
+		_, err := validator.ValidateUpdate(contextFor(restoreController), oldVM, newVM)
+		Expect(err).To(MatchError(ContainSubstring("no applicable VM subresource permission granted")))
+	})
+
+	It("should still deny a restore by a member of a denied group", func() {
+		validator.DenyGroups = []string{"quarantined"}
+		ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				UserInfo: authenticationv1.UserInfo{Username: restoreController, Groups: []string{"quarantined"}},
+			},
+		})
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).To(MatchError(ContainSubstring("denied group")))
+	})
+})
@@ -0,0 +1,35 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// sarBudget counts the SubjectAccessReviews of one admission request against a limit
+type sarBudget struct {
+	// limit is the maximum number of reviews (0 does not limit)
+	limit int
+	used  int
+	vmRef string
+}
+
+// spend accounts for n more reviews, failing without spending them if they would exceed the limit
+func (b *sarBudget) spend(n int) error {
+	if b.limit > 0 && b.used+n > b.limit {
+		return denyf(ReasonCodeSARBudgetExceeded, "update of VirtualMachine %s needs more than %d SubjectAccessReviews, "+
+			"it changes categories with too many distinct subresources", b.vmRef, b.limit)
+	}
+	b.used += n
+	return nil
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var _ = Describe("SubjectAccessReview groups", func() {
+	var (
+		userInfo authenticationv1.UserInfo
+		reviewed []string
+	)
+
+	BeforeEach(func() {
+		userInfo = authenticationv1.UserInfo{Username: "test-user"}
+		reviewed = nil
+	})
+
+	// grantedInSubresourcesGroup reports whether the review asks for storage-admin in
+	// subresources.kubevirt.io, the only grant of the fake clients
+	grantedInSubresourcesGroup := func(attributes *authv1.ResourceAttributes) bool {
+		reviewed = append(reviewed, attributes.Group+" "+attributes.Verb)
+		return attributes.Group == "subresources.kubevirt.io" && attributes.Verb == "update" &&
+			attributes.Resource == "virtualmachines/storage-admin"
+	}
+
+	subresourcesGroupClient := func() client.Client {
+		return fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				sar := obj.(*authv1.SubjectAccessReview)
+				sar.Status.Allowed = grantedInSubresourcesGroup(sar.Spec.ResourceAttributes)
+				return nil
+			},
+		}).Build()
+	}
+
+	It("should check only kubevirt.io by default", func() {
+		checker := &SubjectAccessReviewPermissionChecker{Client: subresourcesGroupClient()}
+
+		allowed, err := checker.CheckPermission(context.Background(), userInfo, "default", "test-vm", "virtualmachines/storage-admin")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+		Expect(reviewed).To(Equal([]string{"kubevirt.io update"}))
+	})
+
+	It("should recognize a grant under subresources.kubevirt.io when configured", func() {
+		checker := &SubjectAccessReviewPermissionChecker{
+			Client: subresourcesGroupClient(),
+			Groups: []string{"kubevirt.io", "subresources.kubevirt.io"},
+		}
+
+		allowed, err := checker.CheckPermission(context.Background(), userInfo, "default", "test-vm", "virtualmachines/storage-admin")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		Expect(reviewed).To(Equal([]string{"kubevirt.io update", "subresources.kubevirt.io update"}))
+	})
+
+	It("should check every verb in every group", func() {
+		checker := &SubjectAccessReviewPermissionChecker{
+			Client: subresourcesGroupClient(),
+			Groups: []string{"kubevirt.io", "subresources.kubevirt.io"},
+			Verbs:  []string{"patch", "update"},
+		}
+
+		allowed, err := checker.CheckPermission(context.Background(), userInfo, "default", "test-vm", "virtualmachines/compute-admin")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+		Expect(reviewed).To(Equal([]string{
+			"kubevirt.io patch", "kubevirt.io update", "subresources.kubevirt.io patch", "subresources.kubevirt.io update",
+		}))
+	})
+
+	It("should check the configured groups with the typed client", func() {
+		clientset := fake.NewClientset()
+		clientset.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			attributes := action.(k8stesting.CreateAction).GetObject().(*authv1.SubjectAccessReview).Spec.ResourceAttributes
+			return true, &authv1.SubjectAccessReview{Status: authv1.SubjectAccessReviewStatus{Allowed: grantedInSubresourcesGroup(attributes)}}, nil
+		})
+		checker := &TypedSubjectAccessReviewPermissionChecker{
+			Client: clientset.AuthorizationV1().SubjectAccessReviews(),
+			Groups: []string{"subresources.kubevirt.io"},
+		}
+
+		allowed, err := checker.CheckPermission(context.Background(), userInfo, "default", "test-vm", "virtualmachines/storage-admin")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		Expect(reviewed).To(Equal([]string{"subresources.kubevirt.io update"}))
+	})
+})
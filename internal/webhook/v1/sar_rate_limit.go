@@ -0,0 +1,58 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/time/rate"
+)
+
+// NewSARRateLimiter returns a token bucket allowing qps SubjectAccessReviews per second with
+// bursts of up to burst reviews, or nil (unlimited) when qps is not positive
+func NewSARRateLimiter(qps float64, burst int) *rate.Limiter {
+	if qps <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(qps), max(burst, 1))
+}
+
+// createRateLimited calls create, which sends one SubjectAccessReview, under the retry policy,
+// taking a limiter token before every attempt
+func createRateLimited(ctx context.Context, retry RetryPolicy, limiter *rate.Limiter, create func() error) error {
+	return retry.do(ctx, func() error {
+		if err := waitForSARToken(ctx, limiter); err != nil {
+			return err
+		}
+		return create()
+	})
+}
+
+// waitForSARToken blocks until the limiter allows another SubjectAccessReview. It fails right
+// away if the context is done or its deadline would pass before a token is available, so the
+// admission request fails per the webhook's failure policy instead of hanging. A nil limiter
+// never blocks.
+func waitForSARToken(ctx context.Context, limiter *rate.Limiter) error {
+	if limiter == nil {
+		return nil
+	}
+	if err := limiter.Wait(ctx); err != nil {
+		return fmt.Errorf("SubjectAccessReview rate limit exceeded: %w", err)
+	}
+	return nil
+}
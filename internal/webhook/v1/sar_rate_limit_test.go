@@ -0,0 +1,121 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var _ = Describe("SubjectAccessReview rate limiting", func() {
+	var (
+		userInfo authenticationv1.UserInfo
+		attempts int
+		checker  *SubjectAccessReviewPermissionChecker
+	)
+
+	BeforeEach(func() {
+		userInfo = authenticationv1.UserInfo{Username: "test-user"}
+		attempts = 0
+		checker = &SubjectAccessReviewPermissionChecker{
+			Client: fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					attempts++
+					obj.(*authv1.SubjectAccessReview).Status.Allowed = true
+					return nil
+				},
+			}).Build(),
+		}
+	})
+
+	check := func(ctx context.Context) error {
+		_, err := checker.CheckPermission(ctx, userInfo, "default", "test-vm", "virtualmachines/storage-admin")
+		return err
+	}
+
+	It("should not limit without a rate", func() {
+		Expect(NewSARRateLimiter(0, 10)).To(BeNil())
+
+		for range 100 {
+			Expect(check(context.Background())).To(Succeed())
+		}
+		Expect(attempts).To(Equal(100))
+	})
+
+	It("should allow a burst and then throttle to the rate", func() {
+		checker.Limiter = NewSARRateLimiter(20, 2)
+
+		start := time.Now()
+		for range 4 {
+			Expect(check(context.Background())).To(Succeed())
+		}
+		// The burst is free, the two reviews beyond it wait 50ms each
+		Expect(time.Since(start)).To(BeNumerically(">=", 90*time.Millisecond))
+		Expect(attempts).To(Equal(4))
+	})
+
+	It("should fail without creating a review when the deadline passes before a token", func() {
+		checker.Limiter = NewSARRateLimiter(0.1, 1)
+		Expect(check(context.Background())).To(Succeed())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+
+		Expect(check(ctx)).To(MatchError(ContainSubstring("SubjectAccessReview rate limit exceeded")))
+		Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+		Expect(attempts).To(Equal(1))
+	})
+
+	It("should stop waiting when the context is canceled", func() {
+		checker.Limiter = NewSARRateLimiter(0.1, 1)
+		Expect(check(context.Background())).To(Succeed())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		Expect(check(ctx)).To(MatchError(ContainSubstring("SubjectAccessReview rate limit exceeded")))
+		Expect(attempts).To(Equal(1))
+	})
+
+	It("should take a token for every retry", func() {
+		checker.Limiter = NewSARRateLimiter(0.1, 2)
+		checker.Retry = RetryPolicy{Retries: 3, Backoff: time.Millisecond}
+		checker.Client = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				attempts++
+				return apierrors.NewServiceUnavailable("apiserver restarting")
+			},
+		}).Build()
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		Expect(check(ctx)).To(HaveOccurred())
+		Expect(attempts).To(Equal(2))
+	})
+})
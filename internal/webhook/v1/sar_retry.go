@@ -0,0 +1,79 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// RetryPolicy bounds how often a SubjectAccessReview is retried after a transient failure.
+// The zero value does not retry.
+type RetryPolicy struct {
+	// Retries is the number of retries after the first attempt
+	Retries int
+
+	// Backoff is the wait before the first retry, doubled before every further retry
+	Backoff time.Duration
+}
+
+// do calls create until it succeeds, fails with a non-retriable error, or the retries are
+// exhausted, and returns the last error. It never waits past the context deadline: if the
+// next backoff would end after it, the last error is returned right away.
+func (r RetryPolicy) do(ctx context.Context, create func() error) error {
+	backoff := r.Backoff
+	for attempt := 0; ; attempt++ {
+		err := create()
+		if err == nil || attempt >= r.Retries || !isRetriable(err) {
+			return err
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < backoff {
+			return err
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
+
+// isRetriable reports whether a SubjectAccessReview failure is transient: timeouts,
+// throttling (429) and server errors (5xx). Anything else, such as forbidden or a
+// malformed review, fails immediately.
+func isRetriable(err error) bool {
+	if apierrors.IsTimeout(err) || apierrors.IsServerTimeout(err) || apierrors.IsTooManyRequests(err) {
+		return true
+	}
+
+	var status apierrors.APIStatus
+	if errors.As(err, &status) && status.Status().Code >= http.StatusInternalServerError {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
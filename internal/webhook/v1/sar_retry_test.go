@@ -0,0 +1,167 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var _ = Describe("SubjectAccessReview retries", func() {
+	var (
+		userInfo authenticationv1.UserInfo
+		retry    RetryPolicy
+		sarGR    schema.GroupResource
+	)
+
+	BeforeEach(func() {
+		userInfo = authenticationv1.UserInfo{Username: "test-user"}
+		retry = RetryPolicy{Retries: 3, Backoff: time.Millisecond}
+		sarGR = authv1.Resource("subjectaccessreviews")
+	})
+
+	// failingClient fails the first failures SubjectAccessReview creations with err, then allows
+	failingClient := func(failures int, err error, attempts *int) client.Client {
+		return fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				*attempts++
+				if *attempts <= failures {
+					return err
+				}
+				obj.(*authv1.SubjectAccessReview).Status.Allowed = true
+				return nil
+			},
+		}).Build()
+	}
+
+	It("should retry transient failures until the review succeeds", func() {
+		var attempts int
+		checker := &SubjectAccessReviewPermissionChecker{
+			Client: failingClient(2, apierrors.NewServiceUnavailable("apiserver restarting"), &attempts),
+			Retry:  retry,
+		}
+
+		allowed, err := checker.CheckPermission(context.Background(), userInfo, "default", "test-vm", "virtualmachines/storage-admin")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		Expect(attempts).To(Equal(3))
+	})
+
+	It("should retry throttling and timeouts", func() {
+		for _, transient := range []error{
+			apierrors.NewTooManyRequests("slow down", 1),
+			apierrors.NewTimeoutError("timed out", 1),
+			apierrors.NewInternalError(context.DeadlineExceeded),
+		} {
+			var attempts int
+			checker := &SubjectAccessReviewPermissionChecker{Client: failingClient(1, transient, &attempts), Retry: retry}
+
+			allowed, err := checker.CheckPermission(context.Background(), userInfo, "default", "test-vm", "virtualmachines/storage-admin")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allowed).To(BeTrue())
+			Expect(attempts).To(Equal(2))
+		}
+	})
+
+	It("should give up once the retries are exhausted", func() {
+		var attempts int
+		checker := &SubjectAccessReviewPermissionChecker{
+			Client: failingClient(10, apierrors.NewServiceUnavailable("apiserver restarting"), &attempts),
+			Retry:  retry,
+		}
+
+		_, err := checker.CheckPermission(context.Background(), userInfo, "default", "test-vm", "virtualmachines/storage-admin")
+		Expect(err).To(MatchError(ContainSubstring("failed to create SubjectAccessReview")))
+		Expect(apierrors.IsServiceUnavailable(err)).To(BeTrue())
+		Expect(attempts).To(Equal(4))
+	})
+
+	It("should fail immediately on non-retriable errors", func() {
+		for _, permanent := range []error{
+			apierrors.NewForbidden(sarGR, "", nil),
+			apierrors.NewBadRequest("malformed review"),
+		} {
+			var attempts int
+			checker := &SubjectAccessReviewPermissionChecker{Client: failingClient(1, permanent, &attempts), Retry: retry}
+
+			_, err := checker.CheckPermission(context.Background(), userInfo, "default", "test-vm", "virtualmachines/storage-admin")
+			Expect(err).To(HaveOccurred())
+			Expect(attempts).To(Equal(1))
+		}
+	})
+
+	It("should not retry without a retry policy", func() {
+		var attempts int
+		checker := &SubjectAccessReviewPermissionChecker{
+			Client: failingClient(1, apierrors.NewServiceUnavailable("apiserver restarting"), &attempts),
+		}
+
+		_, err := checker.CheckPermission(context.Background(), userInfo, "default", "test-vm", "virtualmachines/storage-admin")
+		Expect(err).To(HaveOccurred())
+		Expect(attempts).To(Equal(1))
+	})
+
+	It("should not wait past the context deadline", func() {
+		var attempts int
+		checker := &SubjectAccessReviewPermissionChecker{
+			Client: failingClient(1, apierrors.NewServiceUnavailable("apiserver restarting"), &attempts),
+			Retry:  RetryPolicy{Retries: 3, Backoff: time.Hour},
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		_, err := checker.CheckPermission(ctx, userInfo, "default", "test-vm", "virtualmachines/storage-admin")
+		Expect(err).To(HaveOccurred())
+		Expect(attempts).To(Equal(1))
+	})
+
+	It("should retry transient failures with the typed client", func() {
+		var attempts int
+		clientset := fake.NewClientset()
+		clientset.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			attempts++
+			if attempts <= 2 {
+				return true, nil, apierrors.NewInternalError(context.DeadlineExceeded)
+			}
+			return true, &authv1.SubjectAccessReview{Status: authv1.SubjectAccessReviewStatus{Allowed: true}}, nil
+		})
+		checker := &TypedSubjectAccessReviewPermissionChecker{
+			Client: clientset.AuthorizationV1().SubjectAccessReviews(),
+			Retry:  retry,
+		}
+
+		allowed, err := checker.CheckPermission(context.Background(), userInfo, "default", "test-vm", "virtualmachines/storage-admin")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		Expect(attempts).To(Equal(3))
+	})
+})
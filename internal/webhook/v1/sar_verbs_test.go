@@ -0,0 +1,113 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var _ = Describe("SubjectAccessReview verbs", func() {
+	var (
+		userInfo authenticationv1.UserInfo
+		verbs    []string
+	)
+
+	BeforeEach(func() {
+		userInfo = authenticationv1.UserInfo{Username: "test-user"}
+		verbs = nil
+	})
+
+	// patchOnlyClient grants only the patch verb on storage-admin and records every verb reviewed
+	patchOnlyClient := func() client.Client {
+		return fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				sar := obj.(*authv1.SubjectAccessReview)
+				attributes := sar.Spec.ResourceAttributes
+				verbs = append(verbs, attributes.Verb)
+				sar.Status.Allowed = attributes.Verb == "patch" && attributes.Resource == "virtualmachines/storage-admin"
+				return nil
+			},
+		}).Build()
+	}
+
+	It("should check only update by default", func() {
+		checker := &SubjectAccessReviewPermissionChecker{Client: patchOnlyClient()}
+
+		allowed, err := checker.CheckPermission(context.Background(), userInfo, "default", "test-vm", "virtualmachines/storage-admin")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+		Expect(verbs).To(Equal([]string{"update"}))
+	})
+
+	It("should grant a subresource when any configured verb is allowed", func() {
+		checker := &SubjectAccessReviewPermissionChecker{Client: patchOnlyClient(), Verbs: []string{"update", "patch"}}
+
+		allowed, err := checker.CheckPermission(context.Background(), userInfo, "default", "test-vm", "virtualmachines/storage-admin")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		Expect(verbs).To(Equal([]string{"update", "patch"}))
+	})
+
+	It("should stop at the first allowed verb", func() {
+		checker := &SubjectAccessReviewPermissionChecker{Client: patchOnlyClient(), Verbs: []string{"patch", "update"}}
+
+		allowed, err := checker.CheckPermission(context.Background(), userInfo, "default", "test-vm", "virtualmachines/storage-admin")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		Expect(verbs).To(Equal([]string{"patch"}))
+	})
+
+	It("should deny a subresource when no configured verb is allowed", func() {
+		checker := &SubjectAccessReviewPermissionChecker{Client: patchOnlyClient(), Verbs: []string{"update", "patch"}}
+
+		allowed, err := checker.CheckPermission(context.Background(), userInfo, "default", "test-vm", "virtualmachines/compute-admin")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+		Expect(verbs).To(Equal([]string{"update", "patch"}))
+	})
+
+	It("should check the configured verbs with the typed client", func() {
+		clientset := fake.NewClientset()
+		clientset.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			verb := action.(k8stesting.CreateAction).GetObject().(*authv1.SubjectAccessReview).Spec.ResourceAttributes.Verb
+			verbs = append(verbs, verb)
+			return true, &authv1.SubjectAccessReview{Status: authv1.SubjectAccessReviewStatus{Allowed: verb == "patch"}}, nil
+		})
+		checker := &TypedSubjectAccessReviewPermissionChecker{
+			Client: clientset.AuthorizationV1().SubjectAccessReviews(),
+			Verbs:  []string{"update", "patch"},
+		}
+
+		permissions, err := checker.PrefetchPermissions(context.Background(), userInfo, "default", "test-vm", []string{"virtualmachines/storage-admin"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(permissions).To(Equal(map[string]bool{"virtualmachines/storage-admin": true}))
+		Expect(verbs).To(Equal([]string{"update", "patch"}))
+	})
+})
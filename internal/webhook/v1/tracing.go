@@ -0,0 +1,103 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+)
+
+// tracerName identifies the spans emitted by the webhook
+const tracerName = "kubevirt.io/kubevirt-rbac-webhook"
+
+// Span attribute keys recorded on the admission decision spans.
+const (
+	TraceAttributeUser              = "kubevirt.rbac.user"
+	TraceAttributeVM                = "kubevirt.rbac.vm"
+	TraceAttributeCategoriesChanged = "kubevirt.rbac.categories_changed"
+	TraceAttributeDecision          = "kubevirt.rbac.decision"
+	TraceAttributeSubresource       = "kubevirt.rbac.subresource"
+	TraceAttributeAllowed           = "kubevirt.rbac.allowed"
+	TraceAttributeGranted           = "kubevirt.rbac.granted"
+)
+
+// tracer returns the tracer of the validator's TracerProvider, or of the global provider,
+// which is a no-op unless an exporter was installed at startup
+func (v *VirtualMachineCustomValidator) tracer() trace.Tracer {
+	provider := v.TracerProvider
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+	return provider.Tracer(tracerName)
+}
+
+// endDecisionSpan records the decision on the ValidateUpdate span and ends it
+func endDecisionSpan(span trace.Span, decisionErr error) {
+	if decisionErr != nil {
+		span.SetAttributes(attribute.String(TraceAttributeDecision, "denied"))
+		span.SetStatus(codes.Error, decisionErr.Error())
+	} else {
+		span.SetAttributes(attribute.String(TraceAttributeDecision, "allowed"))
+	}
+	span.End()
+}
+
+// tracedCheckPermission runs a single permission check in its own span, recording the
+// subresource and the outcome
+func (v *VirtualMachineCustomValidator) tracedCheckPermission(ctx context.Context, subresource string, check func(ctx context.Context) (bool, error)) (bool, error) {
+	ctx, span := v.tracer().Start(ctx, "SubjectAccessReview",
+		trace.WithAttributes(attribute.String(TraceAttributeSubresource, subresource)))
+	defer span.End()
+
+	allowed, err := check(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return false, err
+	}
+	span.SetAttributes(attribute.Bool(TraceAttributeAllowed, allowed))
+	return allowed, nil
+}
+
+// prefetchPermissions runs PermissionChecker.PrefetchPermissions in a span recording the
+// subresources reviewed and which of them were granted
+func (v *VirtualMachineCustomValidator) prefetchPermissions(ctx context.Context, userInfo authenticationv1.UserInfo, vm *kubevirtiov1.VirtualMachine, subresources []string) (map[string]bool, error) {
+	ctx, span := v.tracer().Start(ctx, "PrefetchPermissions",
+		trace.WithAttributes(attribute.StringSlice(TraceAttributeSubresource, subresources)))
+	defer span.End()
+
+	permissions, err := v.PermissionChecker.PrefetchPermissions(ctx, userInfo, vm.Namespace, vm.Name, subresources)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	var granted []string
+	for _, subresource := range subresources {
+		if permissions[subresource] {
+			granted = append(granted, subresource)
+		}
+	}
+	span.SetAttributes(attribute.StringSlice(TraceAttributeGranted, granted))
+	return permissions, nil
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("Tracing", func() {
+	var (
+		exporter  *tracetest.InMemoryExporter
+		validator *VirtualMachineCustomValidator
+		mockPerm  *MockPermissionChecker
+		oldVM     *kubevirtiov1.VirtualMachine
+		newVM     *kubevirtiov1.VirtualMachine
+		ctx       context.Context
+	)
+
+	// spanAttributes returns the attributes of the only ended span with the given name
+	spanAttributes := func(name string) map[attribute.Key]attribute.Value {
+		var attributes map[attribute.Key]attribute.Value
+		for _, span := range exporter.GetSpans() {
+			if span.Name != name {
+				continue
+			}
+			Expect(attributes).To(BeNil(), "more than one %s span", name)
+			attributes = make(map[attribute.Key]attribute.Value)
+			for _, kv := range span.Attributes {
+				attributes[kv.Key] = kv.Value
+			}
+		}
+		Expect(attributes).ToNot(BeNil(), "no %s span", name)
+		return attributes
+	}
+
+	BeforeEach(func() {
+		exporter = tracetest.NewInMemoryExporter()
+		provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+		DeferCleanup(provider.Shutdown)
+
+		mockPerm = &MockPermissionChecker{permissions: make(map[string]bool)}
+		validator = &VirtualMachineCustomValidator{
+			FieldCheckers:     DefaultFieldCheckers(),
+			PermissionChecker: mockPerm,
+			TracerProvider:    provider,
+		}
+
+		oldVM = &kubevirtiov1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-vm", Namespace: "default"},
+			Spec: kubevirtiov1.VirtualMachineSpec{
+				Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+					Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+						Domain: kubevirtiov1.DomainSpec{
+							CPU: &kubevirtiov1.CPU{Cores: 2},
+						},
+					},
+				},
+			},
+		}
+		newVM = oldVM.DeepCopy()
+
+		ctx = admission.NewContextWithRequest(context.Background(), admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				UserInfo: authenticationv1.UserInfo{Username: "test-user"},
+			},
+		})
+	})
+
+	It("should record an allowed decision on the ValidateUpdate span", func() {
+		mockPerm.permissions["virtualmachines/compute-admin"] = true
+		newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).ToNot(HaveOccurred())
+
+		attributes := spanAttributes("ValidateUpdate")
+		Expect(attributes).To(HaveKeyWithValue(attribute.Key(TraceAttributeDecision), attribute.StringValue("allowed")))
+		Expect(attributes).To(HaveKeyWithValue(attribute.Key(TraceAttributeUser), attribute.StringValue("test-user")))
+		Expect(attributes).To(HaveKeyWithValue(attribute.Key(TraceAttributeVM), attribute.StringValue("default/test-vm")))
+		Expect(attributes).To(HaveKeyWithValue(attribute.Key(TraceAttributeCategoriesChanged), attribute.StringSliceValue([]string{"compute"})))
+	})
+
+	It("should record a denied decision on the ValidateUpdate span", func() {
+		mockPerm.permissions["virtualmachines/storage-admin"] = true
+		newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).To(HaveOccurred())
+
+		Expect(spanAttributes("ValidateUpdate")).To(HaveKeyWithValue(attribute.Key(TraceAttributeDecision), attribute.StringValue("denied")))
+	})
+
+	It("should trace each SubjectAccessReview as a child of the ValidateUpdate span", func() {
+		mockPerm.permissions["virtualmachines/full-admin"] = true
+		newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).ToNot(HaveOccurred())
+
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(2))
+		sar, decision := spans[0], spans[1]
+		Expect(sar.Name).To(Equal("SubjectAccessReview"))
+		Expect(sar.Parent.SpanID()).To(Equal(decision.SpanContext.SpanID()))
+		Expect(spanAttributes("SubjectAccessReview")).To(And(
+			HaveKeyWithValue(attribute.Key(TraceAttributeSubresource), attribute.StringValue("virtualmachines/full-admin")),
+			HaveKeyWithValue(attribute.Key(TraceAttributeAllowed), attribute.BoolValue(true)),
+		))
+	})
+
+	It("should trace prefetched permissions in a single span", func() {
+		validator.PrefetchPermissions = true
+		mockPerm.permissions["virtualmachines/compute-admin"] = true
+		newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(spanAttributes("PrefetchPermissions")).To(HaveKeyWithValue(
+			attribute.Key(TraceAttributeGranted), attribute.StringSliceValue([]string{"virtualmachines/compute-admin"})))
+		Expect(spanAttributes("ValidateUpdate")).To(HaveKeyWithValue(attribute.Key(TraceAttributeDecision), attribute.StringValue("allowed")))
+	})
+
+	It("should not fail without a tracer provider", func() {
+		validator.TracerProvider = nil
+		mockPerm.permissions["virtualmachines/compute-admin"] = true
+		newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+		_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exporter.GetSpans()).To(BeEmpty())
+	})
+})
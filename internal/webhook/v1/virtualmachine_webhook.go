@@ -19,15 +19,30 @@ package v1
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/time/rate"
 	authenticationv1 "k8s.io/api/authentication/v1"
 	authv1 "k8s.io/api/authorization/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	authorizationv1client "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"k8s.io/client-go/rest"
 	kubevirtiov1 "kubevirt.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -37,45 +52,497 @@ import (
 // log is for logging in this package.
 var virtualmachinelog = logf.Log.WithName("virtualmachine-resource")
 
+// WebhookOptions holds the configurable behavior of the VirtualMachine webhook.
+type WebhookOptions struct {
+	// AllowGroups lists groups whose members are always allowed, without any SubjectAccessReview
+	AllowGroups []string
+
+	// DenyGroups lists groups whose members are always denied (takes precedence over AllowGroups)
+	DenyGroups []string
+
+	// UseTypedSARClient checks permissions with the typed authorization client instead of
+	// the controller-runtime client
+	UseTypedSARClient bool
+
+	// ReportAllMissingPermissions lists every unauthorized category in denial messages
+	ReportAllMissingPermissions bool
+
+	// StrictMode denies users without full-admin or any subresource permission
+	StrictMode bool
+
+	// DisabledCheckers lists FieldPermissionChecker names that are not registered;
+	// their categories fall through to the generic spec/metadata handling
+	DisabledCheckers []string
+
+	// MaxObjectBytes denies updates whose serialized old or new object is larger (0 disables the guard)
+	MaxObjectBytes int
+
+	// Path overrides the admission path of the VirtualMachine validator
+	// (default: /validate-kubevirt-io-v1-virtualmachine, derived from the GVK)
+	Path string
+
+	// RequireComputeLiveAdmin requires virtualmachines/compute-live-admin for compute
+	// changes to running VMs
+	RequireComputeLiveAdmin bool
+
+	// RequireInputAdmin requires virtualmachines/input-admin for input device type/bus
+	// changes, which devices-admin then no longer covers
+	RequireInputAdmin bool
+
+	// RequireFilesystemRemovalAdmin requires virtualmachines/filesystem-removal-admin for
+	// removing virtio-fs filesystems, which filesystem-admin and filesystem-user then no longer cover
+	RequireFilesystemRemovalAdmin bool
+
+	// RequireFullAdminForDeviceRemovals requires virtualmachines/full-admin for removing GPUs
+	// and host devices, which devices-admin then no longer covers
+	RequireFullAdminForDeviceRemovals bool
+
+	// PrefetchPermissions resolves full-admin and every category subresource in one
+	// concurrent sweep instead of one SubjectAccessReview after another
+	PrefetchPermissions bool
+
+	// FullAdminFromCategories treats users granted every category subresource like full-admin
+	FullAdminFromCategories bool
+
+	// LabelGrantsConfigMap names the ConfigMap holding label-based grants, read once at setup
+	// (empty name disables label grants)
+	LabelGrantsConfigMap types.NamespacedName
+
+	// OwnerDelegation grants every category on VMs with a controller owner to users that may
+	// update the owner
+	OwnerDelegation bool
+
+	// LiveOldObject reads the VM from the API server and uses it as the old state,
+	// instead of trusting the AdmissionReview's oldObject
+	LiveOldObject bool
+
+	// InstancetypeAware compares the specs of instancetype-backed VMs with the instancetype's
+	// CPU and memory settings filled in, and lets instancetype-admin switch the instancetype
+	InstancetypeAware bool
+
+	// EnforcedNamespaces limits enforcement to these namespaces (empty enforces every namespace)
+	EnforcedNamespaces []string
+
+	// ExemptNamespaces are never enforced; exemption takes precedence over EnforcedNamespaces
+	ExemptNamespaces []string
+
+	// PendingEnforcementFields lists field paths whose changes produce a warning, not a denial
+	PendingEnforcementFields []string
+
+	// SARRetry retries SubjectAccessReviews that fail with a transient error
+	SARRetry RetryPolicy
+
+	// SARVerbs are the verbs checked on subresources, any of which grants them (empty checks update)
+	SARVerbs []string
+
+	// SARGroups are the API groups subresources are checked in, any of which grants them
+	// (empty checks kubevirt.io)
+	SARGroups []string
+
+	// SARQPS limits the SubjectAccessReviews created per second (0 does not limit)
+	SARQPS float64
+
+	// SARBurst is the number of SubjectAccessReviews allowed in a burst above SARQPS
+	SARBurst int
+
+	// MaxSARsPerRequest fails updates that need more subresource SubjectAccessReviews (0 does not limit)
+	MaxSARsPerRequest int
+
+	// MaxChangedCategories requires full-admin for updates changing more categories (0 does not limit)
+	MaxChangedCategories int
+
+	// ControlAnnotationPrefixes lists annotation key prefixes whose changes require full-admin
+	ControlAnnotationPrefixes []string
+
+	// LabelSubresources lists prefix=subresource entries (e.g. topology.kubernetes.io/=virtualmachines/scheduling-admin);
+	// changing a label with the prefix requires the subresource instead of the role covering the metadata
+	LabelSubresources []string
+
+	// ImmutableFields lists field paths no one may change, except full-admin with ImmutableFieldsAllowFullAdmin
+	ImmutableFields []string
+
+	// ImmutableFieldsAllowFullAdmin lets full-admin users change the ImmutableFields
+	ImmutableFieldsAllowFullAdmin bool
+
+	// DefaultArchitecture is the default architecture of the KubeVirt CR (empty uses amd64)
+	DefaultArchitecture string
+
+	// DefaultMachineTypes lists architecture=machine type entries (e.g. amd64=q35) of the KubeVirt CR
+	// (empty uses KubeVirt's defaults)
+	DefaultMachineTypes []string
+
+	// PolicyConfigMap names the ConfigMap holding the reloadable policy, watched for changes
+	// (empty name disables reloading)
+	PolicyConfigMap types.NamespacedName
+
+	// RestoreControllerUsers lists the usernames of the VirtualMachineRestore controller, whose
+	// restore updates skip the granular checks (empty disables the bypass)
+	RestoreControllerUsers []string
+
+	// NamespaceFreeze honors the frozen annotation on namespaces too, reading the VM's
+	// namespace on every update
+	NamespaceFreeze bool
+
+	// UnauthenticatedPolicy decides updates whose admission request has no username (empty denies)
+	UnauthenticatedPolicy UnauthenticatedPolicy
+}
+
+// ValidatorRegistration describes a validating webhook for a single resource type.
+type ValidatorRegistration struct {
+	// Object is the resource type to validate; its GVK derives the default admission path
+	Object runtime.Object
+
+	// Validator validates create/update/delete requests for Object
+	Validator webhook.CustomValidator
+
+	// Path overrides the default /validate-<group>-<version>-<kind> admission path
+	Path string
+}
+
+// RegisterValidators registers a validating webhook for each resource type in the manager,
+// so several validators (e.g. VirtualMachine, VirtualMachineInstance, VirtualMachinePool)
+// can be served by one webhook server.
+// Each handler is wrapped to add audit annotations to the admission response.
+func RegisterValidators(mgr ctrl.Manager, registrations ...ValidatorRegistration) error {
+	for _, registration := range registrations {
+		gvk, err := apiutil.GVKForObject(registration.Object, mgr.GetScheme())
+		if err != nil {
+			return fmt.Errorf("failed to register validating webhook for %T: %w", registration.Object, err)
+		}
+
+		path := registration.Path
+		if path == "" {
+			path = validatePath(gvk)
+		} else if !webhookPathRegex.MatchString(path) {
+			return fmt.Errorf("failed to register validating webhook for %T: path %q does not match %s",
+				registration.Object, path, webhookPathRegex)
+		}
+		if isPathRegistered(mgr, path) {
+			return fmt.Errorf("failed to register validating webhook for %T: path %q is already registered", registration.Object, path)
+		}
+
+		hook := admission.WithCustomValidator(mgr.GetScheme(), registration.Object, registration.Validator)
+		hook.Handler = &auditAnnotationHandler{Handler: hook.Handler}
+		mgr.GetWebhookServer().Register(path, hook)
+	}
+	return nil
+}
+
+// isPathRegistered reports whether the manager's webhook server already serves the path
+func isPathRegistered(mgr ctrl.Manager, path string) bool {
+	// The mux is only created once the first webhook is registered
+	mux := mgr.GetWebhookServer().WebhookMux()
+	if mux == nil {
+		return false
+	}
+	_, pattern := mux.Handler(&http.Request{URL: &url.URL{Path: path}})
+	return pattern == path
+}
+
+// webhookPathRegex matches valid admission paths (same rule as the controller-runtime builder)
+var webhookPathRegex = regexp.MustCompile(`^((/[a-zA-Z0-9-_]+)+|/)$`)
+
+// validatePath returns the default admission path for a GVK, e.g. /validate-kubevirt-io-v1-virtualmachine
+func validatePath(gvk schema.GroupVersionKind) string {
+	return "/validate-" + strings.ReplaceAll(gvk.Group, ".", "-") + "-" + gvk.Version + "-" + strings.ToLower(gvk.Kind)
+}
+
+// DefaultFieldCheckers returns every FieldPermissionChecker in evaluation order.
+// IMPORTANT: Order matters for hierarchical permissions (subset before superset)
+func DefaultFieldCheckers() []FieldPermissionChecker {
+	return []FieldPermissionChecker{
+		// Independent permissions (no hierarchy, can be in any order)
+		&SriovPermissionChecker{},
+		&AutoattachPermissionChecker{},
+		&LifecyclePermissionChecker{},
+		&TemplateMetadataPermissionChecker{},
+		&InstancetypeRevisionPermissionChecker{},
+
+		// Hierarchical permissions (subset before superset)
+		&NetworkPortsPermissionChecker{},    // Subset: Ports of otherwise unchanged interfaces (network-security-admin as superset)
+		&NetworkSecurityPermissionChecker{}, // Subset: MAC/ports/ACPI index of existing interfaces (not covered by network-admin)
+		&BridgePermissionChecker{},          // Subset: Binding changes to or from bridge (not covered by network-admin)
+		&LinkStatePermissionChecker{},       // Subset: Interface link state only
+		&NetworkTuningPermissionChecker{},   // Subset: Interface model only
+		&MultusPermissionChecker{},          // Subset: Multus networks only
+		&NetworkPermissionChecker{},         // Superset: All networking except SR-IOV and security edits
+
+		&InputPermissionChecker{},   // Subset: Input type/bus changes only (required with RequireInputAdmin)
+		&ConsolePermissionChecker{}, // Subset: vGPU display options of existing GPUs only
+		&GPUPermissionChecker{},     // Subset: GPUs only
+		&DevicesPermissionChecker{}, // Superset: GPUs, host devices and other devices
+
+		&CPUPinningPermissionChecker{},   // Carved out of compute: CPU/emulator thread/NUMA placement and IOThreads
+		&CPUTopologyPermissionChecker{},  // Carved out of compute: CPU sockets and threads
+		&HugepagesPermissionChecker{},    // Carved out of compute: memory increases of hugepage-backed VMs
+		&MemoryResizePermissionChecker{}, // Subset: Guest memory size only
+		&MemoryLimitPermissionChecker{},  // Subset: Memory limit only (not requests)
+		&CPUFeaturesPermissionChecker{},  // Subset: Guest CPU feature flags only
+		&ComputePermissionChecker{},      // Superset: CPU, resources and all other memory settings
+
+		&BootPermissionChecker{},              // Subset: Disk boot order and bootloader selection only
+		&CdromUserPermissionChecker{},         // Subset: CD-ROM media only
+		&DedicatedIOThreadPermissionChecker{}, // Subset: Per-disk dedicated IO threads only (performance-admin)
+		&DiskTuningPermissionChecker{},        // Subset: Per-disk cache/IO tuning only
+		&DiskIdentityPermissionChecker{},      // Subset: Per-disk serial numbers only
+		&DiskTagPermissionChecker{},           // Subset: Per-disk tags only (config-admin)
+		&SharedDiskPermissionChecker{},        // Subset: Per-disk shareable and error policy only
+		&FilesystemRemovalPermissionChecker{}, // Subset: Removed virtio-fs filesystems only (required with RequireFilesystemRemovalAdmin)
+		&FilesystemUserPermissionChecker{},    // Subset: PVC-backed virtio-fs filesystems only
+		&FilesystemPermissionChecker{},        // Subset: virtio-fs filesystems only
+		&IdentityPermissionChecker{},          // Subset: secret/downwardAPI volumes; carved out of storage: serviceAccount volumes
+		&ConfigPermissionChecker{},            // Subset: secret/configMap/downwardAPI volumes only
+		&StoragePermissionChecker{},           // Superset: All storage (including CD-ROMs)
+	}
+}
+
+// withoutCheckers drops the named checkers, preserving the order of the rest.
+// Unknown names are rejected so that a typo does not silently keep a checker enabled.
+func withoutCheckers(checkers []FieldPermissionChecker, disabled []string) ([]FieldPermissionChecker, error) {
+	known := make(map[string]bool, len(checkers))
+	for _, checker := range checkers {
+		known[checker.Name()] = true
+	}
+	for _, name := range disabled {
+		if !known[name] {
+			return nil, fmt.Errorf("unknown field permission checker %q", name)
+		}
+	}
+
+	var enabled []FieldPermissionChecker
+	for _, checker := range checkers {
+		if !slices.Contains(disabled, checker.Name()) {
+			enabled = append(enabled, checker)
+		}
+	}
+	return enabled, nil
+}
+
 // SetupVirtualMachineWebhookWithManager registers the webhook for VirtualMachine in the manager.
-func SetupVirtualMachineWebhookWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewWebhookManagedBy(mgr).For(&kubevirtiov1.VirtualMachine{}).
-		WithValidator(&VirtualMachineCustomValidator{
-			Client: mgr.GetClient(),
-			// IMPORTANT: Order matters for hierarchical permissions (subset before superset)
-			FieldCheckers: []FieldPermissionChecker{
-				// Independent permissions (no hierarchy, can be in any order)
-				&NetworkPermissionChecker{},
-				&ComputePermissionChecker{},
-				&DevicesPermissionChecker{},
-				&LifecyclePermissionChecker{},
-
-				// Hierarchical permissions (subset before superset)
-				&CdromUserPermissionChecker{}, // Subset: CD-ROM media only
-				&StoragePermissionChecker{},   // Superset: All storage (including CD-ROMs)
-			},
-			PermissionChecker: &SubjectAccessReviewPermissionChecker{
-				Client: mgr.GetClient(),
-			},
-		}).
-		Complete()
+func SetupVirtualMachineWebhookWithManager(mgr ctrl.Manager, opts WebhookOptions) error {
+	sarLimiter := NewSARRateLimiter(opts.SARQPS, opts.SARBurst)
+	var permissionChecker PermissionChecker = &SubjectAccessReviewPermissionChecker{
+		Client:  mgr.GetClient(),
+		Retry:   opts.SARRetry,
+		Verbs:   opts.SARVerbs,
+		Groups:  opts.SARGroups,
+		Limiter: sarLimiter,
+	}
+	if opts.UseTypedSARClient {
+		typedChecker, err := NewTypedSubjectAccessReviewPermissionChecker(mgr.GetConfig())
+		if err != nil {
+			return err
+		}
+		typedChecker.Retry = opts.SARRetry
+		typedChecker.Verbs = opts.SARVerbs
+		typedChecker.Groups = opts.SARGroups
+		typedChecker.Limiter = sarLimiter
+		permissionChecker = typedChecker
+	}
+
+	switch opts.UnauthenticatedPolicy {
+	case "", UnauthenticatedDeny, UnauthenticatedNoPermissions:
+	default:
+		return fmt.Errorf("unknown unauthenticated policy %q, must be %s or %s",
+			opts.UnauthenticatedPolicy, UnauthenticatedDeny, UnauthenticatedNoPermissions)
+	}
+
+	// Configure every checker, so the reloadable policy can enable the disabled ones
+	configuredCheckers := DefaultFieldCheckers()
+	for _, checker := range configuredCheckers {
+		switch checker := checker.(type) {
+		case *ComputePermissionChecker:
+			checker.RequireLiveAdminWhenRunning = opts.RequireComputeLiveAdmin
+		case *DevicesPermissionChecker:
+			checker.RequireInputAdminForTypeChanges = opts.RequireInputAdmin
+			checker.RequireFullAdminForRemovals = opts.RequireFullAdminForDeviceRemovals
+		case *GPUPermissionChecker:
+			checker.RequireFullAdminForRemovals = opts.RequireFullAdminForDeviceRemovals
+		case *FilesystemPermissionChecker:
+			checker.RequireRemovalAdmin = opts.RequireFilesystemRemovalAdmin
+		case *FilesystemUserPermissionChecker:
+			checker.RequireRemovalAdmin = opts.RequireFilesystemRemovalAdmin
+		case *InstancetypeRevisionPermissionChecker:
+			checker.IncludeInstancetypeChanges = opts.InstancetypeAware
+		}
+	}
+	fieldCheckers, err := withoutCheckers(configuredCheckers, opts.DisabledCheckers)
+	if err != nil {
+		return err
+	}
+	if err := validateCheckerOrder(fieldCheckers); err != nil {
+		return err
+	}
+
+	// The cache is not started yet, so read the ConfigMap directly from the API server
+	var labelGrants []LabelGrant
+	if opts.LabelGrantsConfigMap.Name != "" {
+		labelGrants, err = LoadLabelGrants(context.Background(), mgr.GetAPIReader(), opts.LabelGrantsConfigMap)
+		if err != nil {
+			return err
+		}
+	}
+
+	labelSubresources, err := ParseLabelSubresources(opts.LabelSubresources)
+	if err != nil {
+		return err
+	}
+
+	machineTypes, err := ParseMachineTypes(opts.DefaultMachineTypes)
+	if err != nil {
+		return err
+	}
+
+	var immutableFields *ImmutableFieldsChecker
+	if len(opts.ImmutableFields) > 0 {
+		immutableFields = &ImmutableFieldsChecker{
+			Paths:          opts.ImmutableFields,
+			AllowFullAdmin: opts.ImmutableFieldsAllowFullAdmin,
+		}
+	}
+
+	// Read the live VM directly from the API server, not from a (possibly stale) cache
+	var liveReader client.Reader
+	if opts.LiveOldObject {
+		liveReader = mgr.GetAPIReader()
+	}
+	var namespaceReader client.Reader
+	if opts.NamespaceFreeze {
+		namespaceReader = mgr.GetAPIReader()
+	}
+	var instancetypeReader client.Reader
+	if opts.InstancetypeAware {
+		instancetypeReader = mgr.GetAPIReader()
+	}
+
+	var policy *PolicyStore
+	if opts.PolicyConfigMap.Name != "" {
+		policy, err = setupPolicyReloader(mgr, opts.PolicyConfigMap, Policy{
+			StrictMode:      opts.StrictMode,
+			AllowGroups:     opts.AllowGroups,
+			DenyGroups:      opts.DenyGroups,
+			FieldCheckers:   fieldCheckers,
+			ImmutableFields: immutableFields,
+		}, configuredCheckers)
+		if err != nil {
+			return err
+		}
+	}
+
+	return RegisterValidators(mgr, ValidatorRegistration{
+		Object: &kubevirtiov1.VirtualMachine{},
+		Path:   opts.Path,
+		Validator: &VirtualMachineCustomValidator{
+			Client:      mgr.GetClient(),
+			AllowGroups: opts.AllowGroups,
+			DenyGroups:  opts.DenyGroups,
+
+			ReportAllMissingPermissions: opts.ReportAllMissingPermissions,
+			StrictMode:                  opts.StrictMode,
+			MaxObjectBytes:              opts.MaxObjectBytes,
+			PrefetchPermissions:         opts.PrefetchPermissions,
+			MaxSARsPerRequest:           opts.MaxSARsPerRequest,
+			MaxChangedCategories:        opts.MaxChangedCategories,
+			FullAdminFromCategories:     opts.FullAdminFromCategories,
+			LabelGrants:                 labelGrants,
+			OwnerDelegation:             opts.OwnerDelegation,
+			SARRetry:                    opts.SARRetry,
+			SARLimiter:                  sarLimiter,
+			SARVerbs:                    opts.SARVerbs,
+			LiveReader:                  liveReader,
+			NamespaceReader:             namespaceReader,
+			InstancetypeReader:          instancetypeReader,
+			UnauthenticatedPolicy:       opts.UnauthenticatedPolicy,
+			EnforcedNamespaces:          opts.EnforcedNamespaces,
+			ExemptNamespaces:            opts.ExemptNamespaces,
+			PendingEnforcementFields:    opts.PendingEnforcementFields,
+			ControlAnnotationPrefixes:   opts.ControlAnnotationPrefixes,
+			LabelSubresources:           labelSubresources,
+			ImmutableFields:             immutableFields,
+			ClusterDefaults:             ClusterDefaults{Architecture: opts.DefaultArchitecture, MachineTypes: machineTypes},
+			RestoreControllerUsers:      opts.RestoreControllerUsers,
+			FieldCheckers:               fieldCheckers,
+			PermissionChecker:           permissionChecker,
+			Policy:                      policy,
+		},
+	})
 }
 
 // NOTE: The ValidatingWebhookConfiguration is managed statically via config/webhook/manifests.yaml
 // and deployed with kustomize. This is a simple webhook-only deployment with no controllers or CRDs.
 //
 // +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+// +kubebuilder:rbac:groups="",namespace=system,resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kubevirt.io,resources=virtualmachines,verbs=get
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get
+// +kubebuilder:rbac:groups=instancetype.kubevirt.io,resources=virtualmachineinstancetypes;virtualmachineclusterinstancetypes,verbs=get
 
 // PermissionChecker defines an interface for checking RBAC permissions.
 // This abstraction allows for easier testing by enabling mock implementations.
 type PermissionChecker interface {
 	// CheckPermission checks if a user has permission to update a specific subresource
 	CheckPermission(ctx context.Context, userInfo authenticationv1.UserInfo, namespace, vmName, subresource string) (bool, error)
+
+	// PrefetchPermissions checks several subresources at once and returns, for each of them,
+	// whether the user has permission to update it
+	PrefetchPermissions(ctx context.Context, userInfo authenticationv1.UserInfo, namespace, vmName string, subresources []string) (map[string]bool, error)
+}
+
+// checkConcurrently runs CheckPermission for every distinct subresource in parallel and
+// collects the results. The first error cancels the remaining checks and is returned.
+func checkConcurrently(ctx context.Context, checker PermissionChecker, userInfo authenticationv1.UserInfo, namespace, vmName string, subresources []string) (map[string]bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	// Duplicates are only checked once
+	permissions := make(map[string]bool, len(subresources))
+	for _, subresource := range slices.Compact(slices.Sorted(slices.Values(subresources))) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			allowed, err := checker.CheckPermission(ctx, userInfo, namespace, vmName, subresource)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("failed to check %s permission: %w", subresource, err)
+					cancel()
+				}
+				return
+			}
+			permissions[subresource] = allowed
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return permissions, nil
 }
 
 // SubjectAccessReviewPermissionChecker implements PermissionChecker using Kubernetes SubjectAccessReview.
 type SubjectAccessReviewPermissionChecker struct {
 	Client client.Client
+
+	// Retry retries reviews that fail with a transient error (the zero value does not retry)
+	Retry RetryPolicy
+
+	// Verbs are checked on the subresource in turn, any of them grants it (empty checks update)
+	Verbs []string
+
+	// Groups are the API groups the subresource is checked in, any of them grants it
+	// (empty checks kubevirt.io)
+	Groups []string
+
+	// Limiter throttles review creations, every attempt takes a token (nil does not throttle)
+	Limiter *rate.Limiter
 }
 
 var _ PermissionChecker = &SubjectAccessReviewPermissionChecker{}
@@ -83,27 +550,127 @@ var _ PermissionChecker = &SubjectAccessReviewPermissionChecker{}
 // CheckPermission uses SubjectAccessReview to check if a user has permission for a subresource
 // on a specific VM. This enables resource-name-specific RBAC policies.
 func (p *SubjectAccessReviewPermissionChecker) CheckPermission(ctx context.Context, userInfo authenticationv1.UserInfo, namespace, vmName, subresource string) (bool, error) {
-	sar := &authv1.SubjectAccessReview{
+	return anyAllowed(p.Groups, p.Verbs, func(group, verb string) (bool, error) {
+		sar := newSubjectAccessReview(userInfo, namespace, vmName, group, subresource, verb)
+
+		err := createRateLimited(ctx, p.Retry, p.Limiter, func() error {
+			return p.Client.Create(ctx, sar)
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to create SubjectAccessReview: %w", err)
+		}
+
+		return sar.Status.Allowed, nil
+	})
+}
+
+// PrefetchPermissions sends one SubjectAccessReview per subresource, concurrently
+func (p *SubjectAccessReviewPermissionChecker) PrefetchPermissions(ctx context.Context, userInfo authenticationv1.UserInfo, namespace, vmName string, subresources []string) (map[string]bool, error) {
+	return checkConcurrently(ctx, p, userInfo, namespace, vmName, subresources)
+}
+
+// TypedSubjectAccessReviewPermissionChecker implements PermissionChecker using the typed
+// authorization client directly, bypassing the controller-runtime client machinery.
+type TypedSubjectAccessReviewPermissionChecker struct {
+	Client authorizationv1client.SubjectAccessReviewInterface
+
+	// Retry retries reviews that fail with a transient error (the zero value does not retry)
+	Retry RetryPolicy
+
+	// Verbs are checked on the subresource in turn, any of them grants it (empty checks update)
+	Verbs []string
+
+	// Groups are the API groups the subresource is checked in, any of them grants it
+	// (empty checks kubevirt.io)
+	Groups []string
+
+	// Limiter throttles review creations, every attempt takes a token (nil does not throttle)
+	Limiter *rate.Limiter
+}
+
+var _ PermissionChecker = &TypedSubjectAccessReviewPermissionChecker{}
+
+// NewTypedSubjectAccessReviewPermissionChecker builds a TypedSubjectAccessReviewPermissionChecker from a rest.Config.
+func NewTypedSubjectAccessReviewPermissionChecker(config *rest.Config) (*TypedSubjectAccessReviewPermissionChecker, error) {
+	authClient, err := authorizationv1client.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authorization client: %w", err)
+	}
+
+	return &TypedSubjectAccessReviewPermissionChecker{
+		Client: authClient.SubjectAccessReviews(),
+	}, nil
+}
+
+// CheckPermission uses SubjectAccessReview to check if a user has permission for a subresource
+// on a specific VM, in the same way as SubjectAccessReviewPermissionChecker.
+func (p *TypedSubjectAccessReviewPermissionChecker) CheckPermission(ctx context.Context, userInfo authenticationv1.UserInfo, namespace, vmName, subresource string) (bool, error) {
+	return anyAllowed(p.Groups, p.Verbs, func(group, verb string) (bool, error) {
+		sar := newSubjectAccessReview(userInfo, namespace, vmName, group, subresource, verb)
+
+		var result *authv1.SubjectAccessReview
+		err := createRateLimited(ctx, p.Retry, p.Limiter, func() error {
+			var err error
+			result, err = p.Client.Create(ctx, sar, metav1.CreateOptions{})
+			return err
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to create SubjectAccessReview: %w", err)
+		}
+
+		return result.Status.Allowed, nil
+	})
+}
+
+// PrefetchPermissions sends one SubjectAccessReview per subresource, concurrently
+func (p *TypedSubjectAccessReviewPermissionChecker) PrefetchPermissions(ctx context.Context, userInfo authenticationv1.UserInfo, namespace, vmName string, subresources []string) (map[string]bool, error) {
+	return checkConcurrently(ctx, p, userInfo, namespace, vmName, subresources)
+}
+
+// defaultSARVerb is the verb checked on subresources when no verbs are configured
+const defaultSARVerb = "update"
+
+// defaultSARGroup is the API group subresources are checked in when no groups are configured
+const defaultSARGroup = "kubevirt.io"
+
+// anyAllowed checks every verb in every group in turn and reports whether any of them is
+// allowed, stopping at the first allowed pair or error. No groups checks defaultSARGroup,
+// no verbs checks defaultSARVerb.
+func anyAllowed(groups, verbs []string, check func(group, verb string) (bool, error)) (bool, error) {
+	if len(groups) == 0 {
+		groups = []string{defaultSARGroup}
+	}
+	if len(verbs) == 0 {
+		verbs = []string{defaultSARVerb}
+	}
+	for _, group := range groups {
+		for _, verb := range verbs {
+			allowed, err := check(group, verb)
+			if err != nil || allowed {
+				return allowed, err
+			}
+		}
+	}
+	return false, nil
+}
+
+// newSubjectAccessReview builds the SubjectAccessReview asking whether the user may
+// perform the verb on the given subresource of a specific VM, in the given API group
+func newSubjectAccessReview(userInfo authenticationv1.UserInfo, namespace, vmName, group, subresource, verb string) *authv1.SubjectAccessReview {
+	return &authv1.SubjectAccessReview{
 		Spec: authv1.SubjectAccessReviewSpec{
 			User:   userInfo.Username,
 			Groups: userInfo.Groups,
 			UID:    userInfo.UID,
 			ResourceAttributes: &authv1.ResourceAttributes{
 				Namespace: namespace,
-				Verb:      "update",
-				Group:     "kubevirt.io",
+				Verb:      verb,
+				Group:     group,
 				Resource:  subresource,
 				Name:      vmName,
 			},
 		},
 	}
-
-	err := p.Client.Create(ctx, sar)
-	if err != nil {
-		return false, fmt.Errorf("failed to create SubjectAccessReview: %w", err)
-	}
-
-	return sar.Status.Allowed, nil
 }
 
 // VirtualMachineCustomValidator struct is responsible for validating the VirtualMachine resource
@@ -115,10 +682,196 @@ type VirtualMachineCustomValidator struct {
 	Client            client.Client
 	FieldCheckers     []FieldPermissionChecker
 	PermissionChecker PermissionChecker
+
+	// AllowGroups short-circuits to allow for members of any of these groups (break-glass)
+	AllowGroups []string
+
+	// DenyGroups short-circuits to deny for members of any of these groups (quarantine)
+	// Deny takes precedence over allow when a user is a member of both
+	DenyGroups []string
+
+	// ReportAllMissingPermissions denies with the complete list of changed categories
+	// the user lacks permission for, instead of a generic message
+	ReportAllMissingPermissions bool
+
+	// StrictMode disables the backwards-compatible allow for users without any
+	// subresource permissions (deny by default)
+	StrictMode bool
+
+	// MaxObjectBytes bounds the serialized size of the old and new objects, so oversized
+	// VMs are rejected before any DeepCopy/DeepEqual work (0 disables the guard)
+	MaxObjectBytes int
+
+	// FullAdminFromCategories allows unrestricted changes, like virtualmachines/full-admin, to
+	// users granted every subresource the field checkers may require, for clusters that grant
+	// all category roles instead of defining a full-admin role
+	FullAdminFromCategories bool
+
+	// PrefetchPermissions resolves full-admin and every category subresource up front with
+	// PermissionChecker.PrefetchPermissions, trading SubjectAccessReviews that a full-admin
+	// would not need for a single round of latency
+	PrefetchPermissions bool
+
+	// DecisionHook is notified of every update decision (nil disables notifications)
+	DecisionHook DecisionHook
+
+	// LabelGrants grant subresources based on the VM's labels, in addition to SubjectAccessReviews
+	LabelGrants []LabelGrant
+
+	// OwnerDelegation grants every category subresource (not full-admin) on a VM with a
+	// controller owner, e.g. a VirtualMachinePool, to users that may update the owner.
+	// The owner is reviewed through Client, whose RESTMapper must know the owner's kind, with
+	// SARRetry, SARLimiter and SARVerbs like the subresources of the PermissionChecker.
+	OwnerDelegation bool
+
+	// SARRetry retries owner reviews that fail with a transient error (the zero value does not retry)
+	SARRetry RetryPolicy
+
+	// SARLimiter throttles owner reviews, sharing its tokens with the PermissionChecker's
+	// reviews (nil does not throttle)
+	SARLimiter *rate.Limiter
+
+	// SARVerbs are checked on the owner in turn, any of them grants it (empty checks update)
+	SARVerbs []string
+
+	// UnauthenticatedPolicy decides updates whose admission request has no username
+	// (default: Deny)
+	UnauthenticatedPolicy UnauthenticatedPolicy
+
+	// MaxSARsPerRequest fails an update that would need more subresource SubjectAccessReviews
+	// (0 does not limit). Each subresource counts once, whatever the number of SARVerbs and
+	// SARGroups it is checked with. The reviews of unchanged categories, which only confirm
+	// that a user holds no granular role, do not count.
+	MaxSARsPerRequest int
+
+	// MaxChangedCategories requires full-admin for an update that changes more categories, even
+	// if the user holds the role of each: changing that many at once is usually a wholesale
+	// rewrite of the VM rather than a scoped change (0 does not limit). A category counts if
+	// its checker still sees a change once the subsets before it neutralized theirs.
+	MaxChangedCategories int
+
+	// MissingRequestPolicy decides updates validated without an admission request in the
+	// context, e.g. when the validator is embedded outside the webhook server (default: Fail)
+	MissingRequestPolicy MissingRequestPolicy
+
+	// LiveReader, when set, is used to GET the stored VM as the old state for diffing,
+	// guarding against a stale or incomplete oldObject (nil trusts the AdmissionReview)
+	LiveReader client.Reader
+
+	// NamespaceReader, when set, is used to GET the VM's namespace, whose frozen annotation
+	// freezes all of its VMs (nil only honors the annotation on the VM)
+	NamespaceReader client.Reader
+
+	// InstancetypeReader, when set, is used to GET the old VM's instancetype, whose CPU and
+	// memory settings are filled into both specs before they are compared (nil compares the
+	// specs as stored)
+	InstancetypeReader client.Reader
+
+	// EnforcedNamespaces limits enforcement to VMs in these namespaces, e.g. for a staged
+	// rollout; updates elsewhere are allowed unchecked (empty enforces every namespace)
+	EnforcedNamespaces []string
+
+	// ExemptNamespaces lists namespaces whose VMs are never enforced, a defense-in-depth
+	// complement to the webhook's namespaceSelector (takes precedence over EnforcedNamespaces)
+	ExemptNamespaces []string
+
+	// PendingEnforcementFields lists dot-separated field paths (e.g. spec.template.spec.domain.devices.tpm)
+	// planned to be enforced in the future; changing one produces an admission warning but is
+	// never denied for that reason, so users can prepare before a category becomes enforced
+	PendingEnforcementFields []string
+
+	// ControlAnnotationPrefixes lists annotation key prefixes (e.g. kubevirt.io/) of annotations
+	// that KubeVirt or other tooling act on; adding, removing or modifying one on the VM or its
+	// template requires full-admin, even for users whose granular roles cover the template metadata
+	ControlAnnotationPrefixes []string
+
+	// LabelSubresources maps label key prefixes (e.g. topology.kubernetes.io/) to the subresource
+	// required to add, remove or modify a label with the prefix on the VM or its template, instead
+	// of the role covering the rest of the metadata; the longest matching prefix applies
+	LabelSubresources map[string]string
+
+	// ImmutableFields denies changes to fields declared immutable by policy, for every user
+	// without full-admin (nil disables the policy)
+	ImmutableFields *ImmutableFieldsChecker
+
+	// ClusterDefaults are the architecture and machine types KubeVirt defaults, which are not
+	// user changes when the stored VM leaves them unset (the zero value uses KubeVirt's defaults)
+	ClusterDefaults ClusterDefaults
+
+	// TracerProvider provides the tracer for the ValidateUpdate and SubjectAccessReview spans
+	// (nil uses the global provider, a no-op unless an exporter was installed)
+	TracerProvider trace.TracerProvider
+
+	// Policy, if set, holds the reloadable policy: its current StrictMode, AllowGroups,
+	// DenyGroups, FieldCheckers and ImmutableFields replace the validator's for each request
+	Policy *PolicyStore
+
+	// RestoreControllerUsers lists the usernames (e.g. system:serviceaccount:kubevirt:kubevirt-controller)
+	// of KubeVirt's VirtualMachineRestore controller. A restore it applies can rewrite large parts
+	// of the spec and is allowed without granular checks, like members of AllowGroups.
+	RestoreControllerUsers []string
 }
 
+// MissingRequestPolicy is the decision for updates whose user cannot be identified because
+// the admission request is missing from the context.
+type MissingRequestPolicy string
+
+const (
+	// MissingRequestFail fails the validation with an error (fail-closed, the default)
+	MissingRequestFail MissingRequestPolicy = "Fail"
+
+	// MissingRequestAllow treats the user as unknown and allows the update
+	MissingRequestAllow MissingRequestPolicy = "Allow"
+
+	// MissingRequestDeny treats the user as unknown and denies the update
+	MissingRequestDeny MissingRequestPolicy = "Deny"
+)
+
+// UnauthenticatedPolicy is the decision for updates whose admission request has no username,
+// e.g. because of misconfigured authentication. SubjectAccessReviews for such a request would
+// evaluate an effectively anonymous subject, so it is decided explicitly instead.
+type UnauthenticatedPolicy string
+
+const (
+	// UnauthenticatedDeny denies the update as unauthenticated (the default)
+	UnauthenticatedDeny UnauthenticatedPolicy = "Deny"
+
+	// UnauthenticatedNoPermissions treats the user as holding no permission, without any
+	// SubjectAccessReview: the update is allowed by the backwards-compatible path, or denied
+	// in strict mode
+	UnauthenticatedNoPermissions UnauthenticatedPolicy = "NoPermissions"
+)
+
 var _ webhook.CustomValidator = &VirtualMachineCustomValidator{}
 
+// Decision describes the outcome of a VirtualMachine update admission.
+type Decision struct {
+	// User is the user that submitted the update
+	User authenticationv1.UserInfo
+
+	// VM identifies the updated VirtualMachine
+	VM types.NamespacedName
+
+	// ChangedCategories lists the categories changed by the update, in checker order
+	ChangedCategories []string
+
+	// Allowed is true when the update was admitted
+	Allowed bool
+
+	// Reason is the denial message, empty when the update was allowed
+	Reason string
+
+	// ReasonCode classifies the denial, empty when the update was allowed
+	ReasonCode ReasonCode
+}
+
+// DecisionHook is called after an update decision is made, e.g. to forward denials to a SIEM.
+// Implementations run synchronously in the admission path, so they should hand off slow work.
+type DecisionHook interface {
+	// OnDecision receives the decision; a returned error is logged and never changes the decision
+	OnDecision(ctx context.Context, decision Decision) error
+}
+
 // ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type VirtualMachine.
 func (v *VirtualMachineCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	virtualmachine, ok := obj.(*kubevirtiov1.VirtualMachine)
@@ -127,115 +880,489 @@ func (v *VirtualMachineCustomValidator) ValidateCreate(ctx context.Context, obj
 	}
 	virtualmachinelog.Info("Validation for VirtualMachine upon creation", "name", virtualmachine.GetName())
 
+	if v.Policy != nil {
+		v = v.Policy.Load().applyTo(v)
+	}
+
+	// Creates are not enforced yet, but log the categories the create would require, compared
+	// against an empty VM, so operators can review them before create enforcement is enabled
+	virtualmachinelog.V(2).Info("Categories required by VirtualMachine creation",
+		"name", virtualmachine.GetName(), "namespace", virtualmachine.GetNamespace(),
+		"categories", v.changedCategories(emptyBaseline(virtualmachine), virtualmachine))
+
 	// For create operations, we allow all creates (permission is handled by standard RBAC)
 	return nil, nil
 }
 
+// emptyBaseline returns an empty VM to compare a created VM against. It has an empty template
+// when the VM has one, because checkers ignore template fields unless both VMs have a template.
+func emptyBaseline(vm *kubevirtiov1.VirtualMachine) *kubevirtiov1.VirtualMachine {
+	baseline := &kubevirtiov1.VirtualMachine{}
+	if vm.Spec.Template != nil {
+		baseline.Spec.Template = &kubevirtiov1.VirtualMachineInstanceTemplateSpec{}
+	}
+	return baseline
+}
+
+// isEmptyVirtualMachine reports whether the VM is missing or has neither metadata nor a spec,
+// as decoded from an empty old object. Stored objects always have a name and UID.
+func isEmptyVirtualMachine(vm *kubevirtiov1.VirtualMachine) bool {
+	return vm == nil || (equality.Semantic.DeepEqual(vm.ObjectMeta, metav1.ObjectMeta{}) &&
+		equality.Semantic.DeepEqual(vm.Spec, kubevirtiov1.VirtualMachineSpec{}))
+}
+
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type VirtualMachine.
-func (v *VirtualMachineCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+func (v *VirtualMachineCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (warnings admission.Warnings, err error) {
 	newVM, ok := newObj.(*kubevirtiov1.VirtualMachine)
 	if !ok {
 		return nil, fmt.Errorf("expected a VirtualMachine object for the newObj but got %T", newObj)
 	}
 	oldVM, ok := oldObj.(*kubevirtiov1.VirtualMachine)
-	if !ok {
+	if !ok && oldObj != nil {
 		return nil, fmt.Errorf("expected a VirtualMachine object for the oldObj but got %T", oldObj)
 	}
 
+	// An update without an old object (first-seen objects, some conversion paths) has nothing
+	// to diff against: every field would look added and deny granular users, so once the
+	// group short-circuits decided it is validated like a create. Until then the create's
+	// empty baseline stands in for the old object.
+	validateAsCreate := isEmptyVirtualMachine(oldVM)
+	if validateAsCreate {
+		oldVM = emptyBaseline(newVM)
+	}
+
+	// Denials carry their reason code to the admission response, the audit annotations and the logs
+	defer func() {
+		if err == nil {
+			return
+		}
+		err = withReasonCode(err)
+		reasonCode := ReasonCodeOf(err)
+		if record := auditRecordFrom(ctx); record != nil {
+			record.reasonCode = reasonCode
+		}
+		virtualmachinelog.Info("Denied VirtualMachine update", "name", newVM.GetName(), "namespace", newVM.GetNamespace(),
+			"reasonCode", reasonCode, "neededSubresources", neededSubresourcesOf(err))
+	}()
+
+	// Record the decision for Explain, if requested
+	decisionTrace := decisionTraceFrom(ctx)
+	defer func() {
+		decisionTrace.finish(err)
+	}()
+
+	// Take one snapshot of the reloadable policy, so a reload cannot change it mid-request
+	if v.Policy != nil {
+		v = v.Policy.Load().applyTo(v)
+	}
+
 	virtualmachinelog.Info("Validation for VirtualMachine upon update", "name", newVM.GetName())
 
+	// Trace the decision; without an exporter the span is a no-op
+	ctx, span := v.tracer().Start(ctx, "ValidateUpdate",
+		trace.WithAttributes(attribute.String(TraceAttributeVM, client.ObjectKeyFromObject(newVM).String())))
+	defer func() {
+		if span.IsRecording() {
+			span.SetAttributes(attribute.StringSlice(TraceAttributeCategoriesChanged, v.changedCategories(oldVM, newVM)))
+		}
+		endDecisionSpan(span, err)
+	}()
+
+	// Namespaces outside the enforced scope skip all checks, before any other work is done
+	if !v.isNamespaceEnforced(newVM.Namespace) {
+		return nil, nil
+	}
+
+	// Warn about changes to fields that will be enforced in the future, whatever the decision;
+	// like creates, updates without an old object have no changes to warn about
+	defer func() {
+		if !validateAsCreate {
+			warnings = append(warnings, v.pendingEnforcementWarnings(oldVM, newVM)...)
+		}
+	}()
+
 	// Security Model: Opt-in Restrictions (Backwards Compatible)
-	// Step 1: If user has "virtualmachines/full-admin" → allow everything
+	// Step 0: Group short-circuits (no SubjectAccessReview round-trips)
+	//         - Member of a deny group → deny (wins over allow groups)
+	//         - Member of an allow group → allow everything
+	//         - No old object → validate like a create
+	//         - Serialized object larger than MaxObjectBytes → deny
+	//         - No spec or metadata changes (no-op update) → allow
+	// Step 1: Changes to fields immutable by policy → deny (unless full-admin and allowed by the policy)
+	//         Frozen VM or namespace, or a change to the frozen annotation → deny (unless full-admin)
+	//         If user has "virtualmachines/full-admin" → allow everything
+	//         (with FullAdminFromCategories, so does a user granted every category subresource)
 	//         IMPORTANT: full-admin grants UNRESTRICTED access to ALL spec/metadata fields,
 	//         not just fields covered by granular roles. This is the highest permission level.
 	//         (full-admin is an aggregated role and also aggregates to built-in admin/edit roles)
 	// Step 2: Check if user has ANY granular subresource permissions (e.g., virtualmachines/storage-admin)
 	//         - If NO subresource permissions → allow everything (backwards compatible)
+	//           (in strict mode → deny instead)
 	//         - If YES → proceed to granular checks (opt-in to restrictions)
 	// Step 3: For users with subresource permissions, validate each change against those permissions
 	// Step 4: Check neutralized object for unauthorized changes to spec or metadata
 	// Step 5: Return success if all checks pass
 
+	// Denials name the VM, so they can be traced in aggregated logs
+	vmRef := client.ObjectKeyFromObject(newVM).String()
+
 	// Get user info from the admission request in the context
 	req, err := admission.RequestFromContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get admission request from context: %w", err)
+		// Without a request the user is unknown, so no permission can be checked
+		switch v.MissingRequestPolicy {
+		case MissingRequestAllow:
+			virtualmachinelog.Info("No admission request in context, allowing update by unknown user", "name", newVM.GetName())
+			return nil, nil
+		case MissingRequestDeny:
+			virtualmachinelog.Info("No admission request in context, denying update by unknown user", "name", newVM.GetName())
+			return nil, denyf(ReasonCodeUnknownUser, "cannot identify the user updating VirtualMachine %s", vmRef)
+		default:
+			return nil, fmt.Errorf("failed to get admission request from context: %w", err)
+		}
 	}
 
 	userInfo := req.UserInfo
+	span.SetAttributes(attribute.String(TraceAttributeUser, userInfo.Username))
+
+	// Notify the decision hook of the outcome, whichever step decided it
+	defer func() {
+		v.notifyDecision(ctx, userInfo, oldVM, newVM, err)
+	}()
+
+	// Without a username, SubjectAccessReviews would evaluate an anonymous subject: deny
+	// explicitly, or treat the user as holding no permission
+	unauthenticated := userInfo.Username == ""
+	if unauthenticated && v.UnauthenticatedPolicy != UnauthenticatedNoPermissions {
+		return nil, denyf(ReasonCodeUnauthenticated, "unauthenticated user cannot update VirtualMachine %s: the admission request has no username", vmRef)
+	}
+
+	// Step 0: Group-based short-circuits (deny wins over allow)
+	if group, found := findGroup(userInfo.Groups, v.DenyGroups); found {
+		return nil, denyf(ReasonCodeDeniedGroup, "user is a member of denied group %q, cannot update VirtualMachine %s", group, vmRef)
+	}
+	if _, found := findGroup(userInfo.Groups, v.AllowGroups); found {
+		return nil, nil
+	}
+
+	if validateAsCreate {
+		virtualmachinelog.Info("Update without an old object, validating as a create", "name", newVM.GetName())
+		return v.ValidateCreate(ctx, newVM)
+	}
+
+	// Restores are authorized by the VirtualMachineRestore's RBAC, not by the VM's categories
+	if v.isAuthorizedRestore(userInfo, oldVM, newVM) {
+		virtualmachinelog.Info("Allowing restore update of the restore controller", "name", newVM.GetName(),
+			"namespace", newVM.GetNamespace(), "user", userInfo.Username, "restoreUID", newVM.Annotations[RestoreUIDAnnotation])
+		return nil, nil
+	}
+
+	// Size guard: comparing very large objects is expensive, reject them up front
+	if err := v.checkObjectSize(req, vmRef); err != nil {
+		return nil, err
+	}
+
+	// Optionally diff against the stored VM rather than the AdmissionReview's oldObject
+	if v.LiveReader != nil {
+		oldVM, err = v.liveOldVM(ctx, oldVM)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Defaults KubeVirt's mutating webhook set on the new object only are not user changes
+	oldVM = backfillDefaults(oldVM, newVM, v.ClusterDefaults)
+
+	// Optionally compare the effective specs of instancetype-backed VMs
+	if v.InstancetypeReader != nil {
+		oldVM, newVM, err = v.expandInstancetype(ctx, oldVM, newVM)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// No-op updates (e.g. re-applying the same fields) need no permission checks
+	if !v.hasUserChanges(oldVM, newVM) {
+		return nil, nil
+	}
+
+	// Record the changed categories for the audit annotations, whatever the decision
+	if record := auditRecordFrom(ctx); record != nil {
+		record.categoriesChanged = v.changedCategories(oldVM, newVM)
+	}
+	decisionTrace.recordChanges(oldVM, newVM, v.FieldCheckers)
+
+	decisionContext := NewDecisionContext(oldVM)
+	changes := CategorizeChanges(oldVM, newVM, v.FieldCheckers)
+
+	// The SubjectAccessReviews of this request count against MaxSARsPerRequest, except the probe
+	// confirming a user without granular roles
+	sars := &sarBudget{limit: v.MaxSARsPerRequest, vmRef: vmRef}
+
+	// Optionally resolve the permissions the changed categories need in one concurrent sweep;
+	// subresources missing from the prefetched results are checked on demand
+	var prefetched map[string]bool
+	if v.PrefetchPermissions && !unauthenticated {
+		subresources := v.changedSubresources(decisionContext, changes)
+		if err := sars.spend(len(subresources)); err != nil {
+			return nil, err
+		}
+		prefetched, err = v.prefetchPermissions(ctx, userInfo, newVM, subresources)
+		if err != nil {
+			return nil, fmt.Errorf("failed to prefetch permissions: %w", err)
+		}
+	}
+	grantedByOwner := v.ownerGrant(ctx, userInfo, oldVM, sars)
+	// reviewPermission checks a subresource; budgeted reviews count against MaxSARsPerRequest
+	reviewPermission := func(subresource string, budgeted bool) (bool, error) {
+		if unauthenticated {
+			return false, nil
+		}
+		// Label grants match the stored labels, so a user cannot relabel a VM into a grant
+		if grantedByLabels(v.LabelGrants, userInfo, oldVM.Labels, subresource) {
+			return true, nil
+		}
+		// Owner grants follow the stored owner too, and never include full-admin
+		if subresource != "virtualmachines/full-admin" {
+			granted, err := grantedByOwner()
+			if err != nil {
+				return false, err
+			}
+			if granted {
+				return true, nil
+			}
+		}
+		if allowed, ok := prefetched[subresource]; ok {
+			return allowed, nil
+		}
+		if budgeted {
+			if err := sars.spend(1); err != nil {
+				return false, err
+			}
+		}
+		return v.tracedCheckPermission(ctx, subresource, func(ctx context.Context) (bool, error) {
+			return v.PermissionChecker.CheckPermission(ctx, userInfo, newVM.Namespace, newVM.Name, subresource)
+		})
+	}
+	checkPermission := func(subresource string) (bool, error) {
+		return reviewPermission(subresource, true)
+	}
+	// probePermission reviews the subresources of unchanged categories, which only confirm that
+	// a user holds no granular role. They are bounded by the checkers, not by the update, so
+	// they do not count against MaxSARsPerRequest: a limit below the number of subresources
+	// would otherwise deny every user without granular roles.
+	probePermission := func(subresource string) (bool, error) {
+		return reviewPermission(subresource, false)
+	}
 
 	// Step 1: If user has full-admin permission, allow everything
 	// Check for virtualmachines/full-admin (aggregated role with all VM permissions)
 	// Note: Users with Kubernetes built-in 'admin' or 'edit' roles also get full-admin via aggregation
 	// IMPORTANT: full-admin allows changes to ALL spec/metadata fields, not just those covered by granular roles
-	hasFullAdminPermission, err := v.PermissionChecker.CheckPermission(ctx, userInfo, newVM.Namespace, newVM.Name, "virtualmachines/full-admin")
+	hasFullAdminPermission, err := checkPermission("virtualmachines/full-admin")
 	if err != nil {
 		return nil, fmt.Errorf("failed to check 'virtualmachines/full-admin' permission: %w", err)
 	}
 
+	// Optionally, holding every category subresource is equivalent to full-admin. The results
+	// are kept for step 2, so no subresource is checked twice.
+	subresourcePermissions := make(map[string]bool)
+	if !hasFullAdminPermission && v.FullAdminFromCategories {
+		hasFullAdminPermission, err = v.holdsEveryCategory(decisionContext, subresourcePermissions, checkPermission)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Fields immutable by policy are denied regardless of granular roles, and optionally for full-admin too
+	if err := v.ImmutableFields.Check(oldVM, newVM, hasFullAdminPermission, vmRef); err != nil {
+		return nil, err
+	}
+
+	// Frozen VMs are denied regardless of granular roles, only full-admin may update them
+	if !hasFullAdminPermission {
+		if err := v.checkFrozen(ctx, oldVM, newVM, vmRef); err != nil {
+			return nil, err
+		}
+	}
+
 	if hasFullAdminPermission {
 		// User has full-admin permission, allow all changes (unrestricted access)
+		if decisionTrace != nil {
+			decisionTrace.FullAdmin = true
+		}
 		return nil, nil
 	}
 
 	// Step 2: Check if user has ANY of the new subresource permissions
-	// Check if user has any subresource permissions
+	// The subresources of the changed categories are checked first: the neutralization below
+	// needs them anyway, and a grant among them opts the user in without reviewing the others.
+	// Only if none of them is granted are the others probed, until one is.
 	hasAnySubresource := false
-	subresourcePermissions := make(map[string]bool)
 
-	for _, checker := range v.FieldCheckers {
-		hasPermission, err := v.PermissionChecker.CheckPermission(ctx, userInfo, newVM.Namespace, newVM.Name, checker.Subresource())
-		if err != nil {
-			return nil, fmt.Errorf("failed to check %s permission: %w", checker.Name(), err)
+	for _, changedOnly := range []bool{true, false} {
+		check := checkPermission
+		if !changedOnly {
+			check = probePermission
 		}
-		subresourcePermissions[checker.Subresource()] = hasPermission
-		if hasPermission {
-			hasAnySubresource = true
+		for _, checker := range v.FieldCheckers {
+			if changes[checker.Name()] != changedOnly || (!changedOnly && hasAnySubresource) {
+				continue
+			}
+			// The base subresource decides opt-in, the context may require a different one
+			for _, subresource := range []string{checker.Subresource(), requiredSubresource(checker, decisionContext)} {
+				hasPermission, err := cachedPermission(subresourcePermissions, subresource, check)
+				if err != nil {
+					return nil, fmt.Errorf("failed to check %s permission: %w", checker.Name(), err)
+				}
+				if hasPermission {
+					hasAnySubresource = true
+				}
+			}
 		}
 	}
 
+	// The subresources of LabelSubresources are granular roles too: a user holding only one of
+	// them (e.g. scheduling-admin) has opted in like a user holding a category subresource
+	if !hasAnySubresource {
+		for _, subresource := range v.labelSubresourceTargets() {
+			hasPermission, err := cachedPermission(subresourcePermissions, subresource, probePermission)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check %s permission: %w", subresource, err)
+			}
+			if hasPermission {
+				hasAnySubresource = true
+				break
+			}
+		}
+	}
+
+	decisionTrace.recordPermissions(v.FieldCheckers, decisionContext, subresourcePermissions)
+
 	// If user has NO subresource permissions, allow everything (backwards compatible)
+	// unless strict mode requires every change to map to an explicit subresource grant
 	if !hasAnySubresource {
+		if v.StrictMode {
+			return nil, denyNeedingf(ReasonCodeStrictNoRole, v.neededForStrictMode(oldVM, newVM, decisionContext), "no applicable VM subresource permission granted for VirtualMachine %s", vmRef)
+		}
+		if record := auditRecordFrom(ctx); record != nil {
+			record.noGranularRoles = true
+		}
 		return nil, nil
 	}
 
+	// Control annotations can change the VM's behavior like a spec field, but no granular
+	// role covers them: only full-admin may modify them
+	if changed := v.changedControlAnnotations(oldVM, newVM); len(changed) > 0 {
+		return nil, denyFullAdminf(ReasonCodeControlAnnotationForbidden, "user does not have permission to modify control annotations %s of VirtualMachine %s (requires virtualmachines/full-admin)",
+			strings.Join(changed, ", "), vmRef)
+	}
+
 	// Step 3: User has opted-in to granular permissions by having subresource permissions
 	// Create copies that we'll mutate to "neutralize" permitted changes
 	oldCopy := oldVM.DeepCopy()
 	newCopy := newVM.DeepCopy()
 
+	// Labels mapped to a subresource require it, whatever role covers the rest of the metadata;
+	// once permitted they are neutralized before any checker runs
+	if err := v.checkLabelSubresources(oldCopy, newCopy, vmRef, func(subresource string) (bool, error) {
+		return cachedPermission(subresourcePermissions, subresource, checkPermission)
+	}); err != nil {
+		return nil, err
+	}
+
 	// Run all field-specific permission checks
 	// IMPORTANT: Check HasChanged on the COPIES, not originals
 	// This allows subset permissions (cdrom-user) to neutralize changes before
 	// superset permissions (storage-admin) see them
+	var unauthorizedCheckers []FieldPermissionChecker
+	var neutralizedCategories []string
 	for _, checker := range v.FieldCheckers {
 		if checker.HasChanged(oldCopy, newCopy) {
 			// This field category has changes, check if user has permission
-			hasPermission := subresourcePermissions[checker.Subresource()]
+			hasPermission, err := cachedPermission(subresourcePermissions, requiredSubresource(checker, decisionContext), checkPermission)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check %s permission: %w", checker.Name(), err)
+			}
 
 			if hasPermission {
 				// User has permission for this field category, neutralize it
 				checker.Neutralize(oldCopy, newCopy)
+				neutralizedCategories = append(neutralizedCategories, checker.Name())
+				decisionTrace.recordNeutralized(checker.Name())
+			} else {
+				// If user lacks permission, we'll deny later if changes remain after all checkers run
+				unauthorizedCheckers = append(unauthorizedCheckers, checker)
 			}
-			// If user lacks permission, we'll deny later if changes remain after all checkers run
 		}
 	}
+	// Subresources first checked during neutralization are traced too
+	decisionTrace.recordPermissions(v.FieldCheckers, decisionContext, subresourcePermissions)
+
+	// Changing too many categories at once requires full-admin, whatever the roles held. A lacked
+	// subset whose changes a superset neutralized is not counted, only the changes still left.
+	if changed := len(neutralizedCategories) + len(missingCategories(unauthorizedCheckers, oldCopy, newCopy)); v.MaxChangedCategories > 0 && changed > v.MaxChangedCategories {
+		return nil, denyFullAdminf(ReasonCodeTooManyCategories, "update of VirtualMachine %s changes %d categories, more than %d require virtualmachines/full-admin",
+			vmRef, changed, v.MaxChangedCategories)
+	}
+
+	// Debug invariant: neutralizing one copy must never have changed the other
+	if verifyNeutralizationIsolation {
+		if err := checkNeutralizationIsolation(oldCopy, newCopy, oldVM, newVM); err != nil {
+			virtualmachinelog.Error(err, "Neutralization isolation violated", "name", newVM.GetName(), "namespace", newVM.GetNamespace())
+			return nil, fmt.Errorf("failed to validate VirtualMachine %s: %w", vmRef, err)
+		}
+	}
+
+	// Debugging aid: which subresources the user holds and which categories changed
+	// (only names and booleans are logged, never tokens or user extra data)
+	changedCategories := v.changedCategories(oldVM, newVM)
+	virtualmachinelog.V(2).Info("Resolved subresource permissions",
+		"name", newVM.GetName(), "namespace", newVM.GetNamespace(), "user", userInfo.Username, "running", decisionContext.Running,
+		"subresourcePermissions", subresourcePermissions, "changedCategories", changedCategories)
 
 	// Step 4: After all field-specific checks, see if any unauthorized changes remain
 	// We need to check both Spec and Metadata, but ignore system-managed fields
 
-	// Normalize system-managed metadata fields that we don't care about
+	// Report every category the user lacks, not just the first denial
+	if v.ReportAllMissingPermissions {
+		if missing := missingCategories(unauthorizedCheckers, oldCopy, newCopy); len(missing) > 0 {
+			logDeniedSpecDiff(oldCopy, newCopy, newVM)
+			return nil, denyNeedingf(missingReasonCode(missing), v.categorySubresources(missing, decisionContext), "missing permissions for VirtualMachine %s: %s", vmRef, strings.Join(missing, ", "))
+		}
+	}
+
+	// Normalize system-managed metadata fields that we don't care about, and the order of
+	// named lists, which checkers compare as sets
 	v.normalizeSystemMetadata(&oldCopy.ObjectMeta, &newCopy.ObjectMeta)
+	sortByIdentity(oldCopy)
+	sortByIdentity(newCopy)
 
 	// Check if Spec or Metadata has unauthorized changes
 	specChanged := !equality.Semantic.DeepEqual(oldCopy.Spec, newCopy.Spec)
 	metadataChanged := !equality.Semantic.DeepEqual(oldCopy.ObjectMeta, newCopy.ObjectMeta)
 
 	if specChanged || metadataChanged {
+		// Forensics for disputed denials: the exact fields no permitted category covered
+		logDeniedSpecDiff(oldCopy, newCopy, newVM)
 		if metadataChanged {
-			return nil, fmt.Errorf("user does not have permission to modify VirtualMachine metadata")
+			return nil, denyFullAdminf(ReasonCodeMetadataForbidden, "user does not have permission to modify VirtualMachine %s metadata", vmRef)
 		}
-		return nil, fmt.Errorf("user does not have permission to modify one or more VirtualMachine spec fields")
+		if templateMetadataChanged(oldCopy, newCopy) {
+			return nil, denyNeedingf(ReasonCodeTemplateMetadataForbidden, v.neededForTemplateMetadata(decisionContext), "user does not have permission to modify VirtualMachine %s template metadata (spec.template.metadata)", vmRef)
+		}
+		// Changes left behind by no checker are outside every granular role
+		missing := missingCategories(unauthorizedCheckers, oldCopy, newCopy)
+		if len(missing) == 0 {
+			return nil, denyFullAdminf(ReasonCodeFullAdminRequired, "user does not have permission to modify VirtualMachine %s: the changed spec fields are not covered by any granular role and require virtualmachines/full-admin", vmRef)
+		}
+		needed := v.categorySubresources(missing, decisionContext)
+		// The user holds part of a change spanning several categories: name the part they lack
+		if len(neutralizedCategories) > 0 {
+			return nil, denyNeedingf(missingReasonCode(missing), needed, "user does not have permission to modify one or more spec fields of VirtualMachine %s: permitted %s, missing %s",
+				vmRef, strings.Join(neutralizedCategories, ", "), v.describeCategories(missing, decisionContext))
+		}
+		return nil, denyNeedingf(missingReasonCode(missing), needed, "user does not have permission to modify one or more spec fields of VirtualMachine %s", vmRef)
 	}
 
 	// Step 5: All changes were authorized
@@ -254,6 +1381,244 @@ func (v *VirtualMachineCustomValidator) ValidateDelete(ctx context.Context, obj
 	return nil, nil
 }
 
+// notifyDecision passes the outcome of an update to the DecisionHook, if any.
+// Hook errors are logged only, so a failing hook cannot block or admit updates.
+func (v *VirtualMachineCustomValidator) notifyDecision(ctx context.Context, userInfo authenticationv1.UserInfo, oldVM, newVM *kubevirtiov1.VirtualMachine, decisionErr error) {
+	if v.DecisionHook == nil {
+		return
+	}
+
+	decision := Decision{
+		User:              userInfo,
+		VM:                client.ObjectKeyFromObject(newVM),
+		ChangedCategories: v.changedCategories(oldVM, newVM),
+		Allowed:           decisionErr == nil,
+	}
+	if decisionErr != nil {
+		decision.Reason = decisionErr.Error()
+		decision.ReasonCode = ReasonCodeOf(decisionErr)
+	}
+
+	if err := v.DecisionHook.OnDecision(ctx, decision); err != nil {
+		virtualmachinelog.Error(err, "Decision hook failed",
+			"name", newVM.GetName(), "namespace", newVM.GetNamespace(), "allowed", decision.Allowed)
+	}
+}
+
+// subresourcesToCheck returns full-admin followed by every subresource the checkers may
+// require in the given context, without duplicates
+func (v *VirtualMachineCustomValidator) subresourcesToCheck(dc DecisionContext) []string {
+	subresources := []string{"virtualmachines/full-admin"}
+	for _, checker := range v.FieldCheckers {
+		for _, subresource := range []string{checker.Subresource(), requiredSubresource(checker, dc)} {
+			if !slices.Contains(subresources, subresource) {
+				subresources = append(subresources, subresource)
+			}
+		}
+	}
+	return subresources
+}
+
+// cachedPermission returns whether the subresource is granted, checking it only if it is not
+// in permissions yet. Several checkers may share a subresource, so each is only checked once.
+func cachedPermission(permissions map[string]bool, subresource string, checkPermission func(subresource string) (bool, error)) (bool, error) {
+	if granted, checked := permissions[subresource]; checked {
+		return granted, nil
+	}
+	granted, err := checkPermission(subresource)
+	if err != nil {
+		return false, err
+	}
+	permissions[subresource] = granted
+	return granted, nil
+}
+
+// changedSubresources returns full-admin and the subresources of the changed categories in the
+// given context, each once
+func (v *VirtualMachineCustomValidator) changedSubresources(dc DecisionContext, changes map[string]bool) []string {
+	subresources := []string{"virtualmachines/full-admin"}
+	for _, checker := range v.FieldCheckers {
+		if !changes[checker.Name()] {
+			continue
+		}
+		for _, subresource := range []string{checker.Subresource(), requiredSubresource(checker, dc)} {
+			if !slices.Contains(subresources, subresource) {
+				subresources = append(subresources, subresource)
+			}
+		}
+	}
+	return subresources
+}
+
+// holdsEveryCategory reports whether the user is granted every subresource the checkers may
+// require in the given context, recording each result in permissions. It stops at the first
+// subresource that is not granted. Without checkers there is no category to hold.
+func (v *VirtualMachineCustomValidator) holdsEveryCategory(dc DecisionContext, permissions map[string]bool, checkPermission func(subresource string) (bool, error)) (bool, error) {
+	subresources := v.subresourcesToCheck(dc)[1:]
+	if len(subresources) == 0 {
+		return false, nil
+	}
+
+	for _, subresource := range subresources {
+		granted, err := checkPermission(subresource)
+		if err != nil {
+			return false, fmt.Errorf("failed to check %s permission: %w", subresource, err)
+		}
+		permissions[subresource] = granted
+		if !granted {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// liveOldVM returns the VM currently stored in the API server, to be used as the old state.
+// If the VM is gone the admission oldObject is all there is, so it is returned unchanged.
+func (v *VirtualMachineCustomValidator) liveOldVM(ctx context.Context, oldVM *kubevirtiov1.VirtualMachine) (*kubevirtiov1.VirtualMachine, error) {
+	liveVM := &kubevirtiov1.VirtualMachine{}
+	if err := v.LiveReader.Get(ctx, client.ObjectKeyFromObject(oldVM), liveVM); err != nil {
+		if apierrors.IsNotFound(err) {
+			virtualmachinelog.Info("VirtualMachine not found, using the admission oldObject", "name", oldVM.GetName(), "namespace", oldVM.GetNamespace())
+			return oldVM, nil
+		}
+		return nil, fmt.Errorf("failed to get live VirtualMachine %s: %w", client.ObjectKeyFromObject(oldVM), err)
+	}
+
+	if liveVM.ResourceVersion != oldVM.ResourceVersion {
+		virtualmachinelog.Info("Admission oldObject differs from the live VirtualMachine, using the live object",
+			"name", oldVM.GetName(), "namespace", oldVM.GetNamespace(),
+			"oldObjectResourceVersion", oldVM.ResourceVersion, "liveResourceVersion", liveVM.ResourceVersion)
+	}
+	return liveVM, nil
+}
+
+// changedCategories returns the names of the categories changed by the update, in checker order
+func (v *VirtualMachineCustomValidator) changedCategories(oldVM, newVM *kubevirtiov1.VirtualMachine) []string {
+	changes := CategorizeChanges(oldVM, newVM, v.FieldCheckers)
+	var changed []string
+	for _, checker := range v.FieldCheckers {
+		if changes[checker.Name()] {
+			changed = append(changed, checker.Name())
+		}
+	}
+	return changed
+}
+
+// checkObjectSize rejects requests whose serialized old or new object exceeds MaxObjectBytes
+func (v *VirtualMachineCustomValidator) checkObjectSize(req admission.Request, vmRef string) error {
+	if v.MaxObjectBytes <= 0 {
+		return nil
+	}
+	if size := max(len(req.Object.Raw), len(req.OldObject.Raw)); size > v.MaxObjectBytes {
+		return denyf(ReasonCodeObjectTooLarge, "VirtualMachine %s object size %d bytes exceeds the maximum of %d bytes", vmRef, size, v.MaxObjectBytes)
+	}
+	return nil
+}
+
+// CategorizeChanges reports, for each checker's category name, whether the update changes
+// fields in that category. Unlike the neutralization pipeline it compares the unmodified
+// objects, so a subset and its superset (e.g. cdrom and storage) can both be reported.
+func CategorizeChanges(oldVM, newVM *kubevirtiov1.VirtualMachine, checkers []FieldPermissionChecker) map[string]bool {
+	changes := make(map[string]bool, len(checkers))
+	for _, checker := range checkers {
+		changes[checker.Name()] = checker.HasChanged(oldVM, newVM)
+	}
+	return changes
+}
+
+// missingCategories returns the names of unauthorized categories that still have changes
+// once every permitted checker has run. A category can be covered by a later superset
+// (e.g. cdrom changes neutralized by storage-admin), so it is re-checked on the final copies.
+func missingCategories(unauthorizedCheckers []FieldPermissionChecker, oldVM, newVM *kubevirtiov1.VirtualMachine) []string {
+	var missing []string
+	for _, checker := range unauthorizedCheckers {
+		if checker.HasChanged(oldVM, newVM) {
+			missing = append(missing, checker.Name())
+		}
+	}
+	return missing
+}
+
+// describeCategories formats the categories with the subresource each requires in the
+// VM's current state, e.g. "devices (virtualmachines/devices-admin)"
+func (v *VirtualMachineCustomValidator) describeCategories(names []string, dc DecisionContext) string {
+	described := make([]string, 0, len(names))
+	for _, checker := range v.FieldCheckers {
+		if slices.Contains(names, checker.Name()) {
+			described = append(described, fmt.Sprintf("%s (%s)", checker.Name(), requiredSubresource(checker, dc)))
+		}
+	}
+	return strings.Join(described, ", ")
+}
+
+// categorySubresources returns the subresource each of the categories requires in the VM's
+// current state, in checker order
+func (v *VirtualMachineCustomValidator) categorySubresources(names []string, dc DecisionContext) []string {
+	var subresources []string
+	for _, checker := range v.FieldCheckers {
+		if slices.Contains(names, checker.Name()) {
+			subresources = append(subresources, requiredSubresource(checker, dc))
+		}
+	}
+	return subresources
+}
+
+// neededForStrictMode returns the subresources of the categories the update changes, which
+// would have permitted it in strict mode; full-admin when the changes are outside every category
+func (v *VirtualMachineCustomValidator) neededForStrictMode(oldVM, newVM *kubevirtiov1.VirtualMachine, dc DecisionContext) []string {
+	if needed := v.categorySubresources(v.changedCategories(oldVM, newVM), dc); len(needed) > 0 {
+		return needed
+	}
+	return []string{"virtualmachines/full-admin"}
+}
+
+// neededForTemplateMetadata returns the subresource that permits template metadata changes,
+// full-admin when the template-metadata checker is disabled
+func (v *VirtualMachineCustomValidator) neededForTemplateMetadata(dc DecisionContext) []string {
+	if needed := v.categorySubresources([]string{"template-metadata"}, dc); len(needed) > 0 {
+		return needed
+	}
+	return []string{"virtualmachines/full-admin"}
+}
+
+// templateMetadataChanged reports whether spec.template.metadata differs between the VMs
+func templateMetadataChanged(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || newVM.Spec.Template == nil {
+		return false
+	}
+	return !equality.Semantic.DeepEqual(oldVM.Spec.Template.ObjectMeta, newVM.Spec.Template.ObjectMeta)
+}
+
+// isNamespaceEnforced reports whether updates to VMs in the namespace are validated
+func (v *VirtualMachineCustomValidator) isNamespaceEnforced(namespace string) bool {
+	if slices.Contains(v.ExemptNamespaces, namespace) {
+		return false
+	}
+	return len(v.EnforcedNamespaces) == 0 || slices.Contains(v.EnforcedNamespaces, namespace)
+}
+
+// findGroup returns the first of the user's groups that is present in the given list
+func findGroup(userGroups, groups []string) (string, bool) {
+	for _, group := range userGroups {
+		if slices.Contains(groups, group) {
+			return group, true
+		}
+	}
+	return "", false
+}
+
+// hasUserChanges reports whether the update changes the spec or any user-managed metadata
+func (v *VirtualMachineCustomValidator) hasUserChanges(oldVM, newVM *kubevirtiov1.VirtualMachine) bool {
+	oldCopy := oldVM.DeepCopy()
+	newCopy := newVM.DeepCopy()
+	v.normalizeSystemMetadata(&oldCopy.ObjectMeta, &newCopy.ObjectMeta)
+	sortByIdentity(oldCopy)
+	sortByIdentity(newCopy)
+
+	return !equality.Semantic.DeepEqual(oldCopy.Spec, newCopy.Spec) ||
+		!equality.Semantic.DeepEqual(oldCopy.ObjectMeta, newCopy.ObjectMeta)
+}
+
 // normalizeSystemMetadata sets system-managed metadata fields to the same values
 // so they don't cause false positives when checking for user-initiated metadata changes
 func (v *VirtualMachineCustomValidator) normalizeSystemMetadata(oldMeta, newMeta *metav1.ObjectMeta) {
@@ -0,0 +1,187 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubevirtiov1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// VM shape bits used by fuzzVM to toggle optional parts of the spec
+const (
+	shapeNilTemplate = 1 << iota
+	shapeNilCPU
+	shapeCdromDisks
+	shapeHotpluggable
+	shapeFilesystems
+	shapeInterfaces
+	shapeGPUs
+	shapeRunning
+	shapeRunStrategy
+	shapeLabels
+	shapeTemplateLabels
+	shapeDiskTuning
+	shapeAutoattach
+	shapeEmptySlices
+)
+
+// FuzzValidateUpdate runs randomized old/new VirtualMachines through the full checker
+// pipeline, asserting that it never panics and that decisions are deterministic.
+func FuzzValidateUpdate(f *testing.F) {
+	// Known tricky cases
+	f.Add(uint32(shapeNilTemplate), uint32(0), uint8(0), uint8(0), uint16(0xffff))
+	f.Add(uint32(0), uint32(shapeNilTemplate), uint8(1), uint8(1), uint16(0))
+	f.Add(uint32(shapeNilCPU), uint32(0), uint8(1), uint8(1), uint16(0x0002))
+	f.Add(uint32(shapeCdromDisks), uint32(shapeCdromDisks|shapeHotpluggable), uint8(2), uint8(2), uint16(0x0004))
+	f.Add(uint32(shapeEmptySlices), uint32(0), uint8(0), uint8(0), uint16(0x00fe))
+	f.Add(uint32(shapeFilesystems|shapeDiskTuning), uint32(shapeInterfaces|shapeGPUs), uint8(3), uint8(200), uint16(0x0100))
+	f.Add(uint32(shapeRunning|shapeLabels), uint32(shapeRunStrategy|shapeTemplateLabels|shapeAutoattach), uint8(1), uint8(1), uint16(0x0000))
+
+	f.Fuzz(func(t *testing.T, oldShape, newShape uint32, oldCount, newCount uint8, permissionBits uint16) {
+		oldVM := fuzzVM(oldShape, oldCount)
+		newVM := fuzzVM(newShape, newCount)
+
+		firstAllowed, firstMessage := fuzzDecide(t, oldVM, newVM, permissionBits)
+		secondAllowed, secondMessage := fuzzDecide(t, oldVM, newVM, permissionBits)
+
+		if firstAllowed != secondAllowed || firstMessage != secondMessage {
+			t.Fatalf("non-deterministic decision: (%t, %q) then (%t, %q)",
+				firstAllowed, firstMessage, secondAllowed, secondMessage)
+		}
+	})
+}
+
+// fuzzDecide runs a single ValidateUpdate with permissions derived from permissionBits
+func fuzzDecide(t *testing.T, oldVM, newVM *kubevirtiov1.VirtualMachine, permissionBits uint16) (bool, string) {
+	checkers := DefaultFieldCheckers()
+
+	// Bit 0 grants full-admin, the remaining bits grant each checker's subresource
+	mockPerm := &MockPermissionChecker{permissions: make(map[string]bool)}
+	mockPerm.permissions["virtualmachines/full-admin"] = permissionBits&1 != 0
+	for i, checker := range checkers {
+		if permissionBits&(1<<(i+1)) != 0 {
+			mockPerm.permissions[checker.Subresource()] = true
+		}
+	}
+
+	validator := &VirtualMachineCustomValidator{
+		FieldCheckers:     checkers,
+		PermissionChecker: mockPerm,
+	}
+
+	ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			UserInfo: authenticationv1.UserInfo{Username: "fuzz-user"},
+		},
+	})
+
+	// Pass copies so both runs see identical input
+	_, err := validator.ValidateUpdate(ctx, oldVM.DeepCopy(), newVM.DeepCopy())
+	if err != nil {
+		return false, err.Error()
+	}
+	return true, ""
+}
+
+// fuzzVM builds a VirtualMachine whose optional parts are selected by shape bits,
+// with count controlling the length of generated slices
+func fuzzVM(shape uint32, count uint8) *kubevirtiov1.VirtualMachine {
+	vm := &kubevirtiov1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fuzz-vm",
+			Namespace: "default",
+		},
+	}
+
+	if shape&shapeRunning != 0 {
+		running := count%2 == 0
+		vm.Spec.Running = &running
+	}
+	if shape&shapeRunStrategy != 0 {
+		strategy := kubevirtiov1.RunStrategyAlways
+		vm.Spec.RunStrategy = &strategy
+	}
+	if shape&shapeLabels != 0 {
+		vm.Labels = map[string]string{"count": fmt.Sprint(count)}
+	}
+
+	if shape&shapeNilTemplate != 0 {
+		return vm
+	}
+
+	template := &kubevirtiov1.VirtualMachineInstanceTemplateSpec{}
+	vm.Spec.Template = template
+
+	if shape&shapeTemplateLabels != 0 {
+		template.ObjectMeta.Labels = map[string]string{"count": fmt.Sprint(count)}
+	}
+	if shape&shapeNilCPU == 0 {
+		template.Spec.Domain.CPU = &kubevirtiov1.CPU{Cores: uint32(count)}
+	}
+	if shape&shapeEmptySlices != 0 {
+		template.Spec.Volumes = []kubevirtiov1.Volume{}
+		template.Spec.Networks = []kubevirtiov1.Network{}
+		template.Spec.Domain.Devices.Disks = []kubevirtiov1.Disk{}
+		template.Spec.Domain.Devices.Interfaces = []kubevirtiov1.Interface{}
+	}
+	if shape&shapeAutoattach != 0 {
+		autoattach := count%2 == 0
+		template.Spec.Domain.Devices.AutoattachGraphicsDevice = &autoattach
+		template.Spec.Domain.Devices.AutoattachPodInterface = &autoattach
+	}
+
+	devices := &template.Spec.Domain.Devices
+	for i := 0; i < int(count); i++ {
+		name := fmt.Sprintf("dev%d", i)
+
+		disk := kubevirtiov1.Disk{Name: name}
+		if shape&shapeCdromDisks != 0 {
+			disk.CDRom = &kubevirtiov1.CDRomTarget{Bus: "sata"}
+		}
+		if shape&shapeDiskTuning != 0 {
+			disk.Cache = kubevirtiov1.CacheWriteBack
+		}
+		devices.Disks = append(devices.Disks, disk)
+
+		volume := kubevirtiov1.Volume{Name: name}
+		if shape&shapeHotpluggable != 0 {
+			volume.DataVolume = &kubevirtiov1.DataVolumeSource{Name: name, Hotpluggable: true}
+		}
+		template.Spec.Volumes = append(template.Spec.Volumes, volume)
+
+		if shape&shapeFilesystems != 0 {
+			devices.Filesystems = append(devices.Filesystems, kubevirtiov1.Filesystem{Name: "fs-" + name})
+			template.Spec.Volumes = append(template.Spec.Volumes, kubevirtiov1.Volume{Name: "fs-" + name})
+		}
+		if shape&shapeInterfaces != 0 {
+			devices.Interfaces = append(devices.Interfaces, kubevirtiov1.Interface{Name: name})
+			template.Spec.Networks = append(template.Spec.Networks, kubevirtiov1.Network{Name: name})
+		}
+		if shape&shapeGPUs != 0 {
+			devices.GPUs = append(devices.GPUs, kubevirtiov1.GPU{Name: name, DeviceName: "nvidia.com/GPU"})
+		}
+	}
+
+	return vm
+}
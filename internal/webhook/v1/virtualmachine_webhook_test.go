@@ -18,16 +18,39 @@ package v1
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
 
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
 	kubevirtiov1 "kubevirt.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
@@ -60,6 +83,44 @@ var _ = Describe("VirtualMachine Webhook", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(warnings).To(BeEmpty())
 		})
+
+		It("should log the categories the create would require", func() {
+			var logLines []string
+			originalLog := virtualmachinelog
+			DeferCleanup(func() { virtualmachinelog = originalLog })
+			virtualmachinelog = funcr.New(func(prefix, args string) {
+				logLines = append(logLines, args)
+			}, funcr.Options{Verbosity: 2})
+
+			validator.FieldCheckers = DefaultFieldCheckers()
+			vm := &kubevirtiov1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-vm",
+					Namespace: "default",
+				},
+				Spec: kubevirtiov1.VirtualMachineSpec{
+					Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+						Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+							Domain: kubevirtiov1.DomainSpec{
+								Devices: kubevirtiov1.Devices{
+									GPUs:  []kubevirtiov1.GPU{{Name: "gpu1", DeviceName: "nvidia.com/A100"}},
+									Disks: []kubevirtiov1.Disk{{Name: "rootdisk"}},
+								},
+							},
+							Volumes: []kubevirtiov1.Volume{{Name: "rootdisk"}},
+						},
+					},
+				},
+			}
+
+			warnings, err := validator.ValidateCreate(ctx, vm)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+			Expect(logLines).To(ContainElement(And(
+				ContainSubstring(`"msg"="Categories required by VirtualMachine creation"`),
+				ContainSubstring(`"categories"=["gpu" "devices" "storage"]`),
+			)))
+		})
 	})
 
 	Context("ValidateDelete", func() {
@@ -186,13 +247,43 @@ var _ = Describe("VirtualMachine Webhook", func() {
 				// IMPORTANT: Order matters for hierarchical permissions (subset before superset)
 				FieldCheckers: []FieldPermissionChecker{
 					// Independent permissions
+					&NetworkPortsPermissionChecker{},    // Subset of network-security
+					&NetworkSecurityPermissionChecker{}, // Carved out of network
+					&BridgePermissionChecker{},          // Carved out of network
+					&LinkStatePermissionChecker{},       // Subset of network
+					&NetworkTuningPermissionChecker{},   // Subset of network
+					&MultusPermissionChecker{},          // Subset of network
 					&NetworkPermissionChecker{},
+					&SriovPermissionChecker{},
+					&CPUPinningPermissionChecker{},   // Carved out of compute
+					&CPUTopologyPermissionChecker{},  // Carved out of compute
+					&HugepagesPermissionChecker{},    // Carved out of compute
+					&MemoryResizePermissionChecker{}, // Subset of compute
+					&MemoryLimitPermissionChecker{},  // Subset of compute
+					&CPUFeaturesPermissionChecker{},  // Subset of compute
 					&ComputePermissionChecker{},
+					&InputPermissionChecker{},   // Subset of devices
+					&ConsolePermissionChecker{}, // Subset of gpu and devices
+					&GPUPermissionChecker{},     // Subset of devices
 					&DevicesPermissionChecker{},
+					&AutoattachPermissionChecker{},
+					&TemplateMetadataPermissionChecker{},
+					&InstancetypeRevisionPermissionChecker{},
 
 					// Hierarchical permissions (subset before superset)
-					&CdromUserPermissionChecker{}, // Subset
-					&StoragePermissionChecker{},   // Superset
+					&BootPermissionChecker{},              // Subset
+					&CdromUserPermissionChecker{},         // Subset
+					&DedicatedIOThreadPermissionChecker{}, // Subset
+					&DiskTuningPermissionChecker{},        // Subset
+					&DiskIdentityPermissionChecker{},      // Subset
+					&DiskTagPermissionChecker{},           // Subset
+					&SharedDiskPermissionChecker{},        // Subset
+					&FilesystemRemovalPermissionChecker{}, // Subset of filesystem
+					&FilesystemUserPermissionChecker{},    // Subset of filesystem
+					&FilesystemPermissionChecker{},        // Subset
+					&IdentityPermissionChecker{},          // Subset
+					&ConfigPermissionChecker{},            // Subset
+					&StoragePermissionChecker{},           // Superset
 				},
 				PermissionChecker: mockPerm,
 			}
@@ -235,287 +326,3591 @@ var _ = Describe("VirtualMachine Webhook", func() {
 			})
 		})
 
-		Context("with full-admin permissions", func() {
-			It("should allow all changes when user has full-admin permission", func() {
-				mockPerm.permissions["virtualmachines/full-admin"] = true
-
-				// Make arbitrary changes (CPU, storage, network, etc.)
-				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
-				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
-				newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks, kubevirtiov1.Network{Name: "network2"})
+		Context("without an old object", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+			})
 
-				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			It("should validate an empty old VM like a create instead of diffing against it", func() {
+				warnings, err := validator.ValidateUpdate(ctx, &kubevirtiov1.VirtualMachine{}, newVM)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(warnings).To(BeNil())
+				Expect(mockPerm.calls).To(BeZero())
 			})
-		})
-
-		Context("without subresource permissions", func() {
-			It("should allow all changes when user has no subresource permissions (backwards compatible)", func() {
-				mockPerm.permissions["virtualmachines/full-admin"] = false
-				mockPerm.permissions["*"] = false
-				mockPerm.permissions["virtualmachines/storage-admin"] = false
-				mockPerm.permissions["virtualmachines/cdrom-user"] = false
 
-				// Make storage changes
-				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+			It("should validate a nil old VM like a create", func() {
+				warnings, err := validator.ValidateUpdate(ctx, nil, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
 
-				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				var missing *kubevirtiov1.VirtualMachine
+				warnings, err = validator.ValidateUpdate(ctx, missing, newVM)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(warnings).To(BeNil())
 			})
-		})
 
-		Context("with storage-admin permission", func() {
-			BeforeEach(func() {
-				mockPerm.permissions["virtualmachines/full-admin"] = false
-				mockPerm.permissions["*"] = false
-				mockPerm.permissions["virtualmachines/storage-admin"] = true
-				mockPerm.permissions["virtualmachines/cdrom-user"] = false
+			It("should deny members of a denied group before validating like a create", func() {
+				validator.DenyGroups = []string{"test-group"}
+
+				_, err := validator.ValidateUpdate(ctx, &kubevirtiov1.VirtualMachine{}, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(ReasonCodeOf(err)).To(Equal(ReasonCodeDeniedGroup))
+
+				_, err = validator.ValidateUpdate(ctx, nil, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(ReasonCodeOf(err)).To(Equal(ReasonCodeDeniedGroup))
 			})
 
-			It("should allow storage changes", func() {
-				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+			It("should still diff an old VM that only has a spec", func() {
+				oldVM.ObjectMeta = metav1.ObjectMeta{}
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
 
-				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
-				Expect(err).ToNot(HaveOccurred())
-				Expect(warnings).To(BeNil())
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
 			})
+		})
 
-			It("should allow filesystem changes", func() {
-				newVM.Spec.Template.Spec.Domain.Devices.Filesystems = append(newVM.Spec.Template.Spec.Domain.Devices.Filesystems, kubevirtiov1.Filesystem{Name: "shared-fs"})
+		Context("with no-op updates", func() {
+			It("should allow identical objects without any permission checks", func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
 
 				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(warnings).To(BeNil())
+				Expect(mockPerm.calls).To(BeZero())
 			})
 
-			It("should deny non-storage changes", func() {
-				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+			It("should ignore system-managed metadata differences", func() {
+				oldVM.ResourceVersion = "1"
+				newVM.ResourceVersion = "2"
+				newVM.Generation = 3
 
 				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(err).ToNot(HaveOccurred())
 				Expect(warnings).To(BeNil())
+				Expect(mockPerm.calls).To(BeZero())
 			})
 
-			It("should deny metadata changes", func() {
-				newVM.Labels = map[string]string{"new": "label"}
+			It("should still check permissions when the spec changes", func() {
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
 
-				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("metadata"))
-				Expect(warnings).To(BeNil())
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(mockPerm.calls).ToNot(BeZero())
 			})
 		})
 
-		Context("with cdrom-user permission", func() {
+		Context("with an object size limit", func() {
 			BeforeEach(func() {
-				mockPerm.permissions["virtualmachines/full-admin"] = false
-				mockPerm.permissions["*"] = false
-				mockPerm.permissions["virtualmachines/storage-admin"] = false
-				mockPerm.permissions["virtualmachines/cdrom-user"] = true
-
-				// Setup CD-ROM disk in both VMs
-				cdromDisk := kubevirtiov1.Disk{
-					Name: "cdrom1",
-					DiskDevice: kubevirtiov1.DiskDevice{
-						CDRom: &kubevirtiov1.CDRomTarget{
-							Bus: "sata",
-						},
-					},
-				}
-				oldVM.Spec.Template.Spec.Domain.Devices.Disks = append(oldVM.Spec.Template.Spec.Domain.Devices.Disks, cdromDisk)
-				newVM.Spec.Template.Spec.Domain.Devices.Disks = append(newVM.Spec.Template.Spec.Domain.Devices.Disks, cdromDisk)
+				validator.MaxObjectBytes = 1024
+				mockPerm.permissions["virtualmachines/full-admin"] = true
 			})
 
-			It("should allow hotpluggable CD-ROM media changes", func() {
-				// Add hotpluggable CD-ROM volume
-				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
-					Name: "cdrom1",
-					VolumeSource: kubevirtiov1.VolumeSource{
-						DataVolume: &kubevirtiov1.DataVolumeSource{
-							Name:         "ubuntu-iso",
-							Hotpluggable: true,
-						},
+			withRawObjects := func(oldRaw, newRaw []byte) context.Context {
+				return admission.NewContextWithRequest(context.Background(), admission.Request{
+					AdmissionRequest: admissionv1.AdmissionRequest{
+						UserInfo:  authenticationv1.UserInfo{Username: "test-user"},
+						Object:    runtime.RawExtension{Raw: newRaw},
+						OldObject: runtime.RawExtension{Raw: oldRaw},
 					},
 				})
+			}
 
-				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			It("should deny oversized objects before any permission checks", func() {
+				for i := 0; i < 100; i++ {
+					newVM.Spec.Template.Spec.Domain.Devices.Disks = append(newVM.Spec.Template.Spec.Domain.Devices.Disks,
+						kubevirtiov1.Disk{Name: fmt.Sprintf("disk-%d", i)})
+				}
+				oldRaw, err := json.Marshal(oldVM)
 				Expect(err).ToNot(HaveOccurred())
+				newRaw, err := json.Marshal(newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(len(newRaw)).To(BeNumerically(">", 1024))
+
+				warnings, err := validator.ValidateUpdate(withRawObjects(oldRaw, newRaw), oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("exceeds the maximum of 1024 bytes"))
 				Expect(warnings).To(BeNil())
+				Expect(mockPerm.calls).To(BeZero())
 			})
 
-			It("should deny storage changes", func() {
-				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "regular-volume"})
-
-				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			It("should deny when only the old object is oversized", func() {
+				warnings, err := validator.ValidateUpdate(withRawObjects(make([]byte, 2048), nil), oldVM, newVM)
 				Expect(err).To(HaveOccurred())
-				// With hierarchical model, non-CD-ROM storage changes aren't caught by specific checker
-				// so they fall through to the generic "spec fields" error
-				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(err.Error()).To(ContainSubstring("VirtualMachine default/test-vm object size 2048 bytes"))
 				Expect(warnings).To(BeNil())
 			})
-		})
 
-		Context("with multiple permissions", func() {
-			BeforeEach(func() {
-				mockPerm.permissions["virtualmachines/full-admin"] = false
-				mockPerm.permissions["*"] = false
-				mockPerm.permissions["virtualmachines/storage-admin"] = true
-				mockPerm.permissions["virtualmachines/cdrom-user"] = true
+			It("should allow objects within the limit", func() {
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+				oldRaw, err := json.Marshal(oldVM)
+				Expect(err).ToNot(HaveOccurred())
+				newRaw, err := json.Marshal(newVM)
+				Expect(err).ToNot(HaveOccurred())
 
-				// Setup CD-ROM disk
-				cdromDisk := kubevirtiov1.Disk{
-					Name: "cdrom1",
-					DiskDevice: kubevirtiov1.DiskDevice{
-						CDRom: &kubevirtiov1.CDRomTarget{
-							Bus: "sata",
-						},
-					},
-				}
-				oldVM.Spec.Template.Spec.Domain.Devices.Disks = append(oldVM.Spec.Template.Spec.Domain.Devices.Disks, cdromDisk)
-				newVM.Spec.Template.Spec.Domain.Devices.Disks = append(newVM.Spec.Template.Spec.Domain.Devices.Disks, cdromDisk)
+				warnings, err := validator.ValidateUpdate(withRawObjects(oldRaw, newRaw), oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
 			})
 
-			It("should allow both storage and CD-ROM changes", func() {
-				// Add regular volume
-				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
-
-				// Add hotpluggable CD-ROM volume
-				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
-					Name: "cdrom1",
-					VolumeSource: kubevirtiov1.VolumeSource{
-						DataVolume: &kubevirtiov1.DataVolumeSource{
-							Name:         "ubuntu-iso",
-							Hotpluggable: true,
-						},
-					},
-				})
+			It("should not limit object size when disabled", func() {
+				validator.MaxObjectBytes = 0
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
 
-				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				warnings, err := validator.ValidateUpdate(withRawObjects(make([]byte, 2048), make([]byte, 2048)), oldVM, newVM)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(warnings).To(BeNil())
 			})
+		})
 
-			It("should still deny unauthorized changes", func() {
+		Context("with full-admin permissions", func() {
+			It("should allow all changes when user has full-admin permission", func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = true
+
+				// Make arbitrary changes (CPU, storage, network, etc.)
 				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+				newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks, kubevirtiov1.Network{Name: "network2"})
 
 				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(err).ToNot(HaveOccurred())
 				Expect(warnings).To(BeNil())
 			})
 		})
 
-		Context("with network-admin permission", func() {
-			BeforeEach(func() {
+		Context("without subresource permissions", func() {
+			It("should allow all changes when user has no subresource permissions (backwards compatible)", func() {
 				mockPerm.permissions["virtualmachines/full-admin"] = false
 				mockPerm.permissions["*"] = false
 				mockPerm.permissions["virtualmachines/storage-admin"] = false
 				mockPerm.permissions["virtualmachines/cdrom-user"] = false
-				mockPerm.permissions["virtualmachines/network-admin"] = true
-			})
-
-			It("should allow network changes", func() {
-				newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks, kubevirtiov1.Network{Name: "secondary"})
-
-				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
-				Expect(err).ToNot(HaveOccurred())
-				Expect(warnings).To(BeNil())
-			})
 
-			It("should allow interface changes", func() {
-				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces, kubevirtiov1.Interface{Name: "eth1"})
+				// Make storage changes
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
 
 				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(warnings).To(BeNil())
 			})
+		})
 
-			It("should deny storage changes", func() {
+		Context("with prefetched permissions", func() {
+			BeforeEach(func() {
+				validator.PrefetchPermissions = true
 				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+			})
 
+			It("should check every subresource exactly once for a user without permissions", func() {
 				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(err).ToNot(HaveOccurred())
 				Expect(warnings).To(BeNil())
-			})
-		})
-
-		Context("with compute-admin permission", func() {
-			BeforeEach(func() {
-				mockPerm.permissions["virtualmachines/full-admin"] = false
-				mockPerm.permissions["*"] = false
-				mockPerm.permissions["virtualmachines/storage-admin"] = false
-				mockPerm.permissions["virtualmachines/network-admin"] = false
-				mockPerm.permissions["virtualmachines/compute-admin"] = true
+				Expect(mockPerm.calls).To(Equal(len(validator.subresourcesToCheck(DecisionContext{}))))
 			})
 
-			It("should allow CPU changes", func() {
-				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+			It("should only prefetch and reuse the permissions of the changed categories", func() {
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
 
 				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(warnings).To(BeNil())
+				// full-admin and storage-admin
+				Expect(mockPerm.calls).To(Equal(2))
 			})
 
-			It("should allow resource changes", func() {
-				newVM.Spec.Template.Spec.Domain.Resources.OvercommitGuestOverhead = true
+			It("should still deny changes outside the granted categories", func() {
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
 
 				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
-				Expect(err).ToNot(HaveOccurred())
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
 				Expect(warnings).To(BeNil())
 			})
 
-			It("should deny storage changes", func() {
-				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+			It("should fail when the prefetch fails", func() {
+				mockPerm.shouldError = true
 
 				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(err.Error()).To(ContainSubstring("failed to prefetch permissions"))
 				Expect(warnings).To(BeNil())
 			})
 		})
 
-		Context("with devices-admin permission", func() {
+		Context("with a single-category change", func() {
 			BeforeEach(func() {
-				mockPerm.permissions["virtualmachines/full-admin"] = false
-				mockPerm.permissions["*"] = false
-				mockPerm.permissions["virtualmachines/storage-admin"] = false
-				mockPerm.permissions["virtualmachines/network-admin"] = false
-				mockPerm.permissions["virtualmachines/devices-admin"] = true
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
 			})
 
-			It("should allow GPU changes", func() {
-				newVM.Spec.Template.Spec.Domain.Devices.GPUs = append(newVM.Spec.Template.Spec.Domain.Devices.GPUs, kubevirtiov1.GPU{Name: "gpu1"})
+			It("should only review full-admin and the changed category's subresource", func() {
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
 
-				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(warnings).To(BeNil())
+				Expect(mockPerm.calls).To(Equal(2))
 			})
 
-			It("should allow host device changes", func() {
-				newVM.Spec.Template.Spec.Domain.Devices.HostDevices = append(newVM.Spec.Template.Spec.Domain.Devices.HostDevices, kubevirtiov1.HostDevice{Name: "dev1"})
+			It("should review the other categories only until one is granted", func() {
+				mockPerm.permissions["virtualmachines/network-admin"] = true
 
-				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError(ContainSubstring("does not have permission")))
+				Expect(mockPerm.calls).To(BeNumerically("<", len(validator.subresourcesToCheck(DecisionContext{}))))
+			})
+
+			It("should review every subresource once for a user without granular roles", func() {
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(warnings).To(BeNil())
+				Expect(mockPerm.calls).To(Equal(len(validator.subresourcesToCheck(DecisionContext{}))))
 			})
 
-			It("should deny compute changes", func() {
-				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+			Context("and MaxSARsPerRequest", func() {
+				BeforeEach(func() {
+					validator.MaxSARsPerRequest = 2
+				})
 
-				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("permission"))
-				Expect(warnings).To(BeNil())
-			})
-		})
+				It("should allow an update within the limit", func() {
+					mockPerm.permissions["virtualmachines/storage-admin"] = true
 
-		Context("hierarchical permissions (superset/subset)", func() {
-			It("should allow storage-admin to make CD-ROM changes (even without cdrom-user)", func() {
-				mockPerm.permissions["virtualmachines/full-admin"] = false
+					_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("should allow a user without granular roles whatever the limit", func() {
+					_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(mockPerm.calls).To(Equal(len(validator.subresourcesToCheck(DecisionContext{}))))
+				})
+
+				It("should fail an update needing more reviews without sending them", func() {
+					mockPerm.permissions["virtualmachines/network-admin"] = true
+					newVM.Spec.Template.Spec.Networks = []kubevirtiov1.Network{*kubevirtiov1.DefaultPodNetwork()}
+					newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+					_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).To(MatchError(ContainSubstring(
+						"update of VirtualMachine default/test-vm needs more than 2 SubjectAccessReviews")))
+					Expect(mockPerm.calls).To(Equal(2))
+				})
+
+				It("should count prefetched reviews", func() {
+					validator.PrefetchPermissions = true
+					validator.MaxSARsPerRequest = 1
+
+					_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).To(MatchError(ContainSubstring("needs more than 1 SubjectAccessReviews")))
+					Expect(mockPerm.calls).To(BeZero())
+				})
+			})
+		})
+
+		Context("with MaxChangedCategories", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+				mockPerm.permissions["virtualmachines/network-admin"] = true
+				mockPerm.permissions["virtualmachines/compute-admin"] = true
+
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+				newVM.Spec.Template.Spec.Networks = []kubevirtiov1.Network{*kubevirtiov1.DefaultPodNetwork()}
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+			})
+
+			It("should deny changing three categories under a threshold of 2", func() {
+				validator.MaxChangedCategories = 2
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError("update of VirtualMachine default/test-vm changes 3 categories, " +
+					"more than 2 require virtualmachines/full-admin"))
+				Expect(ReasonCodeOf(err)).To(Equal(ReasonCodeTooManyCategories))
+			})
+
+			It("should allow changing three categories under a threshold of 3", func() {
+				validator.MaxChangedCategories = 3
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should count a change covered by a subset role once", func() {
+				validator.MaxChangedCategories = 1
+				mockPerm.permissions["virtualmachines/memory-resize-user"] = true
+				newVM = oldVM.DeepCopy()
+				guest := resource.MustParse("2Gi")
+				newVM.Spec.Template.Spec.Domain.Memory = &kubevirtiov1.Memory{Guest: &guest}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should count a change covered by a superset role once when the subset role is lacking", func() {
+				validator.MaxChangedCategories = 1
+				newVM = oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Resources.Limits = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should count a GPU added with devices-admin once", func() {
+				validator.MaxChangedCategories = 1
+				mockPerm.permissions["virtualmachines/devices-admin"] = true
+				newVM = oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = []kubevirtiov1.GPU{{Name: "gpu1", DeviceName: "nvidia.com/A100"}}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should count the lacked categories whose changes are left", func() {
+				validator.MaxChangedCategories = 1
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = []kubevirtiov1.GPU{{Name: "gpu1", DeviceName: "nvidia.com/A100"}}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError("update of VirtualMachine default/test-vm changes 5 categories, " +
+					"more than 1 require virtualmachines/full-admin"))
+			})
+
+			It("should allow full-admin", func() {
+				validator.MaxChangedCategories = 2
+				mockPerm.permissions["virtualmachines/full-admin"] = true
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should not apply to users without granular roles", func() {
+				validator.MaxChangedCategories = 2
+				mockPerm.permissions = map[string]bool{}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("with a decision hook", func() {
+			var hook *recordingDecisionHook
+
+			BeforeEach(func() {
+				hook = &recordingDecisionHook{}
+				validator.DecisionHook = hook
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+			})
+
+			It("should be invoked on allow", func() {
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(hook.decisions).To(Equal([]Decision{{
+					User: authenticationv1.UserInfo{
+						Username: "test-user",
+						Groups:   []string{"test-group"},
+					},
+					VM:                types.NamespacedName{Namespace: "default", Name: "test-vm"},
+					ChangedCategories: []string{"storage"},
+					Allowed:           true,
+				}}))
+			})
+
+			It("should be invoked on deny with the denial reason", func() {
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(hook.decisions).To(HaveLen(1))
+				Expect(hook.decisions[0].Allowed).To(BeFalse())
+				Expect(hook.decisions[0].Reason).To(Equal(err.Error()))
+				Expect(hook.decisions[0].ChangedCategories).To(Equal([]string{"compute"}))
+				Expect(hook.decisions[0].VM).To(Equal(types.NamespacedName{Namespace: "default", Name: "test-vm"}))
+			})
+
+			It("should be invoked on short-circuit decisions", func() {
+				validator.DenyGroups = []string{"test-group"}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(hook.decisions).To(HaveLen(1))
+				Expect(hook.decisions[0].Allowed).To(BeFalse())
+			})
+
+			It("should not let hook errors change the decision", func() {
+				hook.err = fmt.Errorf("SIEM unavailable")
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+				Expect(hook.decisions).To(HaveLen(1))
+			})
+		})
+
+		Context("without an admission request in the context", func() {
+			BeforeEach(func() {
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+			})
+
+			It("should fail closed by default", func() {
+				_, err := validator.ValidateUpdate(context.Background(), oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("failed to get admission request from context"))
+				Expect(mockPerm.calls).To(BeZero())
+			})
+
+			It("should allow the update as an unknown user under the Allow policy", func() {
+				validator.MissingRequestPolicy = MissingRequestAllow
+
+				warnings, err := validator.ValidateUpdate(context.Background(), oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+				Expect(mockPerm.calls).To(BeZero())
+			})
+
+			It("should deny the update as an unknown user under the Deny policy", func() {
+				validator.MissingRequestPolicy = MissingRequestDeny
+
+				_, err := validator.ValidateUpdate(context.Background(), oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("cannot identify the user updating VirtualMachine default/test-vm"))
+				Expect(mockPerm.calls).To(BeZero())
+			})
+
+			It("should fail closed for unknown policies", func() {
+				validator.MissingRequestPolicy = "Maybe"
+
+				_, err := validator.ValidateUpdate(context.Background(), oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("failed to get admission request from context"))
+			})
+		})
+
+		Context("with an unauthenticated user", func() {
+			var unauthenticatedCtx context.Context
+
+			BeforeEach(func() {
+				unauthenticatedCtx = admission.NewContextWithRequest(context.Background(), admission.Request{
+					AdmissionRequest: admissionv1.AdmissionRequest{UserInfo: authenticationv1.UserInfo{}},
+				})
+				mockPerm.permissions["virtualmachines/full-admin"] = true
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+			})
+
+			It("should deny as unauthenticated by default, without any SubjectAccessReview", func() {
+				_, err := validator.ValidateUpdate(unauthenticatedCtx, oldVM, newVM)
+				Expect(err).To(MatchError("unauthenticated user cannot update VirtualMachine default/test-vm: the admission request has no username"))
+				Expect(mockPerm.calls).To(BeZero())
+			})
+
+			It("should allow as a user without permissions under the NoPermissions policy", func() {
+				validator.UnauthenticatedPolicy = UnauthenticatedNoPermissions
+
+				warnings, err := validator.ValidateUpdate(unauthenticatedCtx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+				Expect(mockPerm.calls).To(BeZero())
+			})
+
+			It("should deny as a user without permissions under the NoPermissions policy in strict mode", func() {
+				validator.UnauthenticatedPolicy = UnauthenticatedNoPermissions
+				validator.StrictMode = true
+
+				_, err := validator.ValidateUpdate(unauthenticatedCtx, oldVM, newVM)
+				Expect(err).To(MatchError("no applicable VM subresource permission granted for VirtualMachine default/test-vm"))
+				Expect(mockPerm.calls).To(BeZero())
+			})
+
+			It("should not prefetch permissions under the NoPermissions policy", func() {
+				validator.UnauthenticatedPolicy = UnauthenticatedNoPermissions
+				validator.PrefetchPermissions = true
+
+				_, err := validator.ValidateUpdate(unauthenticatedCtx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(mockPerm.calls).To(BeZero())
+			})
+		})
+
+		Context("with a live old object", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+			})
+
+			It("should diff against the live VM instead of the admission oldObject", func() {
+				// The stored VM still has 2 cores, while the stale oldObject already claims 4
+				liveVM := oldVM.DeepCopy()
+				liveVM.ResourceVersion = "2"
+				validator.LiveReader = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(liveVM).Build()
+
+				oldVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+
+			It("should allow changes the live VM authorizes", func() {
+				validator.LiveReader = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(oldVM.DeepCopy()).Build()
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should fall back to the admission oldObject when the VM is not found", func() {
+				validator.LiveReader = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should fail when the live VM cannot be read", func() {
+				validator.LiveReader = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithInterceptorFuncs(interceptor.Funcs{
+					Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+						return fmt.Errorf("connection refused")
+					},
+				}).Build()
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("failed to get live VirtualMachine default/test-vm"))
+			})
+		})
+
+		Context("in strict mode", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+			})
+
+			It("should deny a user with no subresource permissions", func() {
+				validator.StrictMode = true
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("no applicable VM subresource permission granted"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow the same user when strict mode is off", func() {
+				validator.StrictMode = false
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should still allow full-admin", func() {
+				validator.StrictMode = true
+				mockPerm.permissions["virtualmachines/full-admin"] = true
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with storage-admin permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["*"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+				mockPerm.permissions["virtualmachines/cdrom-user"] = false
+			})
+
+			It("should allow storage changes", func() {
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow filesystem changes", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems = append(newVM.Spec.Template.Spec.Domain.Devices.Filesystems, kubevirtiov1.Filesystem{Name: "shared-fs"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny non-storage changes", func() {
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny metadata changes", func() {
+				newVM.Labels = map[string]string{"new": "label"}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("metadata"))
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with fields defaulted by KubeVirt's mutating webhook", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+
+				// The stored VM predates the defaults, the mutating webhook sets them on the new object only
+				newVM.Spec.Template.Spec.Architecture = "amd64"
+				newVM.Spec.Template.Spec.Domain.Machine = &kubevirtiov1.Machine{Type: "q35"}
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+			})
+
+			It("should allow storage changes", func() {
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow storage changes to a VM with a defaulted instancetype kind", func() {
+				oldVM.Spec.Instancetype = &kubevirtiov1.InstancetypeMatcher{Name: "u1.medium"}
+				newVM.Spec.Instancetype = &kubevirtiov1.InstancetypeMatcher{Name: "u1.medium", Kind: "virtualmachineclusterinstancetype"}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny setting a non-default architecture and machine type", func() {
+				newVM.Spec.Template.Spec.Architecture = "s390x"
+				newVM.Spec.Template.Spec.Domain.Machine = &kubevirtiov1.Machine{Type: "custom-machine"}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(ReasonCodeOf(err)).To(Equal(ReasonCodeFullAdminRequired))
+			})
+
+			It("should deny setting a non-default machine type of the default architecture", func() {
+				newVM.Spec.Template.Spec.Domain.Machine = &kubevirtiov1.Machine{Type: "pc-i440fx-2.0"}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(ReasonCodeOf(err)).To(Equal(ReasonCodeFullAdminRequired))
+			})
+
+			It("should allow the configured defaults of the cluster", func() {
+				validator.ClusterDefaults = ClusterDefaults{Architecture: "arm64", MachineTypes: map[string]string{"arm64": "virt"}}
+				newVM.Spec.Template.Spec.Architecture = "arm64"
+				newVM.Spec.Template.Spec.Domain.Machine = &kubevirtiov1.Machine{Type: "virt"}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny changing a machine type that is already set", func() {
+				oldVM.Spec.Template.Spec.Domain.Machine = &kubevirtiov1.Machine{Type: "pc-q35-rhel8.6.0"}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+
+			It("should deny setting a namespaced instancetype kind", func() {
+				oldVM.Spec.Instancetype = &kubevirtiov1.InstancetypeMatcher{Name: "u1.medium"}
+				newVM.Spec.Instancetype = &kubevirtiov1.InstancetypeMatcher{Name: "u1.medium", Kind: "virtualmachineinstancetype"}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+		})
+
+		Context("with cdrom-user permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["*"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = false
+				mockPerm.permissions["virtualmachines/cdrom-user"] = true
+
+				// Setup CD-ROM disk in both VMs
+				cdromDisk := kubevirtiov1.Disk{
+					Name: "cdrom1",
+					DiskDevice: kubevirtiov1.DiskDevice{
+						CDRom: &kubevirtiov1.CDRomTarget{
+							Bus: "sata",
+						},
+					},
+				}
+				oldVM.Spec.Template.Spec.Domain.Devices.Disks = append(oldVM.Spec.Template.Spec.Domain.Devices.Disks, cdromDisk)
+				newVM.Spec.Template.Spec.Domain.Devices.Disks = append(newVM.Spec.Template.Spec.Domain.Devices.Disks, cdromDisk)
+			})
+
+			It("should allow hotpluggable CD-ROM media changes", func() {
+				// Add hotpluggable CD-ROM volume
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
+					Name: "cdrom1",
+					VolumeSource: kubevirtiov1.VolumeSource{
+						DataVolume: &kubevirtiov1.DataVolumeSource{
+							Name:         "ubuntu-iso",
+							Hotpluggable: true,
+						},
+					},
+				})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny storage changes", func() {
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "regular-volume"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				// With hierarchical model, non-CD-ROM storage changes aren't caught by specific checker
+				// so they fall through to the generic "spec fields" error
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny converting a regular disk to a CD-ROM", func() {
+				// disk1 is a data disk backed by a hotpluggable volume in both VMs
+				dataVolume := kubevirtiov1.Volume{
+					Name: "disk1",
+					VolumeSource: kubevirtiov1.VolumeSource{
+						DataVolume: &kubevirtiov1.DataVolumeSource{Name: "data", Hotpluggable: true},
+					},
+				}
+				oldVM.Spec.Template.Spec.Domain.Devices.Disks[0].DiskDevice = kubevirtiov1.DiskDevice{
+					Disk: &kubevirtiov1.DiskTarget{Bus: "virtio"},
+				}
+				oldVM.Spec.Template.Spec.Volumes = append(oldVM.Spec.Template.Spec.Volumes, dataVolume)
+				newVM = oldVM.DeepCopy()
+
+				// Turn the data disk into a CD-ROM and swap its media for an ISO
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].DiskDevice = kubevirtiov1.DiskDevice{
+					CDRom: &kubevirtiov1.CDRomTarget{Bus: "sata"},
+				}
+				newVM.Spec.Template.Spec.Volumes[1].DataVolume.Name = "ubuntu-iso"
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow storage-admin to convert a regular disk to a CD-ROM", func() {
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].DiskDevice = kubevirtiov1.DiskDevice{
+					CDRom: &kubevirtiov1.CDRomTarget{Bus: "sata"},
+				}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			Context("when changing the media source type", func() {
+				BeforeEach(func() {
+					dataVolumeMedia := kubevirtiov1.Volume{
+						Name: "cdrom1",
+						VolumeSource: kubevirtiov1.VolumeSource{
+							DataVolume: &kubevirtiov1.DataVolumeSource{
+								Name:         "ubuntu-iso",
+								Hotpluggable: true,
+							},
+						},
+					}
+					oldVM.Spec.Template.Spec.Volumes = append(oldVM.Spec.Template.Spec.Volumes, dataVolumeMedia)
+					newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, dataVolumeMedia)
+				})
+
+				It("should allow swapping DataVolume media for hotpluggable PVC media", func() {
+					newVM.Spec.Template.Spec.Volumes[1].VolumeSource = kubevirtiov1.VolumeSource{
+						PersistentVolumeClaim: &kubevirtiov1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{ClaimName: "fedora-iso"},
+							Hotpluggable:                      true,
+						},
+					}
+
+					warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(BeNil())
+				})
+
+				It("should deny swapping to a non-hotpluggable PVC", func() {
+					newVM.Spec.Template.Spec.Volumes[1].VolumeSource = kubevirtiov1.VolumeSource{
+						PersistentVolumeClaim: &kubevirtiov1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{ClaimName: "fedora-iso"},
+						},
+					}
+
+					warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("permission"))
+					Expect(warnings).To(BeNil())
+				})
+
+				It("should deny swapping to a hostDisk", func() {
+					newVM.Spec.Template.Spec.Volumes[1].VolumeSource = kubevirtiov1.VolumeSource{
+						HostDisk: &kubevirtiov1.HostDisk{Path: "/var/iso/fedora.iso", Type: kubevirtiov1.HostDiskExists},
+					}
+
+					warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("permission"))
+					Expect(warnings).To(BeNil())
+				})
+
+				It("should allow swapping to a hostDisk with storage-admin", func() {
+					mockPerm.permissions["virtualmachines/storage-admin"] = true
+					newVM.Spec.Template.Spec.Volumes[1].VolumeSource = kubevirtiov1.VolumeSource{
+						HostDisk: &kubevirtiov1.HostDisk{Path: "/var/iso/fedora.iso", Type: kubevirtiov1.HostDiskExists},
+					}
+
+					warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(BeNil())
+				})
+			})
+		})
+
+		Context("with multiple permissions", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["*"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+				mockPerm.permissions["virtualmachines/cdrom-user"] = true
+
+				// Setup CD-ROM disk
+				cdromDisk := kubevirtiov1.Disk{
+					Name: "cdrom1",
+					DiskDevice: kubevirtiov1.DiskDevice{
+						CDRom: &kubevirtiov1.CDRomTarget{
+							Bus: "sata",
+						},
+					},
+				}
+				oldVM.Spec.Template.Spec.Domain.Devices.Disks = append(oldVM.Spec.Template.Spec.Domain.Devices.Disks, cdromDisk)
+				newVM.Spec.Template.Spec.Domain.Devices.Disks = append(newVM.Spec.Template.Spec.Domain.Devices.Disks, cdromDisk)
+			})
+
+			It("should allow both storage and CD-ROM changes", func() {
+				// Add regular volume
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+
+				// Add hotpluggable CD-ROM volume
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
+					Name: "cdrom1",
+					VolumeSource: kubevirtiov1.VolumeSource{
+						DataVolume: &kubevirtiov1.DataVolumeSource{
+							Name:         "ubuntu-iso",
+							Hotpluggable: true,
+						},
+					},
+				})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should still deny unauthorized changes", func() {
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with network-admin permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["*"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = false
+				mockPerm.permissions["virtualmachines/cdrom-user"] = false
+				mockPerm.permissions["virtualmachines/network-admin"] = true
+			})
+
+			It("should allow network changes", func() {
+				newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks, kubevirtiov1.Network{Name: "secondary"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow interface changes", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces, kubevirtiov1.Interface{Name: "eth1"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny storage changes", func() {
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			Context("and an existing interface", func() {
+				BeforeEach(func() {
+					oldVM.Spec.Template.Spec.Domain.Devices.Interfaces = []kubevirtiov1.Interface{
+						{Name: "default", MacAddress: "02:00:00:00:00:01", Model: "virtio"},
+					}
+					oldVM.Spec.Template.Spec.Networks = []kubevirtiov1.Network{
+						{Name: "default", NetworkSource: kubevirtiov1.NetworkSource{Pod: &kubevirtiov1.PodNetwork{}}},
+					}
+					newVM = oldVM.DeepCopy()
+				})
+
+				It("should deny changing its macAddress without network-security-admin", func() {
+					newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress = "02:00:00:00:00:02"
+
+					_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("does not have permission"))
+				})
+
+				It("should deny changing its ports or acpiIndex without network-security-admin", func() {
+					newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Ports = []kubevirtiov1.Port{{Port: 22}}
+					_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).To(HaveOccurred())
+
+					newVM = oldVM.DeepCopy()
+					newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].ACPIIndex = 2
+					_, err = validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).To(HaveOccurred())
+				})
+
+				It("should allow changing its macAddress with network-security-admin", func() {
+					mockPerm.permissions["virtualmachines/network-security-admin"] = true
+					newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress = "02:00:00:00:00:02"
+					newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Model = "e1000"
+
+					warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(BeNil())
+				})
+
+				It("should deny moving its pciAddress without network-security-admin", func() {
+					oldVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].PciAddress = "0000:81:01.0"
+					newVM = oldVM.DeepCopy()
+					newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].PciAddress = "0000:81:02.0"
+
+					_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("does not have permission"))
+
+					mockPerm.permissions["virtualmachines/network-security-admin"] = true
+					_, err = validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("should allow adding an interface with an explicit pciAddress", func() {
+					newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces,
+						kubevirtiov1.Interface{Name: "eth1", PciAddress: "0000:81:01.0"})
+
+					_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("should allow adding an interface with a macAddress", func() {
+					newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces,
+						kubevirtiov1.Interface{Name: "eth1", MacAddress: "02:00:00:00:00:03"})
+
+					warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(BeNil())
+				})
+
+				It("should allow removing the interface", func() {
+					newVM.Spec.Template.Spec.Domain.Devices.Interfaces = nil
+					newVM.Spec.Template.Spec.Networks = nil
+
+					warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(BeNil())
+				})
+			})
+		})
+
+		Context("with network-operator permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/network-operator"] = true
+
+				oldVM.Spec.Template.Spec.Domain.Devices.Interfaces = []kubevirtiov1.Interface{
+					{Name: "default", Model: "virtio"},
+				}
+				newVM = oldVM.DeepCopy()
+			})
+
+			It("should allow taking a link down and up", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].State = kubevirtiov1.InterfaceStateLinkDown
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+
+				warnings, err = validator.ValidateUpdate(ctx, newVM, oldVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny other interface changes", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Model = "e1000"
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+
+			It("should deny a link state change combined with another interface change", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].State = kubevirtiov1.InterfaceStateLinkDown
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces,
+					kubevirtiov1.Interface{Name: "eth1"})
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should allow link state changes with network-admin", func() {
+				mockPerm.permissions["virtualmachines/network-operator"] = false
+				mockPerm.permissions["virtualmachines/network-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].State = kubevirtiov1.InterfaceStateLinkDown
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with network-tuning-admin permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/network-tuning-admin"] = true
+
+				oldVM.Spec.Template.Spec.Domain.Devices.Interfaces = []kubevirtiov1.Interface{
+					{Name: "default", Model: "virtio"},
+				}
+				newVM = oldVM.DeepCopy()
+			})
+
+			It("should allow changing the model of an existing interface", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Model = "e1000"
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny adding an interface", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces,
+					kubevirtiov1.Interface{Name: "eth1", Model: "e1000"})
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError(ContainSubstring("does not have permission")))
+				Expect(ReasonCodeOf(err)).To(Equal(ReasonCode("NETWORK_FORBIDDEN")))
+			})
+
+			It("should deny a model change combined with a link state change", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Model = "e1000"
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].State = kubevirtiov1.InterfaceStateLinkDown
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should allow model changes with network-admin", func() {
+				mockPerm.permissions["virtualmachines/network-tuning-admin"] = false
+				mockPerm.permissions["virtualmachines/network-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Model = "e1000"
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with network-security-admin permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/network-security-admin"] = true
+
+				oldVM.Spec.Template.Spec.Domain.Devices.Interfaces = []kubevirtiov1.Interface{
+					{Name: "default", MacAddress: "02:00:00:00:00:01"},
+				}
+				newVM = oldVM.DeepCopy()
+			})
+
+			It("should allow changing the macAddress of an existing interface", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress = "02:00:00:00:00:02"
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny adding an interface", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces,
+					kubevirtiov1.Interface{Name: "eth1"})
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+
+			It("should deny other edits of the interface", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress = "02:00:00:00:00:02"
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Model = "e1000"
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should allow adding a port to an existing interface", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Ports = []kubevirtiov1.Port{{Port: 80}}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with network-ports-admin permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/network-ports-admin"] = true
+
+				oldVM.Spec.Template.Spec.Domain.Devices.Interfaces = []kubevirtiov1.Interface{
+					{Name: "default", MacAddress: "02:00:00:00:00:01", Ports: []kubevirtiov1.Port{{Port: 22}}},
+				}
+				newVM = oldVM.DeepCopy()
+			})
+
+			It("should allow adding a port to an existing interface", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Ports = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Ports,
+					kubevirtiov1.Port{Name: "http", Port: 80, Protocol: "TCP"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow removing the ports of an existing interface", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Ports = nil
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should deny a port change combined with a macAddress change", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Ports = []kubevirtiov1.Port{{Port: 80}}
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress = "02:00:00:00:00:02"
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+
+			It("should deny adding an interface with ports", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces,
+					kubevirtiov1.Interface{Name: "eth1", Ports: []kubevirtiov1.Port{{Port: 80}}})
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should deny adding a port without network-ports-admin", func() {
+				mockPerm.permissions["virtualmachines/network-ports-admin"] = false
+				mockPerm.permissions["virtualmachines/network-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Ports = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Ports,
+					kubevirtiov1.Port{Port: 80})
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+		})
+
+		Context("with bridge-admin permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/bridge-admin"] = true
+
+				oldVM.Spec.Template.Spec.Domain.Devices.Interfaces = []kubevirtiov1.Interface{{
+					Name:                   "default",
+					InterfaceBindingMethod: kubevirtiov1.InterfaceBindingMethod{Masquerade: &kubevirtiov1.InterfaceMasquerade{}},
+				}}
+				oldVM.Spec.Template.Spec.Networks = []kubevirtiov1.Network{*kubevirtiov1.DefaultPodNetwork()}
+				newVM = oldVM.DeepCopy()
+			})
+
+			toBridge := func(iface *kubevirtiov1.Interface) {
+				iface.InterfaceBindingMethod = kubevirtiov1.InterfaceBindingMethod{Bridge: &kubevirtiov1.InterfaceBridge{}}
+			}
+
+			It("should allow converting an interface to bridge binding", func() {
+				toBridge(&newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0])
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny converting an interface to bridge binding with only network-admin", func() {
+				mockPerm.permissions["virtualmachines/bridge-admin"] = false
+				mockPerm.permissions["virtualmachines/network-admin"] = true
+				toBridge(&newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0])
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(ReasonCodeOf(err)).To(Equal(ReasonCode("BRIDGE_FORBIDDEN")))
+			})
+
+			It("should deny converting an interface from bridge binding with only network-admin", func() {
+				mockPerm.permissions["virtualmachines/bridge-admin"] = false
+				mockPerm.permissions["virtualmachines/network-admin"] = true
+				toBridge(&oldVM.Spec.Template.Spec.Domain.Devices.Interfaces[0])
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(ReasonCodeOf(err)).To(Equal(ReasonCode("BRIDGE_FORBIDDEN")))
+			})
+
+			It("should allow network-admin to edit a masquerade interface", func() {
+				mockPerm.permissions["virtualmachines/bridge-admin"] = false
+				mockPerm.permissions["virtualmachines/network-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Model = "virtio"
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny other edits of the interface", func() {
+				toBridge(&newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0])
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Model = "e1000"
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+			})
+
+			Context("when adding a bridged Multus interface", func() {
+				BeforeEach(func() {
+					newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces,
+						kubevirtiov1.Interface{Name: "vlan100"})
+					toBridge(&newVM.Spec.Template.Spec.Domain.Devices.Interfaces[1])
+					newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks, kubevirtiov1.Network{
+						Name: "vlan100",
+						NetworkSource: kubevirtiov1.NetworkSource{
+							Multus: &kubevirtiov1.MultusNetwork{NetworkName: "vlan100-nad"},
+						},
+					})
+				})
+
+				It("should deny multus-admin", func() {
+					mockPerm.permissions["virtualmachines/bridge-admin"] = false
+					mockPerm.permissions["virtualmachines/multus-admin"] = true
+
+					_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).To(HaveOccurred())
+					Expect(ReasonCodeOf(err)).To(Equal(ReasonCode("BRIDGE_FORBIDDEN")))
+				})
+
+				It("should deny bridge-admin alone", func() {
+					_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).To(HaveOccurred())
+				})
+
+				It("should allow multus-admin with bridge-admin", func() {
+					mockPerm.permissions["virtualmachines/multus-admin"] = true
+
+					warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(BeNil())
+				})
+			})
+		})
+
+		Context("with sriov-admin permission", func() {
+			var sriovInterface kubevirtiov1.Interface
+
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				sriovInterface = kubevirtiov1.Interface{
+					Name: "vf",
+					InterfaceBindingMethod: kubevirtiov1.InterfaceBindingMethod{
+						SRIOV: &kubevirtiov1.InterfaceSRIOV{},
+					},
+				}
+			})
+
+			It("should allow adding an SR-IOV interface", func() {
+				mockPerm.permissions["virtualmachines/sriov-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces, sriovInterface)
+				newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks, kubevirtiov1.Network{Name: "vf"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny adding an SR-IOV interface with only network-admin", func() {
+				mockPerm.permissions["virtualmachines/network-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces, sriovInterface)
+				newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks, kubevirtiov1.Network{Name: "vf"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow adding a masquerade interface with only network-admin", func() {
+				mockPerm.permissions["virtualmachines/network-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces, kubevirtiov1.Interface{
+					Name: "default",
+					InterfaceBindingMethod: kubevirtiov1.InterfaceBindingMethod{
+						Masquerade: &kubevirtiov1.InterfaceMasquerade{},
+					},
+				})
+				newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks, *kubevirtiov1.DefaultPodNetwork())
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny adding a masquerade interface with only sriov-admin", func() {
+				mockPerm.permissions["virtualmachines/sriov-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces, kubevirtiov1.Interface{Name: "secondary"})
+				newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks, kubevirtiov1.Network{Name: "secondary"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow SR-IOV and masquerade changes with both permissions", func() {
+				mockPerm.permissions["virtualmachines/network-admin"] = true
+				mockPerm.permissions["virtualmachines/sriov-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces,
+					kubevirtiov1.Interface{Name: "secondary"}, sriovInterface)
+				newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks,
+					kubevirtiov1.Network{Name: "secondary"}, kubevirtiov1.Network{Name: "vf"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with multus-admin permission", func() {
+			var multusNetwork kubevirtiov1.Network
+
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/multus-admin"] = true
+				multusNetwork = kubevirtiov1.Network{
+					Name: "vlan100",
+					NetworkSource: kubevirtiov1.NetworkSource{
+						Multus: &kubevirtiov1.MultusNetwork{NetworkName: "vlan100-nad"},
+					},
+				}
+			})
+
+			It("should allow adding a Multus network and its interface", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces,
+					kubevirtiov1.Interface{Name: "vlan100"})
+				newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks, multusNetwork)
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny adding a Multus network without multus-admin or network-admin", func() {
+				mockPerm.permissions["virtualmachines/multus-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+				newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks, multusNetwork)
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow network-admin to add a Multus network (superset)", func() {
+				mockPerm.permissions["virtualmachines/multus-admin"] = false
+				mockPerm.permissions["virtualmachines/network-admin"] = true
+				newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks, multusNetwork)
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny pod network changes", func() {
+				newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks, *kubevirtiov1.DefaultPodNetwork())
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny switching the pod network to Multus", func() {
+				podNetwork := *kubevirtiov1.DefaultPodNetwork()
+				oldVM.Spec.Template.Spec.Networks = append(oldVM.Spec.Template.Spec.Networks, podNetwork)
+				newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks, kubevirtiov1.Network{
+					Name:          podNetwork.Name,
+					NetworkSource: multusNetwork.NetworkSource,
+				})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with compute-admin permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["*"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = false
+				mockPerm.permissions["virtualmachines/network-admin"] = false
+				mockPerm.permissions["virtualmachines/compute-admin"] = true
+			})
+
+			It("should allow CPU changes", func() {
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow resource changes", func() {
+				newVM.Spec.Template.Spec.Domain.Resources.OvercommitGuestOverhead = true
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny storage changes", func() {
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with compute-live-admin required for running VMs", func() {
+			BeforeEach(func() {
+				for _, checker := range validator.FieldCheckers {
+					if compute, ok := checker.(*ComputePermissionChecker); ok {
+						compute.RequireLiveAdminWhenRunning = true
+					}
+				}
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/compute-admin"] = true
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+			})
+
+			It("should allow compute changes to a stopped VM with compute-admin", func() {
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny compute changes to a running VM with only compute-admin", func() {
+				oldVM.Status.Created = true
+				oldVM.Status.Ready = true
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow compute changes to a running VM with compute-live-admin", func() {
+				oldVM.Status.Created = true
+				mockPerm.permissions["virtualmachines/compute-live-admin"] = true
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should still treat compute-admin as opting in to restrictions on a running VM", func() {
+				oldVM.Status.Created = true
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 2
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = append(newVM.Spec.Template.Spec.Domain.Devices.GPUs, kubevirtiov1.GPU{Name: "gpu1"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		It("should not require compute-live-admin for running VMs by default", func() {
+			mockPerm.permissions["virtualmachines/full-admin"] = false
+			mockPerm.permissions["virtualmachines/compute-admin"] = true
+			oldVM.Status.Created = true
+			newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+			warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(BeNil())
+		})
+
+		Context("with memory-resize-user permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/memory-resize-user"] = true
+				guest := resource.MustParse("2Gi")
+				oldVM.Spec.Template.Spec.Domain.Memory = &kubevirtiov1.Memory{Guest: &guest}
+				newVM = oldVM.DeepCopy()
+			})
+
+			It("should allow guest memory changes", func() {
+				guest := resource.MustParse("4Gi")
+				newVM.Spec.Template.Spec.Domain.Memory.Guest = &guest
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny hugepages changes", func() {
+				newVM.Spec.Template.Spec.Domain.Memory.Hugepages = &kubevirtiov1.Hugepages{PageSize: "1Gi"}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny guest memory changes combined with hugepages", func() {
+				guest := resource.MustParse("4Gi")
+				newVM.Spec.Template.Spec.Domain.Memory.Guest = &guest
+				newVM.Spec.Template.Spec.Domain.Memory.Hugepages = &kubevirtiov1.Hugepages{PageSize: "2Mi"}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny CPU changes", func() {
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow hugepages changes with compute-admin", func() {
+				mockPerm.permissions["virtualmachines/compute-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Memory.Hugepages = &kubevirtiov1.Hugepages{PageSize: "1Gi"}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny adding a CPU feature without cpu-features-admin", func() {
+				newVM.Spec.Template.Spec.Domain.CPU.Features = []kubevirtiov1.CPUFeature{{Name: "pcid", Policy: "require"}}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+		})
+
+		Context("with memory-limit-user permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/memory-limit-user"] = true
+				oldVM.Spec.Template.Spec.Domain.Resources = kubevirtiov1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+				}
+				newVM = oldVM.DeepCopy()
+			})
+
+			It("should allow a limit-only change", func() {
+				newVM.Spec.Template.Spec.Domain.Resources.Limits[corev1.ResourceMemory] = resource.MustParse("4Gi")
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny a memory request increase", func() {
+				newVM.Spec.Template.Spec.Domain.Resources.Requests[corev1.ResourceMemory] = resource.MustParse("4Gi")
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+
+			It("should deny a limit change combined with a request change", func() {
+				newVM.Spec.Template.Spec.Domain.Resources.Requests[corev1.ResourceMemory] = resource.MustParse("4Gi")
+				newVM.Spec.Template.Spec.Domain.Resources.Limits[corev1.ResourceMemory] = resource.MustParse("4Gi")
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+
+			It("should allow a request change with compute-admin", func() {
+				mockPerm.permissions["virtualmachines/compute-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Resources.Requests[corev1.ResourceMemory] = resource.MustParse("4Gi")
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should attribute limit and request changes to separate categories", func() {
+				validator.ReportAllMissingPermissions = true
+				mockPerm.permissions["virtualmachines/memory-limit-user"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+
+				limitVM := oldVM.DeepCopy()
+				limitVM.Spec.Template.Spec.Domain.Resources.Limits[corev1.ResourceMemory] = resource.MustParse("4Gi")
+				_, err := validator.ValidateUpdate(ctx, oldVM, limitVM)
+				Expect(err).To(MatchError(ContainSubstring("memory-limit, compute")))
+
+				requestVM := oldVM.DeepCopy()
+				requestVM.Spec.Template.Spec.Domain.Resources.Requests[corev1.ResourceMemory] = resource.MustParse("4Gi")
+				_, err = validator.ValidateUpdate(ctx, oldVM, requestVM)
+				Expect(err).To(MatchError(HaveSuffix(": compute")))
+			})
+		})
+
+		Context("with cpu-features-admin permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/cpu-features-admin"] = true
+			})
+
+			It("should allow adding a CPU feature", func() {
+				newVM.Spec.Template.Spec.Domain.CPU.Features = []kubevirtiov1.CPUFeature{{Name: "pcid", Policy: "require"}}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow removing a CPU feature", func() {
+				oldVM.Spec.Template.Spec.Domain.CPU.Features = []kubevirtiov1.CPUFeature{{Name: "pcid", Policy: "require"}}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny core count changes", func() {
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+
+			It("should deny a CPU feature change combined with a core count change", func() {
+				newVM.Spec.Template.Spec.Domain.CPU.Features = []kubevirtiov1.CPUFeature{{Name: "pcid", Policy: "require"}}
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should allow both with compute-admin", func() {
+				mockPerm.permissions["virtualmachines/cpu-features-admin"] = false
+				mockPerm.permissions["virtualmachines/compute-admin"] = true
+				newVM.Spec.Template.Spec.Domain.CPU.Features = []kubevirtiov1.CPUFeature{{Name: "pcid", Policy: "require"}}
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with cpu-pinning-admin permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/cpu-pinning-admin"] = true
+			})
+
+			It("should allow toggling isolateEmulatorThread", func() {
+				newVM.Spec.Template.Spec.Domain.CPU.DedicatedCPUPlacement = true
+				newVM.Spec.Template.Spec.Domain.CPU.IsolateEmulatorThread = true
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow changing the IOThreads policy", func() {
+				policy := kubevirtiov1.IOThreadsPolicyShared
+				newVM.Spec.Template.Spec.Domain.IOThreadsPolicy = &policy
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny core count changes", func() {
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+
+			It("should deny toggling isolateEmulatorThread with only compute-admin", func() {
+				mockPerm.permissions["virtualmachines/cpu-pinning-admin"] = false
+				mockPerm.permissions["virtualmachines/compute-admin"] = true
+				newVM.Spec.Template.Spec.Domain.CPU.IsolateEmulatorThread = true
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+
+			It("should deny adding CPU settings with placement with only compute-admin", func() {
+				mockPerm.permissions["virtualmachines/cpu-pinning-admin"] = false
+				mockPerm.permissions["virtualmachines/compute-admin"] = true
+				oldVM.Spec.Template.Spec.Domain.CPU = nil
+				newVM.Spec.Template.Spec.Domain.CPU = &kubevirtiov1.CPU{Cores: 4, DedicatedCPUPlacement: true}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+
+			It("should allow core count and placement changes with both", func() {
+				mockPerm.permissions["virtualmachines/compute-admin"] = true
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+				newVM.Spec.Template.Spec.Domain.CPU.IsolateEmulatorThread = true
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should report the category with all missing permissions reported", func() {
+				mockPerm.permissions["virtualmachines/cpu-pinning-admin"] = false
+				mockPerm.permissions["virtualmachines/compute-admin"] = true
+				validator.ReportAllMissingPermissions = true
+				newVM.Spec.Template.Spec.Domain.CPU.IsolateEmulatorThread = true
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError(ContainSubstring("missing permissions")))
+				Expect(err.Error()).To(ContainSubstring("cpu-pinning"))
+			})
+		})
+
+		Context("with cpu-topology-admin permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/cpu-topology-admin"] = true
+			})
+
+			It("should allow changing sockets and threads", func() {
+				newVM.Spec.Template.Spec.Domain.CPU.Sockets = 2
+				newVM.Spec.Template.Spec.Domain.CPU.Threads = 2
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny core count changes", func() {
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(ReasonCodeOf(err)).To(Equal(ReasonCode("COMPUTE_FORBIDDEN")))
+			})
+
+			It("should deny socket changes with only compute-admin", func() {
+				mockPerm.permissions["virtualmachines/cpu-topology-admin"] = false
+				mockPerm.permissions["virtualmachines/compute-admin"] = true
+				newVM.Spec.Template.Spec.Domain.CPU.Sockets = 2
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(ReasonCodeOf(err)).To(Equal(ReasonCode("CPU_TOPOLOGY_FORBIDDEN")))
+			})
+
+			It("should allow core count changes with only compute-admin", func() {
+				mockPerm.permissions["virtualmachines/cpu-topology-admin"] = false
+				mockPerm.permissions["virtualmachines/compute-admin"] = true
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow core and socket changes with both", func() {
+				mockPerm.permissions["virtualmachines/compute-admin"] = true
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+				newVM.Spec.Template.Spec.Domain.CPU.Sockets = 2
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with a hugepage-backed VM", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/compute-admin"] = true
+				oldVM.Spec.Template.Spec.Domain.Memory = &kubevirtiov1.Memory{Hugepages: &kubevirtiov1.Hugepages{PageSize: "1Gi"}}
+				oldVM.Spec.Template.Spec.Domain.Resources.Requests = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")}
+				newVM = oldVM.DeepCopy()
+			})
+
+			It("should deny a memory request increase with only compute-admin", func() {
+				newVM.Spec.Template.Spec.Domain.Resources.Requests[corev1.ResourceMemory] = resource.MustParse("8Gi")
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError("user does not have permission to modify one or more spec fields of VirtualMachine default/test-vm"))
+
+				validator.ReportAllMissingPermissions = true
+				_, err = validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError("missing permissions for VirtualMachine default/test-vm: hugepages"))
+			})
+
+			It("should allow a memory request increase with hugepages-admin", func() {
+				mockPerm.permissions["virtualmachines/compute-admin"] = false
+				mockPerm.permissions["virtualmachines/hugepages-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Resources.Requests[corev1.ResourceMemory] = resource.MustParse("8Gi")
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny a guest memory increase with memory-resize-user", func() {
+				mockPerm.permissions["virtualmachines/compute-admin"] = false
+				mockPerm.permissions["virtualmachines/memory-resize-user"] = true
+				guest := resource.MustParse("8Gi")
+				newVM.Spec.Template.Spec.Domain.Memory.Guest = &guest
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should allow a memory request decrease with compute-admin", func() {
+				newVM.Spec.Template.Spec.Domain.Resources.Requests[corev1.ResourceMemory] = resource.MustParse("2Gi")
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny an increase combined with other compute changes without hugepages-admin", func() {
+				newVM.Spec.Template.Spec.Domain.Resources.Requests[corev1.ResourceMemory] = resource.MustParse("8Gi")
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError(ContainSubstring("permitted compute, missing hugepages (virtualmachines/hugepages-admin)")))
+			})
+
+			It("should allow an increase combined with other compute changes with both", func() {
+				mockPerm.permissions["virtualmachines/hugepages-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Resources.Requests[corev1.ResourceMemory] = resource.MustParse("8Gi")
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow a memory request increase of a VM without hugepages with compute-admin", func() {
+				oldVM.Spec.Template.Spec.Domain.Memory = nil
+				newVM = oldVM.DeepCopy()
+				newVM.Spec.Template.Spec.Domain.Resources.Requests[corev1.ResourceMemory] = resource.MustParse("8Gi")
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with devices-admin permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["*"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = false
+				mockPerm.permissions["virtualmachines/network-admin"] = false
+				mockPerm.permissions["virtualmachines/devices-admin"] = true
+			})
+
+			It("should allow GPU changes", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = append(newVM.Spec.Template.Spec.Domain.Devices.GPUs, kubevirtiov1.GPU{Name: "gpu1"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow host device changes", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.HostDevices = append(newVM.Spec.Template.Spec.Domain.Devices.HostDevices, kubevirtiov1.HostDevice{Name: "dev1"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow adding and removing the RNG device", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Rng = &kubevirtiov1.Rng{}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+
+				warnings, err = validator.ValidateUpdate(ctx, newVM, oldVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow input device changes, including type and bus changes", func() {
+				oldVM.Spec.Template.Spec.Domain.Devices.Inputs = []kubevirtiov1.Input{
+					{Name: "tablet", Type: kubevirtiov1.InputTypeTablet, Bus: kubevirtiov1.InputBusUSB},
+				}
+				newVM.Spec.Template.Spec.Domain.Devices.Inputs = []kubevirtiov1.Input{
+					{Name: "tablet", Type: kubevirtiov1.InputTypeTablet, Bus: kubevirtiov1.InputBusVirtio},
+					{Name: "tablet2", Type: kubevirtiov1.InputTypeTablet},
+				}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow toggling autoattachGraphicsDevice", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.AutoattachGraphicsDevice = boolPtr(false)
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny compute changes", func() {
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with console-admin permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/devices-admin"] = false
+				mockPerm.permissions["virtualmachines/console-admin"] = true
+				oldVM.Spec.Template.Spec.Domain.Devices.GPUs = []kubevirtiov1.GPU{{Name: "vgpu1", DeviceName: "nvidia.com/GRID_T4-1Q"}}
+				newVM = oldVM.DeepCopy()
+			})
+
+			It("should allow changing vGPU display options", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs[0].VirtualGPUOptions = &kubevirtiov1.VGPUOptions{
+					Display: &kubevirtiov1.VGPUDisplayOptions{Enabled: boolPtr(false)},
+				}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow devices-admin to change vGPU display options (superset)", func() {
+				mockPerm.permissions["virtualmachines/console-admin"] = false
+				mockPerm.permissions["virtualmachines/devices-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs[0].VirtualGPUOptions = &kubevirtiov1.VGPUOptions{
+					Display: &kubevirtiov1.VGPUDisplayOptions{Enabled: boolPtr(false)},
+				}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny adding a GPU", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = append(newVM.Spec.Template.Spec.Domain.Devices.GPUs, kubevirtiov1.GPU{Name: "vgpu2"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny a display option change combined with a GPU device change", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs[0].VirtualGPUOptions = &kubevirtiov1.VGPUOptions{
+					Display: &kubevirtiov1.VGPUDisplayOptions{Enabled: boolPtr(false)},
+				}
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs[0].DeviceName = "nvidia.com/GRID_T4-2Q"
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with gpu-admin permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/devices-admin"] = false
+				mockPerm.permissions["virtualmachines/gpu-admin"] = true
+				oldVM.Spec.Template.Spec.Domain.Devices.GPUs = []kubevirtiov1.GPU{{Name: "gpu1", DeviceName: "nvidia.com/A100"}}
+				newVM = oldVM.DeepCopy()
+			})
+
+			It("should allow changing a GPU's deviceName", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs[0].DeviceName = "nvidia.com/H100"
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow adding and removing GPUs", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = []kubevirtiov1.GPU{{Name: "gpu2", DeviceName: "nvidia.com/H100"}}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow changing vGPU display options (superset of console)", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs[0].VirtualGPUOptions = &kubevirtiov1.VGPUOptions{
+					Display: &kubevirtiov1.VGPUDisplayOptions{Enabled: boolPtr(false)},
+				}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny adding a host device", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.HostDevices = []kubevirtiov1.HostDevice{{Name: "nic1", DeviceName: "intel.com/qat"}}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(ReasonCodeOf(err)).To(Equal(CategoryReasonCode("devices")))
+			})
+
+			It("should require gpu-admin for a GPU deviceName change", func() {
+				validator.ReportAllMissingPermissions = true
+				mockPerm.permissions["virtualmachines/gpu-admin"] = false
+				mockPerm.permissions["virtualmachines/console-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs[0].DeviceName = "nvidia.com/H100"
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError("missing permissions for VirtualMachine default/test-vm: gpu, devices"))
+			})
+
+			It("should allow devices-admin to change a GPU's deviceName (superset)", func() {
+				mockPerm.permissions["virtualmachines/gpu-admin"] = false
+				mockPerm.permissions["virtualmachines/devices-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs[0].DeviceName = "nvidia.com/H100"
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny removing a GPU when device removals require full-admin", func() {
+				for _, checker := range validator.FieldCheckers {
+					switch checker := checker.(type) {
+					case *GPUPermissionChecker:
+						checker.RequireFullAdminForRemovals = true
+					case *DevicesPermissionChecker:
+						checker.RequireFullAdminForRemovals = true
+					}
+				}
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = nil
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+		})
+
+		Context("when device removals require full-admin", func() {
+			BeforeEach(func() {
+				for _, checker := range validator.FieldCheckers {
+					if devices, ok := checker.(*DevicesPermissionChecker); ok {
+						devices.RequireFullAdminForRemovals = true
+					}
+				}
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/devices-admin"] = true
+
+				oldVM.Spec.Template.Spec.Domain.Devices.GPUs = []kubevirtiov1.GPU{
+					{Name: "gpu1", DeviceName: "nvidia.com/A100"},
+				}
+				oldVM.Spec.Template.Spec.Domain.Devices.HostDevices = []kubevirtiov1.HostDevice{
+					{Name: "nic1", DeviceName: "intel.com/qat"},
+				}
+				newVM = oldVM.DeepCopy()
+			})
+
+			It("should allow devices-admin to add a GPU", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = append(newVM.Spec.Template.Spec.Domain.Devices.GPUs,
+					kubevirtiov1.GPU{Name: "gpu2", DeviceName: "nvidia.com/A100"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow devices-admin to modify an existing GPU", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs[0].DeviceName = "nvidia.com/H100"
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny devices-admin removing a GPU", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = nil
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+
+			It("should deny devices-admin removing a host device", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.HostDevices = nil
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+
+			It("should allow devices-admin removing a GPU without the mode", func() {
+				for _, checker := range validator.FieldCheckers {
+					if devices, ok := checker.(*DevicesPermissionChecker); ok {
+						devices.RequireFullAdminForRemovals = false
+					}
+				}
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = nil
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow full-admin removing a GPU", func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = nil
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("when input type/bus changes require input-admin", func() {
+			BeforeEach(func() {
+				for _, checker := range validator.FieldCheckers {
+					if devices, ok := checker.(*DevicesPermissionChecker); ok {
+						devices.RequireInputAdminForTypeChanges = true
+					}
+				}
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/devices-admin"] = true
+
+				oldVM.Spec.Template.Spec.Domain.Devices.Inputs = []kubevirtiov1.Input{
+					{Name: "tablet", Type: kubevirtiov1.InputTypeTablet, Bus: kubevirtiov1.InputBusUSB},
+				}
+				newVM = oldVM.DeepCopy()
+			})
+
+			It("should allow devices-admin to add a standard tablet", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Inputs = append(newVM.Spec.Template.Spec.Domain.Devices.Inputs,
+					kubevirtiov1.Input{Name: "tablet2", Type: kubevirtiov1.InputTypeTablet, Bus: kubevirtiov1.InputBusUSB})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny devices-admin changing an input's bus", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Inputs[0].Bus = kubevirtiov1.InputBusVirtio
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny devices-admin adding a non-tablet input", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Inputs = append(newVM.Spec.Template.Spec.Domain.Devices.Inputs,
+					kubevirtiov1.Input{Name: "keyboard", Type: kubevirtiov1.InputTypeKeyboard, Bus: kubevirtiov1.InputBusUSB})
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+
+			It("should allow input-admin to change an input's bus", func() {
+				mockPerm.permissions["virtualmachines/input-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.Inputs[0].Bus = kubevirtiov1.InputBusVirtio
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny input-admin other device changes", func() {
+				mockPerm.permissions["virtualmachines/devices-admin"] = false
+				mockPerm.permissions["virtualmachines/input-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.Inputs[0].Bus = kubevirtiov1.InputBusVirtio
+				newVM.Spec.Template.Spec.Domain.Devices.Rng = &kubevirtiov1.Rng{}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		It("should deny toggling autoattachPodInterface without devices-admin", func() {
+			mockPerm.permissions["virtualmachines/full-admin"] = false
+			mockPerm.permissions["virtualmachines/network-admin"] = true
+
+			newVM.Spec.Template.Spec.Domain.Devices.AutoattachPodInterface = boolPtr(false)
+
+			warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("permission"))
+			Expect(warnings).To(BeNil())
+		})
+
+		Context("with boot-admin permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = false
+				mockPerm.permissions["virtualmachines/boot-admin"] = true
+			})
+
+			It("should allow changing the boot order alone", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].BootOrder = bootOrderPtr(1)
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow selecting the bootloader", func() {
+				newVM.Spec.Template.Spec.Domain.Firmware = &kubevirtiov1.Firmware{
+					Bootloader: &kubevirtiov1.Bootloader{BIOS: &kubevirtiov1.BIOS{}},
+				}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny enabling Secure Boot", func() {
+				newVM.Spec.Template.Spec.Domain.Firmware = &kubevirtiov1.Firmware{
+					Bootloader: &kubevirtiov1.Bootloader{EFI: &kubevirtiov1.EFI{SecureBoot: boolPtr(true)}},
+				}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should deny adding a disk with a boot order", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Disks = append(newVM.Spec.Template.Spec.Domain.Devices.Disks,
+					kubevirtiov1.Disk{Name: "disk2", BootOrder: bootOrderPtr(1)})
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError("user does not have permission to modify one or more spec fields of VirtualMachine default/test-vm"))
+			})
+
+			It("should allow a boot order change with only storage-admin", func() {
+				mockPerm.permissions["virtualmachines/boot-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].BootOrder = bootOrderPtr(1)
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with disk-tuning-admin permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = false
+				mockPerm.permissions["virtualmachines/disk-tuning-admin"] = true
+			})
+
+			It("should allow changing disk cache mode alone", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Cache = kubevirtiov1.CacheWriteThrough
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow changing disk block size alone", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].BlockSize = &kubevirtiov1.BlockSize{
+					MatchVolume: &kubevirtiov1.FeatureState{},
+				}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny a block size change combined with a disk serial change", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].BlockSize = &kubevirtiov1.BlockSize{
+					Custom: &kubevirtiov1.CustomBlockSize{Logical: 512, Physical: 4096},
+				}
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Serial = "SN-0002"
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny adding disks", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Disks = append(newVM.Spec.Template.Spec.Domain.Devices.Disks,
+					kubevirtiov1.Disk{Name: "disk2"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny volume changes", func() {
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should attribute a dedicated IO thread toggle to performance-admin", func() {
+				validator.ReportAllMissingPermissions = true
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].DedicatedIOThread = boolPtr(true)
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError("missing permissions for VirtualMachine default/test-vm: dedicated-io-thread, storage"))
+			})
+
+			It("should allow a cache change with a dedicated IO thread toggle with performance-admin too", func() {
+				mockPerm.permissions["virtualmachines/performance-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Cache = kubevirtiov1.CacheWriteThrough
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].DedicatedIOThread = boolPtr(true)
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with performance-admin permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = false
+				mockPerm.permissions["virtualmachines/performance-admin"] = true
+			})
+
+			It("should allow toggling a dedicated IO thread", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].DedicatedIOThread = boolPtr(true)
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should leave disk cache mode changes to disk-tuning-admin", func() {
+				validator.ReportAllMissingPermissions = true
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Cache = kubevirtiov1.CacheWriteThrough
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError("missing permissions for VirtualMachine default/test-vm: disk-tuning, storage"))
+			})
+
+			It("should deny adding disks", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Disks = append(newVM.Spec.Template.Spec.Domain.Devices.Disks,
+					kubevirtiov1.Disk{Name: "disk2", DedicatedIOThread: boolPtr(true)})
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("with disk-identity-admin permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = false
+				mockPerm.permissions["virtualmachines/disk-identity-admin"] = true
+			})
+
+			It("should allow changing a disk serial alone", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Serial = "SN-0002"
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow storage-admin to change a disk serial (superset)", func() {
+				mockPerm.permissions["virtualmachines/disk-identity-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Serial = "SN-0002"
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny a serial change combined with other disk changes", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Serial = "SN-0002"
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Cache = kubevirtiov1.CacheWriteThrough
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny volume changes", func() {
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with shared-disk-admin permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = false
+				mockPerm.permissions["virtualmachines/shared-disk-admin"] = true
+			})
+
+			It("should allow toggling shareable on an existing disk", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Shareable = boolPtr(true)
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow changing the error policy of an existing disk", func() {
+				policy := kubevirtiov1.DiskErrorPolicyStop
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].ErrorPolicy = &policy
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny toggling shareable without shared-disk-admin", func() {
+				mockPerm.permissions["virtualmachines/shared-disk-admin"] = false
+				mockPerm.permissions["virtualmachines/disk-tuning-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Shareable = boolPtr(true)
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow storage-admin to toggle shareable (superset)", func() {
+				mockPerm.permissions["virtualmachines/shared-disk-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Shareable = boolPtr(true)
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny a shareable change combined with other disk changes", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Shareable = boolPtr(true)
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Serial = "SN-0002"
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("when a change is only partially authorized", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/network-admin"] = true
+				newVM.Spec.Template.Spec.Networks = append(newVM.Spec.Template.Spec.Networks, kubevirtiov1.Network{Name: "secondary"})
+				newVM.Spec.Template.Spec.Domain.Devices.Interfaces = append(newVM.Spec.Template.Spec.Domain.Devices.Interfaces, kubevirtiov1.Interface{Name: "secondary"})
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = append(newVM.Spec.Template.Spec.Domain.Devices.GPUs, kubevirtiov1.GPU{Name: "gpu1"})
+			})
+
+			It("should name gpu and devices as the missing categories", func() {
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError("user does not have permission to modify one or more spec fields of VirtualMachine default/test-vm: " +
+					"permitted network, missing gpu (virtualmachines/gpu-admin), devices (virtualmachines/devices-admin)"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow the change with devices-admin", func() {
+				mockPerm.permissions["virtualmachines/devices-admin"] = true
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should keep the generic message when no change is permitted", func() {
+				mockPerm.permissions["virtualmachines/network-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError("user does not have permission to modify one or more spec fields of VirtualMachine default/test-vm"))
+			})
+		})
+
+		Context("when reporting all missing permissions", func() {
+			BeforeEach(func() {
+				validator.ReportAllMissingPermissions = true
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+			})
+
+			It("should list every missing category", func() {
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = append(newVM.Spec.Template.Spec.Domain.Devices.GPUs, kubevirtiov1.GPU{Name: "gpu1"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("missing permissions for VirtualMachine default/test-vm: compute, gpu, devices"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should not list subset categories covered by a superset permission", func() {
+				cdromDisk := kubevirtiov1.Disk{
+					Name: "cdrom1",
+					DiskDevice: kubevirtiov1.DiskDevice{
+						CDRom: &kubevirtiov1.CDRomTarget{Bus: "sata"},
+					},
+				}
+				oldVM.Spec.Template.Spec.Domain.Devices.Disks = append(oldVM.Spec.Template.Spec.Domain.Devices.Disks, cdromDisk)
+				newVM.Spec.Template.Spec.Domain.Devices.Disks = append(newVM.Spec.Template.Spec.Domain.Devices.Disks, cdromDisk)
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
+					Name: "cdrom1",
+					VolumeSource: kubevirtiov1.VolumeSource{
+						DataVolume: &kubevirtiov1.DataVolumeSource{Name: "ubuntu-iso", Hotpluggable: true},
+					},
+				})
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("missing permissions for VirtualMachine default/test-vm: compute"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow changes covered by held permissions", func() {
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		It("should attribute RNG device changes to devices", func() {
+			validator.ReportAllMissingPermissions = true
+			mockPerm.permissions["virtualmachines/full-admin"] = false
+			mockPerm.permissions["virtualmachines/storage-admin"] = true
+			newVM.Spec.Template.Spec.Domain.Devices.Rng = &kubevirtiov1.Rng{}
+
+			warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("missing permissions for VirtualMachine default/test-vm: devices"))
+			Expect(warnings).To(BeNil())
+		})
+
+		Context("with a disabled devices checker", func() {
+			BeforeEach(func() {
+				fieldCheckers, err := withoutCheckers(DefaultFieldCheckers(), []string{"devices"})
+				Expect(err).ToNot(HaveOccurred())
+				validator.FieldCheckers = fieldCheckers
+				validator.ReportAllMissingPermissions = true
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+			})
+
+			It("should attribute GPU changes to the gpu category, which stays enabled", func() {
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = append(newVM.Spec.Template.Spec.Domain.Devices.GPUs, kubevirtiov1.GPU{Name: "gpu1"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("missing permissions for VirtualMachine default/test-vm: gpu, compute"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow GPU changes with gpu-admin", func() {
+				mockPerm.permissions["virtualmachines/gpu-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = append(newVM.Spec.Template.Spec.Domain.Devices.GPUs, kubevirtiov1.GPU{Name: "gpu1"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny GPU changes as uncovered fields requiring full-admin once gpu is disabled too", func() {
+				fieldCheckers, err := withoutCheckers(DefaultFieldCheckers(), []string{"gpu", "devices"})
+				Expect(err).ToNot(HaveOccurred())
+				validator.FieldCheckers = fieldCheckers
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = append(newVM.Spec.Template.Spec.Domain.Devices.GPUs, kubevirtiov1.GPU{Name: "gpu1"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("user does not have permission to modify VirtualMachine default/test-vm: " +
+					"the changed spec fields are not covered by any granular role and require virtualmachines/full-admin"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny GPU changes even with devices-admin", func() {
+				mockPerm.permissions["virtualmachines/devices-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.GPUs = append(newVM.Spec.Template.Spec.Domain.Devices.GPUs, kubevirtiov1.GPU{Name: "gpu1"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with a termination grace period change", func() {
+			BeforeEach(func() {
+				validator.FieldCheckers = DefaultFieldCheckers()
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				gracePeriod := int64(0)
+				newVM.Spec.Template.Spec.TerminationGracePeriodSeconds = &gracePeriod
+			})
+
+			It("should allow it with lifecycle-admin", func() {
+				mockPerm.permissions["virtualmachines/lifecycle-admin"] = true
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny it with only storage-admin", func() {
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+
+			It("should not attribute it to compute-admin", func() {
+				mockPerm.permissions["virtualmachines/compute-admin"] = true
+				validator.ReportAllMissingPermissions = true
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError(ContainSubstring("missing permissions")))
+				Expect(err.Error()).To(ContainSubstring("lifecycle"))
+				Expect(err.Error()).ToNot(ContainSubstring("compute"))
+			})
+		})
+
+		Context("when migrating from running to runStrategy", func() {
+			BeforeEach(func() {
+				validator.FieldCheckers = DefaultFieldCheckers()
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				oldVM.Spec.Running = boolPtr(true)
+				newVM = oldVM.DeepCopy()
+				newVM.Spec.Running = nil
+				newVM.Spec.RunStrategy = strategyPtr(string(kubevirtiov1.RunStrategyAlways))
+			})
+
+			It("should allow it with lifecycle-admin", func() {
+				mockPerm.permissions["virtualmachines/lifecycle-admin"] = true
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should attribute it to lifecycle only", func() {
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+				validator.ReportAllMissingPermissions = true
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError("missing permissions for VirtualMachine default/test-vm: lifecycle"))
+			})
+
+			It("should attribute setting both fields to lifecycle", func() {
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+				validator.ReportAllMissingPermissions = true
+				newVM.Spec.Running = boolPtr(true)
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError("missing permissions for VirtualMachine default/test-vm: lifecycle"))
+			})
+
+			It("should leave rejecting both fields set to KubeVirt's validation", func() {
+				mockPerm.permissions["virtualmachines/lifecycle-admin"] = true
+				newVM.Spec.Running = boolPtr(true)
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with a change to a field no checker covers", func() {
+			const uncoveredMessage = "user does not have permission to modify VirtualMachine default/test-vm: " +
+				"the changed spec fields are not covered by any granular role and require virtualmachines/full-admin"
+
+			BeforeEach(func() {
+				validator.FieldCheckers = DefaultFieldCheckers()
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Chassis = &kubevirtiov1.Chassis{Asset: "asset-1"}
+			})
+
+			It("should deny it as requiring full-admin", func() {
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError(uncoveredMessage))
+			})
+
+			It("should deny it as requiring full-admin when reporting all missing permissions", func() {
+				validator.ReportAllMissingPermissions = true
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError(uncoveredMessage))
+			})
+
+			It("should deny it as a covered field when a covered change also lacks its role", func() {
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError("user does not have permission to modify one or more spec fields of VirtualMachine default/test-vm"))
+			})
+
+			It("should deny it as requiring full-admin when every covered change is permitted", func() {
+				mockPerm.permissions["virtualmachines/compute-admin"] = true
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError(uncoveredMessage))
+			})
+
+			It("should allow it with full-admin", func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = true
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Context("with every category granted", func() {
+				var categories []string
+
+				BeforeEach(func() {
+					categories = validator.subresourcesToCheck(NewDecisionContext(oldVM))[1:]
+					for _, subresource := range categories {
+						mockPerm.permissions[subresource] = true
+					}
+				})
+
+				It("should allow it like full-admin with FullAdminFromCategories", func() {
+					validator.FullAdminFromCategories = true
+
+					_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("should deny it without FullAdminFromCategories", func() {
+					_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).To(MatchError(uncoveredMessage))
+				})
+
+				It("should deny it when a single category is missing", func() {
+					validator.FullAdminFromCategories = true
+					mockPerm.permissions["virtualmachines/cpu-pinning-admin"] = false
+
+					_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).To(MatchError(uncoveredMessage))
+				})
+
+				It("should check each subresource only once", func() {
+					validator.FullAdminFromCategories = true
+					mockPerm.permissions[categories[len(categories)-1]] = false
+
+					_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).To(MatchError(uncoveredMessage))
+					Expect(mockPerm.calls).To(Equal(len(categories) + 1))
+				})
+			})
+		})
+
+		Context("with control annotation prefixes", func() {
+			BeforeEach(func() {
+				validator.FieldCheckers = DefaultFieldCheckers()
+				validator.ControlAnnotationPrefixes = []string{"kubevirt.io/", "descheduler.alpha.kubernetes.io/"}
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/template-metadata-admin"] = true
+				oldVM.Spec.Template.ObjectMeta.Annotations = map[string]string{
+					"kubevirt.io/allow-pod-bridge-network-live-migration": "",
+					"example.com/owner": "team-a",
+				}
+				newVM = oldVM.DeepCopy()
+			})
+
+			It("should deny adding a kubevirt.io/ template annotation with template-metadata-admin", func() {
+				newVM.Spec.Template.ObjectMeta.Annotations["kubevirt.io/keep-launcher-alive-after-failure"] = "true"
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError("user does not have permission to modify control annotations " +
+					"kubevirt.io/keep-launcher-alive-after-failure of VirtualMachine default/test-vm (requires virtualmachines/full-admin)"))
+			})
+
+			It("should deny removing a control annotation", func() {
+				delete(newVM.Spec.Template.ObjectMeta.Annotations, "kubevirt.io/allow-pod-bridge-network-live-migration")
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError(ContainSubstring("kubevirt.io/allow-pod-bridge-network-live-migration")))
+			})
+
+			It("should list every changed control annotation", func() {
+				newVM.Spec.Template.ObjectMeta.Annotations["descheduler.alpha.kubernetes.io/evict"] = "true"
+				newVM.Spec.Template.ObjectMeta.Annotations["kubevirt.io/allow-pod-bridge-network-live-migration"] = "false"
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError(ContainSubstring(
+					"control annotations descheduler.alpha.kubernetes.io/evict, kubevirt.io/allow-pod-bridge-network-live-migration of")))
+			})
+
+			It("should allow other template annotations with template-metadata-admin", func() {
+				newVM.Spec.Template.ObjectMeta.Annotations["example.com/owner"] = "team-b"
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should allow control annotation changes with full-admin", func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = true
+				newVM.Spec.Template.ObjectMeta.Annotations["kubevirt.io/keep-launcher-alive-after-failure"] = "true"
+				newVM.Annotations = map[string]string{"kubevirt.io/immediate-data-volume-creation": "false"}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should allow kubevirt.io/ template annotations when no prefix is configured", func() {
+				validator.ControlAnnotationPrefixes = nil
+				newVM.Spec.Template.ObjectMeta.Annotations["kubevirt.io/keep-launcher-alive-after-failure"] = "true"
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("with an instancetype revision change", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				oldVM.Spec.Instancetype = &kubevirtiov1.InstancetypeMatcher{Name: "u1.medium", RevisionName: "vm-u1.medium-v1"}
+				newVM = oldVM.DeepCopy()
+				newVM.Spec.Instancetype.RevisionName = "vm-u1.medium-v2"
+			})
+
+			It("should allow it with instancetype-admin", func() {
+				mockPerm.permissions["virtualmachines/instancetype-admin"] = true
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should attribute it to instancetype, not to a generic spec change", func() {
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError("user does not have permission to modify one or more spec fields of VirtualMachine default/test-vm"))
+
+				validator.ReportAllMissingPermissions = true
+				_, err = validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError("missing permissions for VirtualMachine default/test-vm: instancetype"))
+			})
+
+			It("should deny changing the named instancetype with instancetype-admin", func() {
+				mockPerm.permissions["virtualmachines/instancetype-admin"] = true
+				newVM.Spec.Instancetype.Name = "u1.large"
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError(ContainSubstring("require virtualmachines/full-admin")))
+			})
+		})
+
+		Context("with lifecycle-admin permission and no template", func() {
+			BeforeEach(func() {
+				validator.FieldCheckers = DefaultFieldCheckers()
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/lifecycle-admin"] = true
+
+				oldVM.Spec.Template = nil
+				oldVM.Spec.RunStrategy = strategyPtr("Halted")
+				newVM = oldVM.DeepCopy()
+			})
+
+			It("should allow lifecycle changes", func() {
+				newVM.Spec.RunStrategy = strategyPtr("Always")
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow lifecycle changes when only the old VM has a template", func() {
+				oldVM.Spec.Template = &kubevirtiov1.VirtualMachineInstanceTemplateSpec{}
+				oldVM.Spec.Running = boolPtr(false)
+				newVM.Spec.Template = &kubevirtiov1.VirtualMachineInstanceTemplateSpec{}
+				newVM.Spec.RunStrategy = nil
+				newVM.Spec.Running = boolPtr(true)
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should deny adding a template", func() {
+				newVM.Spec.RunStrategy = strategyPtr("Always")
+				newVM.Spec.Template = &kubevirtiov1.VirtualMachineInstanceTemplateSpec{}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+
+			It("should not panic in any checker", func() {
+				for _, checker := range DefaultFieldCheckers() {
+					oldCopy, newCopy := oldVM.DeepCopy(), newVM.DeepCopy()
+					newCopy.Spec.Template = &kubevirtiov1.VirtualMachineInstanceTemplateSpec{}
+					Expect(func() {
+						checker.HasChanged(oldCopy, newCopy)
+						checker.Neutralize(oldCopy, newCopy)
+						checker.HasChanged(newCopy, oldCopy)
+						checker.Neutralize(newCopy, oldCopy)
+					}).ToNot(Panic(), checker.Name())
+				}
+			})
+		})
+
+		Context("with template-metadata-admin permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/template-metadata-admin"] = true
+			})
+
+			It("should allow template label changes", func() {
+				newVM.Spec.Template.ObjectMeta.Labels = map[string]string{"zone": "east"}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny VM label changes", func() {
+				newVM.Labels = map[string]string{"zone": "east"}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("user does not have permission to modify VirtualMachine default/test-vm metadata"))
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		It("should explain template label denials clearly", func() {
+			mockPerm.permissions["virtualmachines/full-admin"] = false
+			mockPerm.permissions["virtualmachines/storage-admin"] = true
+
+			newVM.Spec.Template.ObjectMeta.Labels = map[string]string{"zone": "east"}
+
+			warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("template metadata (spec.template.metadata)"))
+			Expect(warnings).To(BeNil())
+		})
+
+		Context("denial messages", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+
+				oldVM.Namespace, oldVM.Name = "tenant-a", "db-vm"
+				newVM.Namespace, newVM.Name = "tenant-a", "db-vm"
+			})
+
+			It("should name the VM when denying spec changes", func() {
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("tenant-a/db-vm"))
+			})
+
+			It("should name the VM when denying metadata changes", func() {
+				newVM.Labels = map[string]string{"zone": "east"}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("tenant-a/db-vm"))
+			})
+
+			It("should name the VM when denying template metadata changes", func() {
+				newVM.Spec.Template.ObjectMeta.Labels = map[string]string{"zone": "east"}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("tenant-a/db-vm"))
+			})
+
+			It("should name the VM when reporting all missing permissions", func() {
+				validator.ReportAllMissingPermissions = true
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(MatchError("missing permissions for VirtualMachine tenant-a/db-vm: compute"))
+			})
+		})
+
+		Context("with filesystem-admin permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = false
+				mockPerm.permissions["virtualmachines/filesystem-admin"] = true
+			})
+
+			It("should allow adding a filesystem with its backing volume", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems = append(newVM.Spec.Template.Spec.Domain.Devices.Filesystems,
+					kubevirtiov1.Filesystem{Name: "shared-fs", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}})
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
+					Name: "shared-fs",
+					VolumeSource: kubevirtiov1.VolumeSource{
+						PersistentVolumeClaim: &kubevirtiov1.PersistentVolumeClaimVolumeSource{},
+					},
+				})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny block storage changes", func() {
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny changing a disk-backed volume", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems = append(newVM.Spec.Template.Spec.Domain.Devices.Filesystems,
+					kubevirtiov1.Filesystem{Name: "disk1"})
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "disk1"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with filesystem-user permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/filesystem-user"] = true
+			})
+
+			addFilesystem := func(name string, source kubevirtiov1.VolumeSource) {
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems = append(newVM.Spec.Template.Spec.Domain.Devices.Filesystems,
+					kubevirtiov1.Filesystem{Name: name, Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}})
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes,
+					kubevirtiov1.Volume{Name: name, VolumeSource: source})
+			}
+
+			It("should allow adding a PVC-backed filesystem", func() {
+				addFilesystem("shared-fs", kubevirtiov1.VolumeSource{
+					PersistentVolumeClaim: &kubevirtiov1.PersistentVolumeClaimVolumeSource{},
+				})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow adding a DataVolume-backed filesystem", func() {
+				addFilesystem("shared-fs", kubevirtiov1.VolumeSource{
+					DataVolume: &kubevirtiov1.DataVolumeSource{Name: "shared-dv"},
+				})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny adding a configMap-backed filesystem", func() {
+				addFilesystem("config-fs", kubevirtiov1.VolumeSource{
+					ConfigMap: &kubevirtiov1.ConfigMapVolumeSource{},
+				})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny adding a filesystem without a backing volume", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems = append(newVM.Spec.Template.Spec.Domain.Devices.Filesystems,
+					kubevirtiov1.Filesystem{Name: "unbacked-fs", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			Context("with an existing PVC-backed filesystem", func() {
+				BeforeEach(func() {
+					oldVM.Spec.Template.Spec.Domain.Devices.Filesystems = []kubevirtiov1.Filesystem{
+						{Name: "shared-fs", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}},
+					}
+					oldVM.Spec.Template.Spec.Volumes = append(oldVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
+						Name: "shared-fs",
+						VolumeSource: kubevirtiov1.VolumeSource{
+							PersistentVolumeClaim: &kubevirtiov1.PersistentVolumeClaimVolumeSource{},
+						},
+					})
+					newVM = oldVM.DeepCopy()
+				})
+
+				It("should attribute a change of its virtio-fs parameters to filesystem-admin", func() {
+					validator.ReportAllMissingPermissions = true
+					newVM.Spec.Template.Spec.Domain.Devices.Filesystems[0].Virtiofs = nil
+
+					_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).To(MatchError("missing permissions for VirtualMachine default/test-vm: filesystem, storage"))
+				})
+
+				It("should allow filesystem-admin to change its virtio-fs parameters", func() {
+					mockPerm.permissions["virtualmachines/filesystem-user"] = false
+					mockPerm.permissions["virtualmachines/filesystem-admin"] = true
+					newVM.Spec.Template.Spec.Domain.Devices.Filesystems[0].Virtiofs = nil
+
+					warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(BeNil())
+				})
+
+				It("should still allow adding another PVC-backed filesystem", func() {
+					addFilesystem("other-fs", kubevirtiov1.VolumeSource{
+						PersistentVolumeClaim: &kubevirtiov1.PersistentVolumeClaimVolumeSource{},
+					})
+
+					warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(BeNil())
+				})
+			})
+
+			It("should allow filesystem-admin to add a configMap-backed filesystem without filesystem-user", func() {
+				mockPerm.permissions["virtualmachines/filesystem-user"] = false
+				mockPerm.permissions["virtualmachines/filesystem-admin"] = true
+				addFilesystem("config-fs", kubevirtiov1.VolumeSource{
+					ConfigMap: &kubevirtiov1.ConfigMapVolumeSource{},
+				})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("when filesystem removals require filesystem-removal-admin", func() {
+			BeforeEach(func() {
+				for _, checker := range validator.FieldCheckers {
+					switch checker := checker.(type) {
+					case *FilesystemPermissionChecker:
+						checker.RequireRemovalAdmin = true
+					case *FilesystemUserPermissionChecker:
+						checker.RequireRemovalAdmin = true
+					}
+				}
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = false
+				mockPerm.permissions["virtualmachines/filesystem-admin"] = true
+
+				oldVM.Spec.Template.Spec.Domain.Devices.Filesystems = []kubevirtiov1.Filesystem{
+					{Name: "shared-fs", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}},
+				}
+				oldVM.Spec.Template.Spec.Volumes = append(oldVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
+					Name: "shared-fs",
+					VolumeSource: kubevirtiov1.VolumeSource{
+						PersistentVolumeClaim: &kubevirtiov1.PersistentVolumeClaimVolumeSource{},
+					},
+				})
+				newVM = oldVM.DeepCopy()
+			})
+
+			removeFilesystem := func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems = nil
+				newVM.Spec.Template.Spec.Volumes = oldVM.Spec.Template.Spec.Volumes[:len(oldVM.Spec.Template.Spec.Volumes)-1]
+			}
+
+			It("should allow filesystem-admin to add a mount", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems = append(newVM.Spec.Template.Spec.Domain.Devices.Filesystems,
+					kubevirtiov1.Filesystem{Name: "config-fs", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}})
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
+					Name:         "config-fs",
+					VolumeSource: kubevirtiov1.VolumeSource{ConfigMap: &kubevirtiov1.ConfigMapVolumeSource{}},
+				})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny filesystem-admin removing a mount", func() {
+				removeFilesystem()
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny filesystem-user removing a PVC-backed mount", func() {
+				mockPerm.permissions["virtualmachines/filesystem-admin"] = false
+				mockPerm.permissions["virtualmachines/filesystem-user"] = true
+				removeFilesystem()
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+
+			It("should allow filesystem-removal-admin to remove a mount", func() {
+				mockPerm.permissions["virtualmachines/filesystem-admin"] = false
+				mockPerm.permissions["virtualmachines/filesystem-removal-admin"] = true
+				removeFilesystem()
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny filesystem-removal-admin adding a mount", func() {
+				mockPerm.permissions["virtualmachines/filesystem-admin"] = false
+				mockPerm.permissions["virtualmachines/filesystem-removal-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.Filesystems = append(newVM.Spec.Template.Spec.Domain.Devices.Filesystems,
+					kubevirtiov1.Filesystem{Name: "other-fs", Virtiofs: &kubevirtiov1.FilesystemVirtiofs{}})
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+
+			It("should allow storage-admin to remove a mount", func() {
+				mockPerm.permissions["virtualmachines/filesystem-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+				removeFilesystem()
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow filesystem-admin removing a mount without the option", func() {
+				for _, checker := range validator.FieldCheckers {
+					if filesystems, ok := checker.(*FilesystemPermissionChecker); ok {
+						filesystems.RequireRemovalAdmin = false
+					}
+				}
+				removeFilesystem()
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with identity-admin permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				newVM.Spec.Template.Spec.Domain.Devices.Disks = append(newVM.Spec.Template.Spec.Domain.Devices.Disks,
+					kubevirtiov1.Disk{Name: "sa"})
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
+					Name: "sa",
+					VolumeSource: kubevirtiov1.VolumeSource{
+						ServiceAccount: &kubevirtiov1.ServiceAccountVolumeSource{ServiceAccountName: "privileged"},
+					},
+				})
+			})
+
+			It("should allow adding a serviceAccount volume", func() {
+				mockPerm.permissions["virtualmachines/identity-admin"] = true
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny adding a serviceAccount volume with only a storage subset role", func() {
+				mockPerm.permissions["virtualmachines/disk-tuning-admin"] = true
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny storage-admin adding a serviceAccount volume (carved out of storage)", func() {
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(ReasonCodeOf(err)).To(Equal(ReasonCode("IDENTITY_FORBIDDEN")))
+			})
+
+			Context("when changing the mounted service account", func() {
+				BeforeEach(func() {
+					oldVM = newVM.DeepCopy()
+					newVM = oldVM.DeepCopy()
+					volumes := newVM.Spec.Template.Spec.Volumes
+					volumes[len(volumes)-1].ServiceAccount.ServiceAccountName = "cluster-admin"
+				})
+
+				It("should deny storage-admin", func() {
+					mockPerm.permissions["virtualmachines/storage-admin"] = true
+
+					_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).To(HaveOccurred())
+					Expect(ReasonCodeOf(err)).To(Equal(ReasonCode("IDENTITY_FORBIDDEN")))
+				})
+
+				It("should allow identity-admin", func() {
+					mockPerm.permissions["virtualmachines/identity-admin"] = true
+
+					warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(BeNil())
+				})
+
+				It("should allow storage-admin and identity-admin together with a regular storage change", func() {
+					mockPerm.permissions["virtualmachines/storage-admin"] = true
+					mockPerm.permissions["virtualmachines/identity-admin"] = true
+					newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+
+					warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(BeNil())
+				})
+			})
+
+			It("should deny storage-admin and identity-admin switching a regular volume to a serviceAccount", func() {
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+				mockPerm.permissions["virtualmachines/identity-admin"] = true
+				oldVM = newVM.DeepCopy()
+				volumes := oldVM.Spec.Template.Spec.Volumes
+				volumes[len(volumes)-1].VolumeSource = kubevirtiov1.VolumeSource{
+					PersistentVolumeClaim: &kubevirtiov1.PersistentVolumeClaimVolumeSource{},
+				}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should deny regular storage changes", func() {
+				mockPerm.permissions["virtualmachines/identity-admin"] = true
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with config-admin permission", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				credentials := kubevirtiov1.Volume{
+					Name: "credentials",
+					VolumeSource: kubevirtiov1.VolumeSource{
+						Secret: &kubevirtiov1.SecretVolumeSource{SecretName: "app-credentials"},
+					},
+				}
+				oldVM.Spec.Template.Spec.Volumes = append(oldVM.Spec.Template.Spec.Volumes, credentials)
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, *credentials.DeepCopy())
+				newVM.Spec.Template.Spec.Volumes[len(newVM.Spec.Template.Spec.Volumes)-1].Secret.SecretName = "other-credentials"
+			})
+
+			It("should allow swapping a mounted Secret", func() {
+				mockPerm.permissions["virtualmachines/config-admin"] = true
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny swapping a mounted Secret with only a storage subset role", func() {
+				mockPerm.permissions["virtualmachines/disk-tuning-admin"] = true
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow storage-admin to swap a mounted Secret (superset)", func() {
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow adding a configMap volume", func() {
+				mockPerm.permissions["virtualmachines/config-admin"] = true
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
+					Name: "app-config",
+					VolumeSource: kubevirtiov1.VolumeSource{
+						ConfigMap: &kubevirtiov1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "app-config"},
+						},
+					},
+				})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny adding a serviceAccount volume", func() {
+				mockPerm.permissions["virtualmachines/config-admin"] = true
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
+					Name: "sa",
+					VolumeSource: kubevirtiov1.VolumeSource{
+						ServiceAccount: &kubevirtiov1.ServiceAccountVolumeSource{ServiceAccountName: "privileged"},
+					},
+				})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny regular storage changes", func() {
+				mockPerm.permissions["virtualmachines/config-admin"] = true
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with a disk tag change", func() {
+			BeforeEach(func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Tag = "cloud-init-data"
+			})
+
+			It("should attribute the tag change to config-admin", func() {
+				Expect(validator.changedCategories(oldVM, newVM)).To(Equal([]string{"disk-tag", "storage"}))
+			})
+
+			It("should allow config-admin to change the tag while the volume binding is unchanged", func() {
+				mockPerm.permissions["virtualmachines/config-admin"] = true
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow storage-admin to change the tag (superset)", func() {
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny the tag change with only another storage subset role", func() {
+				mockPerm.permissions["virtualmachines/disk-identity-admin"] = true
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny config-admin a tag change combined with rebinding the disk", func() {
+				mockPerm.permissions["virtualmachines/config-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.Disks[0].Name = "volume1"
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("hierarchical permissions (superset/subset)", func() {
+			It("should allow storage-admin to make CD-ROM changes (even without cdrom-user)", func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
 				mockPerm.permissions["*"] = false
 				mockPerm.permissions["virtualmachines/storage-admin"] = true
 				mockPerm.permissions["virtualmachines/cdrom-user"] = false // User does NOT have cdrom-user
@@ -567,68 +3962,247 @@ var _ = Describe("VirtualMachine Webhook", func() {
 				oldVM.Spec.Template.Spec.Domain.Devices.Disks = append(oldVM.Spec.Template.Spec.Domain.Devices.Disks, cdromDisk)
 				newVM.Spec.Template.Spec.Domain.Devices.Disks = append(newVM.Spec.Template.Spec.Domain.Devices.Disks, cdromDisk)
 
-				// Make BOTH types of storage changes:
-				// 1. Regular storage change
-				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
-					Name: "regular-disk",
-				})
+				// Make BOTH types of storage changes:
+				// 1. Regular storage change
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
+					Name: "regular-disk",
+				})
+
+				// 2. CD-ROM media change
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
+					Name: "cdrom1",
+					VolumeSource: kubevirtiov1.VolumeSource{
+						DataVolume: &kubevirtiov1.DataVolumeSource{
+							Name:         "ubuntu-iso",
+							Hotpluggable: true,
+						},
+					},
+				})
+
+				// Should succeed - storage-admin covers BOTH changes
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should allow user with both permissions to make CD-ROM changes", func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["*"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+				mockPerm.permissions["virtualmachines/cdrom-user"] = true // Has both
+
+				// Setup CD-ROM disk
+				cdromDisk := kubevirtiov1.Disk{
+					Name: "cdrom1",
+					DiskDevice: kubevirtiov1.DiskDevice{
+						CDRom: &kubevirtiov1.CDRomTarget{
+							Bus: "sata",
+						},
+					},
+				}
+				oldVM.Spec.Template.Spec.Domain.Devices.Disks = append(oldVM.Spec.Template.Spec.Domain.Devices.Disks, cdromDisk)
+				newVM.Spec.Template.Spec.Domain.Devices.Disks = append(newVM.Spec.Template.Spec.Domain.Devices.Disks, cdromDisk)
+
+				// Add hotpluggable CD-ROM volume
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
+					Name: "cdrom1",
+					VolumeSource: kubevirtiov1.VolumeSource{
+						DataVolume: &kubevirtiov1.DataVolumeSource{
+							Name:         "fedora-iso",
+							Hotpluggable: true,
+						},
+					},
+				})
+
+				// Should succeed (cdrom-user neutralizes, storage-admin would also cover it)
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with group short-circuit lists", func() {
+			BeforeEach(func() {
+				// Any SubjectAccessReview would fail, proving short-circuits skip them
+				mockPerm.shouldError = true
+
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+			})
+
+			It("should allow members of an allow group without checking permissions", func() {
+				validator.AllowGroups = []string{"system:cluster-admins", "test-group"}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should deny members of a deny group without checking permissions", func() {
+				validator.DenyGroups = []string{"quarantine", "test-group"}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(`denied group "test-group"`))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should give deny groups precedence over allow groups", func() {
+				validator.AllowGroups = []string{"test-group"}
+				validator.DenyGroups = []string{"test-group"}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("denied group"))
+				Expect(warnings).To(BeNil())
+			})
+
+			It("should fall through to permission checks for non-members", func() {
+				validator.AllowGroups = []string{"system:cluster-admins"}
+				validator.DenyGroups = []string{"quarantine"}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("failed to check"))
+				Expect(warnings).To(BeNil())
+			})
+		})
+
+		Context("with namespace scoping", func() {
+			BeforeEach(func() {
+				// A storage-admin changing compute is denied wherever enforcement applies
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+			})
+
+			It("should skip granular checks for VMs outside the enforced namespaces", func() {
+				validator.EnforcedNamespaces = []string{"staging"}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+				Expect(mockPerm.calls).To(BeZero())
+			})
+
+			It("should enforce VMs in the enforced namespaces", func() {
+				validator.EnforcedNamespaces = []string{"staging", "default"}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("does not have permission"))
+			})
+
+			It("should skip granular checks for VMs in exempt namespaces", func() {
+				validator.ExemptNamespaces = []string{"default"}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeNil())
+				Expect(mockPerm.calls).To(BeZero())
+			})
+
+			It("should give exemption precedence over enforcement", func() {
+				validator.EnforcedNamespaces = []string{"default"}
+				validator.ExemptNamespaces = []string{"default"}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should skip deny groups outside the enforced scope", func() {
+				validator.ExemptNamespaces = []string{"default"}
+				validator.DenyGroups = []string{"test-group"}
+
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("with pending enforcement fields", func() {
+			BeforeEach(func() {
+				validator.PendingEnforcementFields = []string{"spec.template.spec.domain.devices.tpm"}
+				mockPerm.permissions["virtualmachines/full-admin"] = false
+				mockPerm.permissions["virtualmachines/storage-admin"] = true
+				mockPerm.permissions["virtualmachines/devices-admin"] = true
+			})
+
+			It("should warn without denying when a pending field changes", func() {
+				newVM.Spec.Template.Spec.Domain.Devices.TPM = &kubevirtiov1.TPMDevice{}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf(ContainSubstring(
+					"spec.template.spec.domain.devices.tpm of VirtualMachine default/test-vm changed")))
+			})
+
+			It("should warn users that bypass granular checks", func() {
+				mockPerm.permissions["virtualmachines/full-admin"] = true
+				newVM.Spec.Template.Spec.Domain.Devices.TPM = &kubevirtiov1.TPMDevice{}
 
-				// 2. CD-ROM media change
-				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
-					Name: "cdrom1",
-					VolumeSource: kubevirtiov1.VolumeSource{
-						DataVolume: &kubevirtiov1.DataVolumeSource{
-							Name:         "ubuntu-iso",
-							Hotpluggable: true,
-						},
-					},
-				})
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(HaveLen(1))
+			})
+
+			It("should not warn when pending fields are unchanged", func() {
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
 
-				// Should succeed - storage-admin covers BOTH changes
 				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(warnings).To(BeNil())
 			})
 
-			It("should allow user with both permissions to make CD-ROM changes", func() {
+			It("should still deny unauthorized changes alongside the warning", func() {
+				mockPerm.permissions["virtualmachines/devices-admin"] = false
+				newVM.Spec.Template.Spec.Domain.Devices.TPM = &kubevirtiov1.TPMDevice{}
+
+				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				Expect(err).To(HaveOccurred())
+				Expect(warnings).To(HaveLen(1))
+			})
+		})
+
+		Context("debug logging", func() {
+			var (
+				originalLog logr.Logger
+				logLines    []string
+			)
+
+			BeforeEach(func() {
+				originalLog = virtualmachinelog
+				logLines = nil
+				virtualmachinelog = funcr.New(func(prefix, args string) {
+					logLines = append(logLines, args)
+				}, funcr.Options{Verbosity: 2})
+			})
+
+			AfterEach(func() {
+				virtualmachinelog = originalLog
+			})
+
+			It("should log the resolved subresource permissions and changed categories", func() {
 				mockPerm.permissions["virtualmachines/full-admin"] = false
-				mockPerm.permissions["*"] = false
 				mockPerm.permissions["virtualmachines/storage-admin"] = true
-				mockPerm.permissions["virtualmachines/cdrom-user"] = true // Has both
-
-				// Setup CD-ROM disk
-				cdromDisk := kubevirtiov1.Disk{
-					Name: "cdrom1",
-					DiskDevice: kubevirtiov1.DiskDevice{
-						CDRom: &kubevirtiov1.CDRomTarget{
-							Bus: "sata",
-						},
-					},
-				}
-				oldVM.Spec.Template.Spec.Domain.Devices.Disks = append(oldVM.Spec.Template.Spec.Domain.Devices.Disks, cdromDisk)
-				newVM.Spec.Template.Spec.Domain.Devices.Disks = append(newVM.Spec.Template.Spec.Domain.Devices.Disks, cdromDisk)
 
-				// Add hotpluggable CD-ROM volume
-				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
-					Name: "cdrom1",
-					VolumeSource: kubevirtiov1.VolumeSource{
-						DataVolume: &kubevirtiov1.DataVolumeSource{
-							Name:         "fedora-iso",
-							Hotpluggable: true,
-						},
-					},
-				})
+				newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
 
-				// Should succeed (cdrom-user neutralizes, storage-admin would also cover it)
-				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
+				_, err := validator.ValidateUpdate(ctx, oldVM, newVM)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(warnings).To(BeNil())
+
+				Expect(logLines).To(ContainElement(And(
+					ContainSubstring(`"msg"="Resolved subresource permissions"`),
+					ContainSubstring(`"virtualmachines/storage-admin"=true`),
+					Not(ContainSubstring(`"virtualmachines/network-admin"`)),
+					ContainSubstring(`"changedCategories"=["storage"]`),
+				)))
 			})
 		})
 
 		Context("error handling", func() {
 			It("should handle permission check errors", func() {
 				mockPerm.shouldError = true
+				newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
 
 				warnings, err := validator.ValidateUpdate(ctx, oldVM, newVM)
 				Expect(err).To(HaveOccurred())
@@ -639,22 +4213,576 @@ var _ = Describe("VirtualMachine Webhook", func() {
 	})
 })
 
+var _ = Describe("Deprecated API versions", func() {
+	var (
+		handler  *admission.Webhook
+		mockPerm *MockPermissionChecker
+		oldVM    *kubevirtiov1.VirtualMachine
+	)
+
+	// v1alpha3Request builds the request the API server sends for an update submitted
+	// as kubevirt.io/v1alpha3: with matchPolicy Equivalent the objects are converted to
+	// the registered kubevirt.io/v1 version, and only RequestKind records the original
+	v1alpha3Request := func(oldVM, newVM *kubevirtiov1.VirtualMachine) admission.Request {
+		oldRaw, err := json.Marshal(oldVM)
+		Expect(err).ToNot(HaveOccurred())
+		newRaw, err := json.Marshal(newVM)
+		Expect(err).ToNot(HaveOccurred())
+
+		return admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				UID:         "v1alpha3-request",
+				Operation:   admissionv1.Update,
+				Kind:        metav1.GroupVersionKind{Group: "kubevirt.io", Version: "v1", Kind: "VirtualMachine"},
+				RequestKind: &metav1.GroupVersionKind{Group: "kubevirt.io", Version: "v1alpha3", Kind: "VirtualMachine"},
+				UserInfo:    authenticationv1.UserInfo{Username: "test-user"},
+				Object:      runtime.RawExtension{Raw: newRaw},
+				OldObject:   runtime.RawExtension{Raw: oldRaw},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		testScheme := runtime.NewScheme()
+		Expect(kubevirtiov1.AddToScheme(testScheme)).To(Succeed())
+
+		mockPerm = &MockPermissionChecker{permissions: map[string]bool{
+			"virtualmachines/storage-admin": true,
+		}}
+		handler = admission.WithCustomValidator(testScheme, &kubevirtiov1.VirtualMachine{}, &VirtualMachineCustomValidator{
+			FieldCheckers:     DefaultFieldCheckers(),
+			PermissionChecker: mockPerm,
+		})
+
+		oldVM = &kubevirtiov1.VirtualMachine{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "kubevirt.io/v1", Kind: "VirtualMachine"},
+			ObjectMeta: metav1.ObjectMeta{Name: "test-vm", Namespace: "default"},
+			Spec: kubevirtiov1.VirtualMachineSpec{
+				Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+					Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+						Domain: kubevirtiov1.DomainSpec{
+							CPU: &kubevirtiov1.CPU{Cores: 2},
+						},
+						Volumes: []kubevirtiov1.Volume{{Name: "volume1"}},
+					},
+				},
+			},
+		}
+	})
+
+	It("should allow storage changes submitted as v1alpha3", func() {
+		newVM := oldVM.DeepCopy()
+		newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{Name: "volume2"})
+
+		resp := handler.Handle(context.Background(), v1alpha3Request(oldVM, newVM))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should deny compute changes submitted as v1alpha3", func() {
+		newVM := oldVM.DeepCopy()
+		newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+		resp := handler.Handle(context.Background(), v1alpha3Request(oldVM, newVM))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("does not have permission"))
+	})
+})
+
+var _ = Describe("RegisterValidators", func() {
+	var mgr ctrl.Manager
+
+	// registeredPath reports whether the manager's webhook server serves the given path
+	registeredPath := func(path string) bool {
+		_, pattern := mgr.GetWebhookServer().WebhookMux().Handler(httptest.NewRequest(http.MethodPost, path, nil))
+		return pattern == path
+	}
+
+	BeforeEach(func() {
+		testScheme := runtime.NewScheme()
+		Expect(kubevirtiov1.AddToScheme(testScheme)).To(Succeed())
+
+		var err error
+		// The manager is never started, so the API server is never contacted
+		mgr, err = ctrl.NewManager(&rest.Config{Host: "https://127.0.0.1:1"}, ctrl.Options{
+			Scheme:                 testScheme,
+			Metrics:                metricsserver.Options{BindAddress: "0"},
+			HealthProbeBindAddress: "0",
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should register the VirtualMachine webhook on its GVK-derived path", func() {
+		Expect(SetupVirtualMachineWebhookWithManager(mgr, WebhookOptions{})).To(Succeed())
+
+		Expect(registeredPath("/validate-kubevirt-io-v1-virtualmachine")).To(BeTrue())
+	})
+
+	It("should register the VirtualMachine webhook on a custom path", func() {
+		Expect(SetupVirtualMachineWebhookWithManager(mgr, WebhookOptions{Path: "/gateway/validate-vm"})).To(Succeed())
+
+		Expect(registeredPath("/gateway/validate-vm")).To(BeTrue())
+		Expect(registeredPath("/validate-kubevirt-io-v1-virtualmachine")).To(BeFalse())
+	})
+
+	It("should register several validators with their own paths", func() {
+		Expect(RegisterValidators(mgr,
+			ValidatorRegistration{Object: &kubevirtiov1.VirtualMachine{}, Validator: &VirtualMachineCustomValidator{}},
+			ValidatorRegistration{Object: &kubevirtiov1.VirtualMachineInstance{}, Validator: &allowAllValidator{}},
+			ValidatorRegistration{Object: &kubevirtiov1.VirtualMachineInstanceReplicaSet{}, Validator: &allowAllValidator{}, Path: "/validate-replicasets"},
+		)).To(Succeed())
+
+		Expect(registeredPath("/validate-kubevirt-io-v1-virtualmachine")).To(BeTrue())
+		Expect(registeredPath("/validate-kubevirt-io-v1-virtualmachineinstance")).To(BeTrue())
+		Expect(registeredPath("/validate-replicasets")).To(BeTrue())
+	})
+
+	It("should reject registering the same path twice", func() {
+		Expect(SetupVirtualMachineWebhookWithManager(mgr, WebhookOptions{})).To(Succeed())
+
+		err := SetupVirtualMachineWebhookWithManager(mgr, WebhookOptions{})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("is already registered"))
+	})
+
+	It("should reject invalid custom paths", func() {
+		err := SetupVirtualMachineWebhookWithManager(mgr, WebhookOptions{Path: "no-leading-slash"})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("failed to register validating webhook"))
+	})
+
+	It("should reject unknown checker names", func() {
+		err := SetupVirtualMachineWebhookWithManager(mgr, WebhookOptions{DisabledCheckers: []string{"gpus"}})
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("CategorizeChanges", func() {
+	var oldVM, newVM *kubevirtiov1.VirtualMachine
+
+	BeforeEach(func() {
+		oldVM = &kubevirtiov1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-vm", Namespace: "default"},
+			Spec: kubevirtiov1.VirtualMachineSpec{
+				Template: &kubevirtiov1.VirtualMachineInstanceTemplateSpec{
+					Spec: kubevirtiov1.VirtualMachineInstanceSpec{
+						Domain: kubevirtiov1.DomainSpec{
+							CPU: &kubevirtiov1.CPU{Cores: 2},
+							Devices: kubevirtiov1.Devices{
+								Disks: []kubevirtiov1.Disk{
+									{Name: "disk1"},
+									{
+										Name: "cdrom1",
+										DiskDevice: kubevirtiov1.DiskDevice{
+											CDRom: &kubevirtiov1.CDRomTarget{Bus: "sata"},
+										},
+									},
+								},
+							},
+						},
+						Volumes: []kubevirtiov1.Volume{{Name: "disk1"}},
+					},
+				},
+			},
+		}
+		newVM = oldVM.DeepCopy()
+	})
+
+	It("should report every category as unchanged for identical VMs", func() {
+		changes := CategorizeChanges(oldVM, newVM, DefaultFieldCheckers())
+		Expect(changes).To(HaveLen(len(DefaultFieldCheckers())))
+		for name, changed := range changes {
+			Expect(changed).To(BeFalse(), "category %s", name)
+		}
+	})
+
+	It("should report each changed category in a multi-category update", func() {
+		newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+		newVM.Spec.Template.Spec.Domain.Devices.GPUs = []kubevirtiov1.GPU{{Name: "gpu1", DeviceName: "nvidia.com/GPU"}}
+		newVM.Spec.Template.Spec.Domain.Devices.Interfaces = []kubevirtiov1.Interface{{Name: "default"}}
+		newVM.Spec.Template.Spec.Networks = []kubevirtiov1.Network{{Name: "default"}}
+
+		changes := CategorizeChanges(oldVM, newVM, DefaultFieldCheckers())
+		Expect(changes).To(HaveKeyWithValue("compute", true))
+		Expect(changes).To(HaveKeyWithValue("devices", true))
+		Expect(changes).To(HaveKeyWithValue("network", true))
+		Expect(changes).To(HaveKeyWithValue("storage", false))
+		Expect(changes).To(HaveKeyWithValue("lifecycle", false))
+	})
+
+	It("should report both a subset and its superset for CD-ROM media changes", func() {
+		newVM.Spec.Template.Spec.Volumes = append(newVM.Spec.Template.Spec.Volumes, kubevirtiov1.Volume{
+			Name: "cdrom1",
+			VolumeSource: kubevirtiov1.VolumeSource{
+				DataVolume: &kubevirtiov1.DataVolumeSource{Name: "ubuntu-iso", Hotpluggable: true},
+			},
+		})
+
+		changes := CategorizeChanges(oldVM, newVM, DefaultFieldCheckers())
+		Expect(changes).To(HaveKeyWithValue("cdrom", true))
+		Expect(changes).To(HaveKeyWithValue("storage", true))
+		Expect(changes).To(HaveKeyWithValue("compute", false))
+	})
+
+	It("should only include categories for the given checkers", func() {
+		newVM.Spec.Template.Spec.Domain.CPU.Cores = 4
+
+		changes := CategorizeChanges(oldVM, newVM, []FieldPermissionChecker{&StoragePermissionChecker{}})
+		Expect(changes).To(Equal(map[string]bool{"storage": false}))
+	})
+})
+
+var _ = Describe("withoutCheckers", func() {
+	It("should drop disabled checkers and keep the order of the rest", func() {
+		fieldCheckers, err := withoutCheckers(DefaultFieldCheckers(), []string{"devices", "storage"})
+		Expect(err).ToNot(HaveOccurred())
+
+		var names []string
+		for _, checker := range fieldCheckers {
+			names = append(names, checker.Name())
+		}
+		Expect(names).To(Equal([]string{
+			"sriov", "autoattach", "lifecycle", "template-metadata", "instancetype", "network-ports", "network-security", "bridge", "link-state", "network-tuning", "multus", "network",
+			"input", "console", "gpu", "cpu-pinning", "cpu-topology", "hugepages", "memory-resize", "memory-limit", "cpu-features", "compute", "boot", "cdrom", "dedicated-io-thread", "disk-tuning", "disk-identity",
+			"disk-tag", "shared-disk", "filesystem-removal", "filesystem-user", "filesystem", "identity", "config",
+		}))
+	})
+
+	It("should return every checker when none are disabled", func() {
+		fieldCheckers, err := withoutCheckers(DefaultFieldCheckers(), nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fieldCheckers).To(HaveLen(len(DefaultFieldCheckers())))
+	})
+
+	It("should reject unknown checker names", func() {
+		_, err := withoutCheckers(DefaultFieldCheckers(), []string{"gpus"})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`unknown field permission checker "gpus"`))
+	})
+})
+
+var _ = Describe("backfillDefaults", func() {
+	var oldVM, newVM *kubevirtiov1.VirtualMachine
+
+	BeforeEach(func() {
+		oldVM = &kubevirtiov1.VirtualMachine{
+			Spec: kubevirtiov1.VirtualMachineSpec{
+				Template:   &kubevirtiov1.VirtualMachineInstanceTemplateSpec{},
+				Preference: &kubevirtiov1.PreferenceMatcher{Name: "fedora"},
+			},
+		}
+		newVM = oldVM.DeepCopy()
+	})
+
+	It("should return the old VM itself when nothing is defaulted", func() {
+		Expect(backfillDefaults(oldVM, newVM, ClusterDefaults{})).To(BeIdenticalTo(oldVM))
+	})
+
+	It("should copy defaulted fields without modifying the old VM", func() {
+		newVM.Spec.Template.Spec.Architecture = "amd64"
+		newVM.Spec.Template.Spec.Domain.Machine = &kubevirtiov1.Machine{Type: "q35"}
+		newVM.Spec.Preference.Kind = "virtualmachineclusterpreference"
+
+		backfilled := backfillDefaults(oldVM, newVM, ClusterDefaults{})
+		Expect(backfilled.Spec).To(Equal(newVM.Spec))
+		Expect(oldVM.Spec.Template.Spec.Architecture).To(BeEmpty())
+		Expect(oldVM.Spec.Template.Spec.Domain.Machine).To(BeNil())
+		Expect(oldVM.Spec.Preference.Kind).To(BeEmpty())
+	})
+
+	It("should backfill the configured defaults of the cluster", func() {
+		newVM.Spec.Template.Spec.Architecture = "arm64"
+		newVM.Spec.Template.Spec.Domain.Machine = &kubevirtiov1.Machine{Type: "virt-9.0"}
+
+		defaults := ClusterDefaults{Architecture: "arm64", MachineTypes: map[string]string{"arm64": "virt-9.0"}}
+		Expect(backfillDefaults(oldVM, newVM, defaults).Spec).To(Equal(newVM.Spec))
+	})
+
+	It("should backfill the machine type of the default architecture when the architecture is unset", func() {
+		newVM.Spec.Template.Spec.Domain.Machine = &kubevirtiov1.Machine{Type: "q35"}
+
+		Expect(backfillDefaults(oldVM, newVM, ClusterDefaults{}).Spec).To(Equal(newVM.Spec))
+	})
+
+	It("should not backfill values other than the cluster defaults", func() {
+		newVM.Spec.Template.Spec.Architecture = "s390x"
+		newVM.Spec.Template.Spec.Domain.Machine = &kubevirtiov1.Machine{Type: "pc-i440fx-2.0"}
+
+		Expect(backfillDefaults(oldVM, newVM, ClusterDefaults{})).To(BeIdenticalTo(oldVM))
+	})
+
+	It("should not backfill the default machine type of another architecture", func() {
+		newVM.Spec.Template.Spec.Architecture = "s390x"
+		newVM.Spec.Template.Spec.Domain.Machine = &kubevirtiov1.Machine{Type: "q35"}
+
+		Expect(backfillDefaults(oldVM, newVM, ClusterDefaults{})).To(BeIdenticalTo(oldVM))
+	})
+
+	It("should not backfill fields the old VM sets or the new VM clears", func() {
+		oldVM.Spec.Template.Spec.Architecture = "amd64"
+		newVM.Spec.Template.Spec.Architecture = "arm64"
+		oldVM.Spec.Template.Spec.Domain.Machine = &kubevirtiov1.Machine{Type: "q35"}
+
+		Expect(backfillDefaults(oldVM, newVM, ClusterDefaults{})).To(BeIdenticalTo(oldVM))
+	})
+
+	Context("ParseMachineTypes", func() {
+		It("should parse architecture=machine type entries", func() {
+			machineTypes, err := ParseMachineTypes([]string{"amd64=pc-q35-rhel9.4.0", " arm64 = virt "})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(machineTypes).To(Equal(map[string]string{"amd64": "pc-q35-rhel9.4.0", "arm64": "virt"}))
+		})
+
+		It("should return nil without entries, for KubeVirt's defaults", func() {
+			machineTypes, err := ParseMachineTypes(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(machineTypes).To(BeNil())
+		})
+
+		It("should reject an entry without a machine type", func() {
+			_, err := ParseMachineTypes([]string{"amd64"})
+			Expect(err).To(MatchError(`invalid default machine type "amd64", must be <architecture>=<machine type>`))
+		})
+
+		It("should reject a duplicate architecture", func() {
+			_, err := ParseMachineTypes([]string{"amd64=q35", "amd64=pc"})
+			Expect(err).To(MatchError(`duplicate default machine type architecture "amd64"`))
+		})
+	})
+})
+
+var _ = Describe("validateCheckerOrder", func() {
+	It("should accept the default order", func() {
+		Expect(validateCheckerOrder(DefaultFieldCheckers())).To(Succeed())
+	})
+
+	It("should accept any order of unrelated checkers", func() {
+		Expect(validateCheckerOrder([]FieldPermissionChecker{
+			&StoragePermissionChecker{}, &NetworkPermissionChecker{}, &ComputePermissionChecker{},
+		})).To(Succeed())
+		Expect(validateCheckerOrder([]FieldPermissionChecker{
+			&AutoattachPermissionChecker{}, &InputPermissionChecker{}, &DevicesPermissionChecker{},
+		})).To(Succeed())
+	})
+
+	It("should reject a subset running after its superset", func() {
+		err := validateCheckerOrder([]FieldPermissionChecker{
+			&StoragePermissionChecker{}, &CdromUserPermissionChecker{},
+		})
+		Expect(err).To(MatchError(`field permission checker "cdrom" must run before "storage", which is a superset of it`))
+	})
+
+	It("should reject a misordered chain of subsets", func() {
+		err := validateCheckerOrder([]FieldPermissionChecker{
+			&FilesystemPermissionChecker{}, &FilesystemUserPermissionChecker{}, &StoragePermissionChecker{},
+		})
+		Expect(err).To(MatchError(ContainSubstring(`"filesystem-user" must run before "filesystem"`)))
+	})
+
+	It("should reject every reversed subset/superset pair of the default order", func() {
+		checkers := DefaultFieldCheckers()
+		for i, checker := range checkers {
+			subset, ok := checker.(SubsetPermissionChecker)
+			if !ok {
+				continue
+			}
+			for _, superset := range checkers[i+1:] {
+				if subset.IsSubsetOf(superset.Name()) {
+					Expect(validateCheckerOrder([]FieldPermissionChecker{superset, subset})).ToNot(Succeed(),
+						"%s before %s", superset.Name(), subset.Name())
+				}
+			}
+		}
+	})
+
+	It("should only name existing supersets", func() {
+		names := make(map[string]bool)
+		for _, checker := range DefaultFieldCheckers() {
+			names[checker.Name()] = true
+		}
+		for _, checker := range DefaultFieldCheckers() {
+			if subset, ok := checker.(SubsetPermissionChecker); ok {
+				Expect(slices.ContainsFunc(slices.Collect(maps.Keys(names)), subset.IsSubsetOf)).To(BeTrue(), checker.Name())
+			}
+		}
+	})
+})
+
+var _ = Describe("TypedSubjectAccessReviewPermissionChecker", func() {
+	var (
+		clientset *fake.Clientset
+		checker   *TypedSubjectAccessReviewPermissionChecker
+		userInfo  authenticationv1.UserInfo
+	)
+
+	BeforeEach(func() {
+		clientset = fake.NewClientset()
+		checker = &TypedSubjectAccessReviewPermissionChecker{
+			Client: clientset.AuthorizationV1().SubjectAccessReviews(),
+		}
+		userInfo = authenticationv1.UserInfo{
+			Username: "test-user",
+			Groups:   []string{"test-group"},
+			UID:      "test-uid",
+		}
+	})
+
+	It("should send the user and VM subresource in the SubjectAccessReview", func() {
+		var received *authv1.SubjectAccessReview
+		clientset.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			received = action.(k8stesting.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+			return true, &authv1.SubjectAccessReview{Status: authv1.SubjectAccessReviewStatus{Allowed: true}}, nil
+		})
+
+		allowed, err := checker.CheckPermission(context.Background(), userInfo, "default", "test-vm", "virtualmachines/storage-admin")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+
+		Expect(received).ToNot(BeNil())
+		Expect(received.Spec.User).To(Equal("test-user"))
+		Expect(received.Spec.Groups).To(ConsistOf("test-group"))
+		Expect(received.Spec.UID).To(Equal("test-uid"))
+		Expect(received.Spec.ResourceAttributes).To(Equal(&authv1.ResourceAttributes{
+			Namespace: "default",
+			Verb:      "update",
+			Group:     "kubevirt.io",
+			Resource:  "virtualmachines/storage-admin",
+			Name:      "test-vm",
+		}))
+	})
+
+	It("should report denied permissions", func() {
+		clientset.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &authv1.SubjectAccessReview{Status: authv1.SubjectAccessReviewStatus{Allowed: false}}, nil
+		})
+
+		allowed, err := checker.CheckPermission(context.Background(), userInfo, "default", "test-vm", "virtualmachines/storage-admin")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+	})
+
+	It("should prefetch permissions with one SubjectAccessReview per distinct subresource", func() {
+		var mu sync.Mutex
+		reviewed := make(map[string]int)
+		clientset.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			sar := action.(k8stesting.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+			mu.Lock()
+			defer mu.Unlock()
+			reviewed[sar.Spec.ResourceAttributes.Resource]++
+			allowed := sar.Spec.ResourceAttributes.Resource == "virtualmachines/storage-admin"
+			return true, &authv1.SubjectAccessReview{Status: authv1.SubjectAccessReviewStatus{Allowed: allowed}}, nil
+		})
+
+		permissions, err := checker.PrefetchPermissions(context.Background(), userInfo, "default", "test-vm", []string{
+			"virtualmachines/full-admin",
+			"virtualmachines/storage-admin",
+			"virtualmachines/devices-admin",
+			"virtualmachines/devices-admin",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(permissions).To(Equal(map[string]bool{
+			"virtualmachines/full-admin":    false,
+			"virtualmachines/storage-admin": true,
+			"virtualmachines/devices-admin": false,
+		}))
+		Expect(reviewed).To(Equal(map[string]int{
+			"virtualmachines/full-admin":    1,
+			"virtualmachines/storage-admin": 1,
+			"virtualmachines/devices-admin": 1,
+		}))
+	})
+
+	It("should fail the prefetch when any SubjectAccessReview fails", func() {
+		clientset.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			sar := action.(k8stesting.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+			if sar.Spec.ResourceAttributes.Resource == "virtualmachines/devices-admin" {
+				return true, nil, fmt.Errorf("apiserver unavailable")
+			}
+			return true, &authv1.SubjectAccessReview{Status: authv1.SubjectAccessReviewStatus{Allowed: true}}, nil
+		})
+
+		permissions, err := checker.PrefetchPermissions(context.Background(), userInfo, "default", "test-vm", []string{
+			"virtualmachines/full-admin",
+			"virtualmachines/devices-admin",
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("failed to check virtualmachines/devices-admin permission"))
+		Expect(permissions).To(BeNil())
+	})
+
+	It("should wrap SubjectAccessReview errors", func() {
+		clientset.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, fmt.Errorf("apiserver unavailable")
+		})
+
+		allowed, err := checker.CheckPermission(context.Background(), userInfo, "default", "test-vm", "virtualmachines/storage-admin")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("failed to create SubjectAccessReview"))
+		Expect(allowed).To(BeFalse())
+	})
+})
+
+// allowAllValidator is a CustomValidator that allows every request, used to register additional GVKs.
+type allowAllValidator struct{}
+
+var _ webhook.CustomValidator = &allowAllValidator{}
+
+func (a *allowAllValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (a *allowAllValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (a *allowAllValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
 // MockPermissionChecker is a mock implementation of PermissionChecker for testing.
 type MockPermissionChecker struct {
 	permissions map[string]bool
 	shouldError bool
+	calls       int
 }
 
 var _ PermissionChecker = &MockPermissionChecker{}
 
 // CheckPermission returns the mocked permission result or an error if configured to do so.
 func (m *MockPermissionChecker) CheckPermission(ctx context.Context, userInfo authenticationv1.UserInfo, namespace, vmName, subresource string) (bool, error) {
+	m.calls++
 	if m.shouldError {
 		return false, fmt.Errorf("mock permission check error")
 	}
 	return m.permissions[subresource], nil
 }
 
+// PrefetchPermissions checks each subresource in turn with CheckPermission.
+func (m *MockPermissionChecker) PrefetchPermissions(ctx context.Context, userInfo authenticationv1.UserInfo, namespace, vmName string, subresources []string) (map[string]bool, error) {
+	permissions := make(map[string]bool, len(subresources))
+	for _, subresource := range subresources {
+		allowed, err := m.CheckPermission(ctx, userInfo, namespace, vmName, subresource)
+		if err != nil {
+			return nil, err
+		}
+		permissions[subresource] = allowed
+	}
+	return permissions, nil
+}
+
+// recordingDecisionHook is a DecisionHook that records every decision it receives.
+type recordingDecisionHook struct {
+	decisions []Decision
+	err       error
+}
+
+var _ DecisionHook = &recordingDecisionHook{}
+
+// OnDecision records the decision and returns the configured error.
+func (r *recordingDecisionHook) OnDecision(ctx context.Context, decision Decision) error {
+	r.decisions = append(r.decisions, decision)
+	return r.err
+}
+
 // Helper function
 func boolPtr(b bool) *bool {
 	return &b
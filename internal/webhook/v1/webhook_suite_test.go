@@ -109,7 +109,7 @@ var _ = BeforeSuite(func() {
 	})
 	Expect(err).NotTo(HaveOccurred())
 
-	err = SetupVirtualMachineWebhookWithManager(mgr)
+	err = SetupVirtualMachineWebhookWithManager(mgr, WebhookOptions{})
 	Expect(err).NotTo(HaveOccurred())
 
 	// +kubebuilder:scaffold:webhook
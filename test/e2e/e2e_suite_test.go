@@ -91,6 +91,10 @@ var _ = BeforeSuite(func() {
 			_, _ = fmt.Fprintf(GinkgoWriter, "WARNING: Webhook not deployed. Run 'make cluster-sync' first\n")
 		}
 
+		By("waiting for the webhook's caBundle to be injected")
+		Expect(utils.WaitForWebhookCABundle(webhookConfigurationName, "2m")).To(Succeed(),
+			"caBundle was not injected into the webhook configuration")
+
 		// Create dedicated test namespace for RBAC tests
 		By("creating test namespace for webhook RBAC tests")
 		testNs := "webhook-rbac-test"
@@ -42,6 +42,9 @@ const metricsServiceName = "controller-manager-metrics-service"
 // metricsRoleBindingName is the name of the RBAC that will be created to allow get the metrics data
 const metricsRoleBindingName = "kubevirt-rbac-webhook-metrics-binding"
 
+// webhookConfigurationName is the name of the project's ValidatingWebhookConfiguration
+const webhookConfigurationName = "kubevirt-rbac-validating-webhook"
+
 var _ = Describe("Manager", Ordered, func() {
 	var controllerPodName string
 
@@ -68,6 +71,10 @@ var _ = Describe("Manager", Ordered, func() {
 		cmd = exec.Command("make", "deploy", fmt.Sprintf("IMG=%s", projectImage))
 		_, err = utils.Run(cmd)
 		Expect(err).NotTo(HaveOccurred(), "Failed to deploy the webhook")
+
+		By("waiting for the webhook's caBundle to be injected")
+		Expect(utils.WaitForWebhookCABundle(webhookConfigurationName, "2m")).To(Succeed(),
+			"caBundle was not injected into the webhook configuration")
 	})
 
 	// After all tests have been executed, clean up by undeploying the webhook
@@ -272,7 +279,7 @@ var _ = Describe("Manager", Ordered, func() {
 			verifyCAInjection := func(g Gomega) {
 				cmd := exec.Command("kubectl", "get",
 					"validatingwebhookconfigurations.admissionregistration.k8s.io",
-					"kubevirt-rbac-validating-webhook",
+					webhookConfigurationName,
 					"-o", "go-template={{ range .webhooks }}{{ .clientConfig.caBundle }}{{ end }}")
 				vwhOutput, err := utils.Run(cmd)
 				g.Expect(err).NotTo(HaveOccurred())
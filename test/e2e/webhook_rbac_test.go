@@ -239,6 +239,55 @@ var _ = Describe("Webhook RBAC Validation", Ordered, func() {
 		})
 	})
 
+	Context("Storage-Admin Covers CD-ROM", func() {
+		var (
+			testSA      string
+			testVM      string
+			bindingName string
+		)
+
+		BeforeAll(func() {
+			testSA = "test-storage-admin-cdrom"
+			testVM = "test-vm-storage-admin-cdrom"
+			bindingName = testSA + "-binding"
+
+			By("creating ServiceAccount for storage-admin CD-ROM tests")
+			Expect(utils.CreateServiceAccount(testSA, testNamespace)).To(Succeed())
+
+			By("creating RoleBinding for storage-admin only (no cdrom-user)")
+			Expect(utils.CreateRoleBinding(bindingName, testNamespace,
+				"kubevirt.io:vm-storage-admin", testSA)).To(Succeed())
+
+			By("creating a test VM with hotpluggable CD-ROM")
+			Expect(utils.CreateVMWithCDRom(testVM, testNamespace, true)).To(Succeed())
+		})
+
+		AfterAll(func() {
+			utils.DeleteVM(testVM, testNamespace)
+			utils.DeleteRoleBinding(bindingName, testNamespace)
+			utils.DeleteServiceAccount(testSA, testNamespace)
+		})
+
+		It("should allow swapping CD-ROM media (hotpluggable)", func() {
+			By("attempting to change CD-ROM volume as storage-admin user")
+			patch := `[{"op":"replace","path":"/spec/template/spec/volumes/1/dataVolume/name","value":"new-cdrom-storage"}]`
+			Expect(utils.PatchResourceAs("vm", testVM, testNamespace, patch, testSA, testNamespace)).
+				To(Succeed(), "storage-admin should be able to swap CD-ROM media without cdrom-user")
+
+			By("verifying the CD-ROM media was swapped")
+			output, err := utils.GetResource("vm", testVM, testNamespace)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output).To(ContainSubstring("new-cdrom-storage"))
+		})
+
+		It("should deny CPU changes", func() {
+			By("attempting to change CPU as storage-admin user")
+			err := utils.PatchResourceAs("vm", testVM, testNamespace, patchAddCPU, testSA, testNamespace)
+			Expect(err).To(HaveOccurred(), "storage-admin should NOT be able to change CPU")
+			Expect(err.Error()).To(ContainSubstring("does not have permission"), "error should indicate lack of permission")
+		})
+	})
+
 	Context("Network-Admin Permission", func() {
 		var (
 			testSA      string
@@ -539,6 +588,127 @@ subjects:
 		})
 	})
 
+	Context("Namespaced Role Grant", func() {
+		var (
+			testSA      string
+			testVM      string
+			bindingName string
+			roleYAML    string
+		)
+
+		BeforeAll(func() {
+			testSA = "test-namespaced-storage"
+			testVM = "test-vm-namespaced-storage"
+			bindingName = testSA + "-binding"
+
+			By("creating ServiceAccount for namespaced Role tests")
+			Expect(utils.CreateServiceAccount(testSA, testNamespace)).To(Succeed())
+
+			By("creating a namespaced Role granting storage-admin (not a ClusterRole)")
+			roleYAML = fmt.Sprintf(`
+apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  name: %s-role
+  namespace: %s
+rules:
+- apiGroups: ["kubevirt.io"]
+  resources: ["virtualmachines"]
+  verbs: ["get", "list", "watch", "update", "patch"]
+- apiGroups: ["kubevirt.io"]
+  resources: ["virtualmachines/storage-admin"]
+  verbs: ["update"]
+`, testSA, testNamespace)
+			Expect(utils.ApplyYAML(roleYAML)).To(Succeed())
+
+			bindingYAML := fmt.Sprintf(`
+apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  name: %s
+  namespace: %s
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: Role
+  name: %s-role
+subjects:
+- kind: ServiceAccount
+  name: %s
+  namespace: %s
+`, bindingName, testNamespace, testSA, testSA, testNamespace)
+			Expect(utils.ApplyYAML(bindingYAML)).To(Succeed())
+
+			By("creating a test VM")
+			Expect(utils.CreateTestVM(testVM, testNamespace)).To(Succeed())
+		})
+
+		AfterAll(func() {
+			utils.DeleteVM(testVM, testNamespace)
+			utils.DeleteRoleBinding(bindingName, testNamespace)
+			utils.DeleteYAML(roleYAML)
+			utils.DeleteServiceAccount(testSA, testNamespace)
+		})
+
+		It("should allow storage changes", func() {
+			By("attempting to add a volume with storage-admin granted by a namespaced Role")
+			Expect(utils.PatchResourceAs("vm", testVM, testNamespace, patchAddVolume, testSA, testNamespace)).
+				To(Succeed(), "namespaced Role storage-admin should be able to add volumes")
+		})
+
+		It("should deny CPU changes", func() {
+			By("attempting to change CPU with storage-admin granted by a namespaced Role")
+			err := utils.PatchResourceAs("vm", testVM, testNamespace, patchAddCPU, testSA, testNamespace)
+			Expect(err).To(HaveOccurred(), "namespaced Role storage-admin should NOT be able to change CPU")
+			Expect(err.Error()).To(ContainSubstring("does not have permission"), "error should indicate lack of permission")
+		})
+	})
+
+	Context("Deprecated v1alpha3 API", func() {
+		var (
+			testSA      string
+			testVM      string
+			bindingName string
+		)
+
+		// Fully qualified resource so kubectl talks to the v1alpha3 endpoint
+		const v1alpha3VM = "virtualmachines.v1alpha3.kubevirt.io"
+
+		BeforeAll(func() {
+			testSA = "test-v1alpha3-storage"
+			testVM = "test-vm-v1alpha3-storage"
+			bindingName = testSA + "-binding"
+
+			By("creating ServiceAccount for v1alpha3 tests")
+			Expect(utils.CreateServiceAccount(testSA, testNamespace)).To(Succeed())
+
+			By("creating RoleBinding for storage-admin")
+			Expect(utils.CreateRoleBinding(bindingName, testNamespace,
+				"kubevirt.io:vm-storage-admin", testSA)).To(Succeed())
+
+			By("creating a test VM")
+			Expect(utils.CreateTestVM(testVM, testNamespace)).To(Succeed())
+		})
+
+		AfterAll(func() {
+			utils.DeleteVM(testVM, testNamespace)
+			utils.DeleteRoleBinding(bindingName, testNamespace)
+			utils.DeleteServiceAccount(testSA, testNamespace)
+		})
+
+		It("should allow storage changes submitted as v1alpha3", func() {
+			By("attempting to add a volume through the v1alpha3 API as storage-admin user")
+			Expect(utils.PatchResourceAs(v1alpha3VM, testVM, testNamespace, patchAddVolume, testSA, testNamespace)).
+				To(Succeed(), "storage-admin should be able to add volumes through v1alpha3")
+		})
+
+		It("should deny CPU changes submitted as v1alpha3", func() {
+			By("attempting to change CPU through the v1alpha3 API as storage-admin user")
+			err := utils.PatchResourceAs(v1alpha3VM, testVM, testNamespace, patchAddCPU, testSA, testNamespace)
+			Expect(err).To(HaveOccurred(), "storage-admin should NOT be able to change CPU through v1alpha3")
+			Expect(err.Error()).To(ContainSubstring("does not have permission"), "error should indicate lack of permission")
+		})
+	})
+
 	Context("Combined Permissions", func() {
 		var (
 			testSA       string
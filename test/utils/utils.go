@@ -19,11 +19,13 @@ package utils
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2" // nolint:revive,staticcheck
 )
@@ -420,6 +422,69 @@ func WaitForResource(resourceType, name, namespace string, timeout string) error
 	return err
 }
 
+// WaitForWebhookCABundle polls the named ValidatingWebhookConfiguration until every webhook has
+// a caBundle injected, so that tests do not hit the webhook before the API server trusts it
+func WaitForWebhookCABundle(name string, timeout string) error {
+	d, err := time.ParseDuration(timeout)
+	if err != nil {
+		return fmt.Errorf("invalid timeout %q: %w", timeout, err)
+	}
+
+	return pollCABundle(name, func() (string, error) {
+		cmd := newKubectlCommand("get", "validatingwebhookconfigurations.admissionregistration.k8s.io", name, "-o", "json")
+		return Run(cmd)
+	}, d, 2*time.Second)
+}
+
+// pollCABundle calls get until its output shows a caBundle on every webhook or the timeout expires.
+// Errors from get (e.g. the configuration not existing yet) are retried as well.
+func pollCABundle(name string, get func() (string, error), timeout, interval time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		output, err := get()
+		if err == nil {
+			var injected bool
+			injected, err = hasCABundle(output)
+			if injected {
+				return nil
+			}
+		}
+
+		if time.Now().After(deadline) {
+			if err != nil {
+				return fmt.Errorf("caBundle of ValidatingWebhookConfiguration %s not injected after %s: %w", name, timeout, err)
+			}
+			return fmt.Errorf("caBundle of ValidatingWebhookConfiguration %s not injected after %s", name, timeout)
+		}
+		time.Sleep(interval)
+	}
+}
+
+// hasCABundle reports whether the ValidatingWebhookConfiguration JSON has at least one webhook
+// and a caBundle on all of them
+func hasCABundle(output string) (bool, error) {
+	var config struct {
+		Webhooks []struct {
+			ClientConfig struct {
+				CABundle string `json:"caBundle"`
+			} `json:"clientConfig"`
+		} `json:"webhooks"`
+	}
+	if err := json.Unmarshal([]byte(output), &config); err != nil {
+		return false, fmt.Errorf("failed to parse ValidatingWebhookConfiguration: %w", err)
+	}
+
+	if len(config.Webhooks) == 0 {
+		return false, nil
+	}
+	for _, webhook := range config.Webhooks {
+		if webhook.ClientConfig.CABundle == "" {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 // CreateTestVM creates a basic test VirtualMachine
 func CreateTestVM(name, namespace string) error {
 	vmYAML := fmt.Sprintf(`
@@ -0,0 +1,90 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	webhookWithoutCABundle = `{"webhooks":[{"name":"vm.example.io","clientConfig":{"service":{"name":"webhook-service"}}}]}`
+	webhookWithCABundle    = `{"webhooks":[{"name":"vm.example.io","clientConfig":{"caBundle":"LS0tLS1CRUdJTg=="}}]}`
+)
+
+func TestHasCABundle(t *testing.T) {
+	g := NewWithT(t)
+
+	for output, expected := range map[string]bool{
+		webhookWithCABundle:    true,
+		webhookWithoutCABundle: false,
+		`{"webhooks":[]}`:      false,
+		`{"webhooks":[{"clientConfig":{"caBundle":"LS0t"}},{"clientConfig":{}}]}`: false,
+	} {
+		injected, err := hasCABundle(output)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(injected).To(Equal(expected), output)
+	}
+
+	_, err := hasCABundle("Error from server (NotFound)")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestPollCABundle(t *testing.T) {
+	t.Run("waits until the caBundle is injected", func(t *testing.T) {
+		g := NewWithT(t)
+
+		outputs := []string{"", webhookWithoutCABundle, webhookWithCABundle}
+		calls := 0
+		get := func() (string, error) {
+			output := outputs[calls]
+			calls++
+			if output == "" {
+				return "", errors.New("not found")
+			}
+			return output, nil
+		}
+
+		g.Expect(pollCABundle("test-webhook", get, time.Second, time.Millisecond)).To(Succeed())
+		g.Expect(calls).To(Equal(3))
+	})
+
+	t.Run("fails after the timeout", func(t *testing.T) {
+		g := NewWithT(t)
+
+		get := func() (string, error) {
+			return webhookWithoutCABundle, nil
+		}
+
+		err := pollCABundle("test-webhook", get, 10*time.Millisecond, time.Millisecond)
+		g.Expect(err).To(MatchError(ContainSubstring("caBundle of ValidatingWebhookConfiguration test-webhook not injected")))
+	})
+
+	t.Run("reports the last error after the timeout", func(t *testing.T) {
+		g := NewWithT(t)
+
+		get := func() (string, error) {
+			return "", errors.New("not found")
+		}
+
+		err := pollCABundle("test-webhook", get, 10*time.Millisecond, time.Millisecond)
+		g.Expect(err).To(MatchError(ContainSubstring("not found")))
+	})
+}